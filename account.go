@@ -3,6 +3,7 @@ package civogo
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 )
 
 // PaginatedAccounts returns a paginated list of Account object
@@ -41,3 +42,28 @@ func (c *Client) GetAccountID() string {
 
 	return accounts.Items[0].ID
 }
+
+// GetAccount returns the account the API key belongs to, with its ID, email address and flags
+func (c *Client) GetAccount() (*Account, error) {
+	accounts, err := c.ListAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accounts.Items) == 0 {
+		err := fmt.Errorf("unable to find the current account, zero matches")
+		return nil, ZeroMatchesError.wrap(err)
+	}
+
+	return &accounts.Items[0], nil
+}
+
+// HasFlag reports whether a feature flag is set on the account
+func (a *Account) HasFlag(flag string) bool {
+	for _, f := range splitCommaList(a.Flags) {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package civogo
+
+import (
+	"testing"
+)
+
+func TestGetAccount(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/accounts": `{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "acc-1", "email_address": "ops@example.com", "flags": "beta,kubernetes"}]}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetAccount()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "acc-1" || got.EmailAddress != "ops@example.com" {
+		t.Errorf("Unexpected account %+v", got)
+	}
+	if !got.HasFlag("kubernetes") || got.HasFlag("kube") {
+		t.Errorf("Unexpected flags %s", got.Flags)
+	}
+}
@@ -0,0 +1,102 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// APIKey is a key that can be used to authenticate against the API, the secret itself is
+// only returned once when the key is created
+type APIKey struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Key         string     `json:"key,omitempty"`
+	Permissions string     `json:"permissions,omitempty"`
+	Default     bool       `json:"default,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at,omitempty"`
+}
+
+// CreateAPIKeyConfig describes a new API key, leaving Permissions empty gives the key
+// the same access as the account, otherwise it's limited to the comma separated codes
+type CreateAPIKeyConfig struct {
+	Name        string     `json:"name"`
+	Permissions string     `json:"permissions,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// Validate checks the key has a name, valid permission codes and doesn't expire in the past
+func (k *CreateAPIKeyConfig) Validate() error {
+	if k.Name == "" {
+		err := fmt.Errorf("the API key needs a name")
+		return InvalidAPIKeyError.wrap(err)
+	}
+	if k.Permissions != "" {
+		if err := validateRolePermissions(k.Permissions); err != nil {
+			return err
+		}
+	}
+	if k.ExpiresAt != nil && !k.ExpiresAt.After(time.Now()) {
+		err := fmt.Errorf("the API key can't expire in the past, got %s", k.ExpiresAt.Format(time.RFC3339))
+		return InvalidAPIKeyError.wrap(err)
+	}
+
+	return nil
+}
+
+// Scoped reports whether the key is limited to a set of permissions
+func (k *APIKey) Scoped() bool {
+	return k.Permissions != ""
+}
+
+// Expired reports whether the key has an expiry date that has passed
+func (k *APIKey) Expired() bool {
+	return k.ExpiresAt != nil && k.ExpiresAt.Before(time.Now())
+}
+
+// ListAPIKeys returns all the API keys of the account, without their secrets
+func (c *Client) ListAPIKeys() ([]APIKey, error) {
+	resp, err := c.SendGetRequest("/v2/api_keys")
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	keys := make([]APIKey, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// CreateAPIKey creates a new API key, the returned Key is the only time the secret is available
+func (c *Client) CreateAPIKey(k *CreateAPIKeyConfig) (*APIKey, error) {
+	if err := k.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.SendPostRequest("/v2/api_keys", k)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	key := &APIKey{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// RevokeAPIKey removes an API key, any requests using it fail straight away
+func (c *Client) RevokeAPIKey(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/api_keys/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
@@ -0,0 +1,70 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestListAPIKeys(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/api_keys": `[{"id": "key-1", "name": "ci", "permissions": "kubernetes.*", "expires_at": "2020-01-01T00:00:00Z"}, {"id": "key-2", "name": "default", "default": true}]`,
+	})
+	defer server.Close()
+
+	got, err := client.ListAPIKeys()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 keys, got %d", len(got))
+	}
+	if !got[0].Scoped() || !got[0].Expired() {
+		t.Errorf("Expected key-1 to be scoped and expired, got %+v", got[0])
+	}
+	if got[1].Scoped() || got[1].Expired() || !got[1].Default {
+		t.Errorf("Expected key-2 to be the unscoped default key, got %+v", got[1])
+	}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/api_keys": `{"id": "key-1", "name": "ci", "key": "secret", "permissions": "kubernetes.*"}`,
+	})
+	defer server.Close()
+
+	got, err := client.CreateAPIKey(&CreateAPIKeyConfig{Name: "ci", Permissions: "kubernetes.*"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Key != "secret" {
+		t.Errorf("Expected %s, got %s", "secret", got.Key)
+	}
+
+	past := time.Now().Add(-time.Hour)
+	if _, err := client.CreateAPIKey(&CreateAPIKeyConfig{Name: "ci", ExpiresAt: &past}); !errors.Is(err, InvalidAPIKeyError) {
+		t.Errorf("Expected InvalidAPIKeyError, got %v", err)
+	}
+	if _, err := client.CreateAPIKey(&CreateAPIKeyConfig{Name: "ci", Permissions: "kubernetes"}); !errors.Is(err, InvalidPermissionError) {
+		t.Errorf("Expected InvalidPermissionError, got %v", err)
+	}
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/api_keys/key-1": `{"result": "success"}`,
+	})
+	defer server.Close()
+
+	got, err := client.RevokeAPIKey("key-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Result != "success" {
+		t.Errorf("Expected %s, got %s", "success", got.Result)
+	}
+}
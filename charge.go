@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -11,18 +12,35 @@ import (
 type Charge struct {
 	Code          string    `json:"code"`
 	Label         string    `json:"label"`
+	ResourceID    string    `json:"resource_id,omitempty"`
+	ResourceType  string    `json:"resource_type,omitempty"`
+	Size          string    `json:"size,omitempty"`
 	From          time.Time `json:"from"`
 	To            time.Time `json:"to"`
 	NumHours      int       `json:"num_hours"`
 	SizeGigabytes int       `json:"size_gb"`
+	Cost          float64   `json:"cost,omitempty"`
+	Currency      string    `json:"currency,omitempty"`
+}
+
+// ChargeTotal is the sum of the hours and cost of a group of charges
+type ChargeTotal struct {
+	Hours int
+	Cost  float64
 }
 
 // ListCharges returns all charges for the calling API account
 func (c *Client) ListCharges(from, to time.Time) ([]Charge, error) {
-	url := "/v2/charges"
-	url = url + fmt.Sprintf("?from=%s&to=%s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	if to.Before(from) {
+		err := fmt.Errorf("the end of the period (%s) is before the start (%s)", to.Format(time.RFC3339), from.Format(time.RFC3339))
+		return nil, InvalidChargePeriodError.wrap(err)
+	}
+
+	params := url.Values{}
+	params.Set("from", from.Format(time.RFC3339))
+	params.Set("to", to.Format(time.RFC3339))
 
-	resp, err := c.SendGetRequest(url)
+	resp, err := c.SendGetRequest("/v2/charges?" + params.Encode())
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -34,3 +52,18 @@ func (c *Client) ListCharges(from, to time.Time) ([]Charge, error) {
 
 	return charges, nil
 }
+
+// TotalChargesBy groups charges using key, e.g. the label or resource type, and sums the
+// hours and cost of each group
+func TotalChargesBy(charges []Charge, key func(Charge) string) map[string]ChargeTotal {
+	totals := map[string]ChargeTotal{}
+	for _, charge := range charges {
+		k := key(charge)
+		total := totals[k]
+		total.Hours += charge.NumHours
+		total.Cost += charge.Cost
+		totals[k] = total
+	}
+
+	return totals
+}
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -61,3 +62,31 @@ func TestListCharges(t *testing.T) {
 		t.Errorf("Expected %d, got %d", 200, got[0].SizeGigabytes)
 	}
 }
+
+func TestListChargesInvalidPeriod(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/charges": `[]`,
+	})
+	defer server.Close()
+
+	from := time.Date(2016, 3, 31, 0, 0, 0, 0, time.UTC)
+	if _, err := client.ListCharges(from, from.Add(-time.Hour)); !errors.Is(err, InvalidChargePeriodError) {
+		t.Errorf("Expected InvalidChargePeriodError, got %v", err)
+	}
+}
+
+func TestTotalChargesBy(t *testing.T) {
+	charges := []Charge{
+		{Label: "web", ResourceType: "instance", NumHours: 10, Cost: 1.5},
+		{Label: "web", ResourceType: "volume", NumHours: 10, Cost: 0.25},
+		{Label: "db", ResourceType: "database", NumHours: 5, Cost: 3},
+	}
+
+	byLabel := TotalChargesBy(charges, func(c Charge) string { return c.Label })
+	if byLabel["web"] != (ChargeTotal{Hours: 20, Cost: 1.75}) {
+		t.Errorf("Unexpected total for web %+v", byLabel["web"])
+	}
+	if byLabel["db"] != (ChargeTotal{Hours: 5, Cost: 3}) {
+		t.Errorf("Unexpected total for db %+v", byLabel["db"])
+	}
+}
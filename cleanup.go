@@ -0,0 +1,277 @@
+package civogo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ResourceType is a kind of resource in the account
+type ResourceType string
+
+// Resource types used by DeleteResourcesByPrefix, BuildResourceGraph and Resolve, only the
+// first five are cleaned up, load balancers are normally owned by a cluster
+const (
+	ResourceTypeKubernetesCluster ResourceType = "kubernetes_cluster"
+	ResourceTypeInstance          ResourceType = "instance"
+	ResourceTypeVolume            ResourceType = "volume"
+	ResourceTypeFirewall          ResourceType = "firewall"
+	ResourceTypeNetwork           ResourceType = "network"
+	ResourceTypeLoadBalancer      ResourceType = "loadbalancer"
+	ResourceTypeDatabase          ResourceType = "database"
+	ResourceTypeObjectStore       ResourceType = "objectstore"
+	ResourceTypeSSHKey            ResourceType = "ssh_key"
+	ResourceTypeReservedIP        ResourceType = "reserved_ip"
+)
+
+// cleanupOrder is the order resources are deleted in, clusters own instances, volumes and
+// firewalls, instances hold on to volumes and firewalls, and networks need to be empty
+var cleanupOrder = []ResourceType{
+	ResourceTypeKubernetesCluster,
+	ResourceTypeInstance,
+	ResourceTypeVolume,
+	ResourceTypeFirewall,
+	ResourceTypeNetwork,
+}
+
+// CleanupOptions controls how DeleteResourcesByPrefix deletes resources
+type CleanupOptions struct {
+	// DryRun finds the matching resources without deleting anything
+	DryRun bool
+	// Wait controls how long to wait for each type of resource to be gone before moving on
+	// to the next one, as later types can't be deleted while they're still in use
+	Wait WaitOptions
+}
+
+// CleanupResult is the outcome of cleaning up a single resource
+type CleanupResult struct {
+	Type    ResourceType
+	ID      string
+	Name    string
+	Deleted bool
+	Error   error
+}
+
+// CleanupReport lists every resource DeleteResourcesByPrefix found, in the order they were deleted
+type CleanupReport struct {
+	DryRun  bool
+	Results []CleanupResult
+}
+
+// Failures returns the resources that couldn't be deleted
+func (r *CleanupReport) Failures() []CleanupResult {
+	failures := []CleanupResult{}
+	for _, result := range r.Results {
+		if result.Error != nil {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// String returns the report with one line per resource, suitable for printing in CI logs
+func (r *CleanupReport) String() string {
+	var b strings.Builder
+	for _, result := range r.Results {
+		status := "deleted"
+		switch {
+		case result.Error != nil:
+			status = "failed: " + result.Error.Error()
+		case r.DryRun:
+			status = "would delete"
+		}
+		fmt.Fprintf(&b, "%s %s (%s) %s\n", result.Type, result.Name, result.ID, status)
+	}
+	return b.String()
+}
+
+// cleanupResource is a resource found by a cleanupHandler
+type cleanupResource struct {
+	ID   string
+	Name string
+}
+
+// cleanupHandler lists and deletes one type of resource
+type cleanupHandler struct {
+	list   func() ([]cleanupResource, error)
+	delete func(id string) error
+}
+
+// DeleteResourcesByPrefix deletes the resources of the given types whose name starts with prefix,
+// in dependency order: clusters, instances, volumes, firewalls and then networks. Leaving types
+// empty cleans up all of them. The default network is never deleted. A failure to delete one
+// resource is recorded in the report and the cleanup carries on, the error is only set if the
+// prefix is empty, a listing fails or the context is cancelled.
+func (c *Client) DeleteResourcesByPrefix(ctx context.Context, prefix string, types []ResourceType, opts CleanupOptions) (*CleanupReport, error) {
+	report := &CleanupReport{DryRun: opts.DryRun}
+
+	if strings.TrimSpace(prefix) == "" {
+		err := fmt.Errorf("a prefix is needed, an empty one would match every resource")
+		return report, InvalidCleanupError.wrap(err)
+	}
+
+	handlers := c.cleanupHandlers()
+	wanted := map[ResourceType]bool{}
+	for _, t := range types {
+		if _, ok := handlers[t]; !ok {
+			err := fmt.Errorf("%q isn't a resource type that can be cleaned up", t)
+			return report, InvalidCleanupError.wrap(err)
+		}
+		wanted[t] = true
+	}
+
+	for _, t := range cleanupOrder {
+		if len(wanted) > 0 && !wanted[t] {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		resources, err := handlers[t].list()
+		if err != nil {
+			return report, err
+		}
+
+		deleted := map[string]bool{}
+		for _, resource := range resources {
+			if !strings.HasPrefix(resource.Name, prefix) {
+				continue
+			}
+
+			result := CleanupResult{Type: t, ID: resource.ID, Name: resource.Name}
+			if !opts.DryRun {
+				if err := ctx.Err(); err != nil {
+					return report, err
+				}
+				if result.Error = handlers[t].delete(resource.ID); result.Error == nil {
+					result.Deleted = true
+					deleted[resource.ID] = true
+				}
+			}
+			report.Results = append(report.Results, result)
+		}
+
+		if len(deleted) == 0 {
+			continue
+		}
+		err = waitFor(opts.Wait, func() (bool, error) {
+			if err := ctx.Err(); err != nil {
+				return false, err
+			}
+			remaining, err := handlers[t].list()
+			if err != nil {
+				return false, err
+			}
+			for _, resource := range remaining {
+				if deleted[resource.ID] {
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// cleanupHandlers returns how each resource type is listed and deleted
+func (c *Client) cleanupHandlers() map[ResourceType]cleanupHandler {
+	return map[ResourceType]cleanupHandler{
+		ResourceTypeKubernetesCluster: {
+			list: func() ([]cleanupResource, error) {
+				clusters, err := listAllPages[KubernetesCluster](c, "/v2/kubernetes/clusters")
+				if err != nil {
+					return nil, err
+				}
+				resources := make([]cleanupResource, 0, len(clusters))
+				for _, cluster := range clusters {
+					resources = append(resources, cleanupResource{ID: cluster.ID, Name: cluster.Name})
+				}
+				return resources, nil
+			},
+			delete: func(id string) error {
+				_, err := c.DeleteKubernetesCluster(id)
+				return err
+			},
+		},
+		ResourceTypeInstance: {
+			list: func() ([]cleanupResource, error) {
+				instances, err := c.ListAllInstances()
+				if err != nil {
+					return nil, err
+				}
+				resources := make([]cleanupResource, 0, len(instances))
+				for _, instance := range instances {
+					resources = append(resources, cleanupResource{ID: instance.ID, Name: instance.Hostname})
+				}
+				return resources, nil
+			},
+			delete: func(id string) error {
+				_, err := c.DeleteInstance(id)
+				return err
+			},
+		},
+		ResourceTypeVolume: {
+			list: func() ([]cleanupResource, error) {
+				volumes, err := c.ListVolumes()
+				if err != nil {
+					return nil, err
+				}
+				resources := make([]cleanupResource, 0, len(volumes))
+				for _, volume := range volumes {
+					resources = append(resources, cleanupResource{ID: volume.ID, Name: volume.Name})
+				}
+				return resources, nil
+			},
+			delete: func(id string) error {
+				_, err := c.DeleteVolume(id)
+				return err
+			},
+		},
+		ResourceTypeFirewall: {
+			list: func() ([]cleanupResource, error) {
+				firewalls, err := c.ListFirewalls()
+				if err != nil {
+					return nil, err
+				}
+				resources := make([]cleanupResource, 0, len(firewalls))
+				for _, firewall := range firewalls {
+					resources = append(resources, cleanupResource{ID: firewall.ID, Name: firewall.Name})
+				}
+				return resources, nil
+			},
+			delete: func(id string) error {
+				_, err := c.DeleteFirewall(id)
+				return err
+			},
+		},
+		ResourceTypeNetwork: {
+			list: func() ([]cleanupResource, error) {
+				networks, err := c.ListNetworks()
+				if err != nil {
+					return nil, err
+				}
+				resources := make([]cleanupResource, 0, len(networks))
+				for _, network := range networks {
+					if network.Default {
+						continue
+					}
+					name := network.Label
+					if name == "" {
+						name = network.Name
+					}
+					resources = append(resources, cleanupResource{ID: network.ID, Name: name})
+				}
+				return resources, nil
+			},
+			delete: func(id string) error {
+				_, err := c.DeleteNetwork(id)
+				return err
+			},
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDeleteResourcesByPrefix(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances": {
+			`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "i-1", "hostname": "ci-123-web"}, {"id": "i-2", "hostname": "prod-web"}]}`,
+			`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "i-2", "hostname": "prod-web"}]}`,
+		},
+		"DELETE /v2/instances/i-1": {`{"result": "success"}`},
+		"GET /v2/firewalls":        {`[{"id": "fw-1", "name": "ci-123-fw"}]`},
+		"GET /v2/networks": {
+			`[{"id": "net-1", "label": "ci-123-net"}, {"id": "net-2", "label": "ci-default", "default": true}]`,
+			`[{"id": "net-2", "label": "ci-default", "default": true}]`,
+		},
+		"DELETE /v2/networks/net-1": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	opts := CleanupOptions{Wait: WaitOptions{Timeout: time.Second, Interval: time.Millisecond}}
+	report, err := client.DeleteResourcesByPrefix(context.Background(), "ci-123", []ResourceType{ResourceTypeNetwork, ResourceTypeFirewall, ResourceTypeInstance}, opts)
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+
+	if len(report.Results) != 3 {
+		t.Fatalf("Expected 3 results, got %+v", report.Results)
+	}
+	order := []ResourceType{ResourceTypeInstance, ResourceTypeFirewall, ResourceTypeNetwork}
+	for i, result := range report.Results {
+		if result.Type != order[i] {
+			t.Errorf("Expected %s at %d, got %s", order[i], i, result.Type)
+		}
+	}
+	if !report.Results[0].Deleted || !report.Results[2].Deleted {
+		t.Errorf("Expected the instance and network to be deleted, got %+v", report.Results)
+	}
+
+	// there's no route for deleting the firewall, so that one fails
+	failures := report.Failures()
+	if len(failures) != 1 || failures[0].ID != "fw-1" {
+		t.Errorf("Expected the firewall to fail, got %+v", failures)
+	}
+	if !strings.Contains(report.String(), "instance ci-123-web (i-1) deleted") {
+		t.Errorf("Unexpected report %s", report)
+	}
+}
+
+func TestDeleteResourcesByPrefixDryRun(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes": {`[{"id": "vol-1", "name": "ci-123-data"}, {"id": "vol-2", "name": "prod-data"}]`},
+	})
+	defer server.Close()
+
+	report, err := client.DeleteResourcesByPrefix(context.Background(), "ci-", []ResourceType{ResourceTypeVolume}, CleanupOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+
+	if len(report.Results) != 1 || report.Results[0].Deleted || report.Results[0].ID != "vol-1" {
+		t.Errorf("Unexpected results %+v", report.Results)
+	}
+	if report.String() != "volume ci-123-data (vol-1) would delete\n" {
+		t.Errorf("Unexpected report %q", report.String())
+	}
+
+	if _, err := client.DeleteResourcesByPrefix(context.Background(), " ", nil, CleanupOptions{DryRun: true}); !errors.Is(err, InvalidCleanupError) {
+		t.Errorf("Expected InvalidCleanupError, got %v", err)
+	}
+}
+
+func TestDeleteResourcesByPrefixPagedClusters(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters": {
+			`{"page": 1, "per_page": 100, "pages": 2, "items": [{"id": "k-1", "name": "prod-cluster"}]}`,
+			`{"page": 2, "per_page": 100, "pages": 2, "items": [{"id": "k-2", "name": "ci-123-cluster"}]}`,
+		},
+	})
+	defer server.Close()
+
+	report, err := client.DeleteResourcesByPrefix(context.Background(), "ci-123", []ResourceType{ResourceTypeKubernetesCluster}, CleanupOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+
+	if len(report.Results) != 1 || report.Results[0].ID != "k-2" {
+		t.Errorf("Expected the cluster on the second page to match, got %+v", report.Results)
+	}
+}
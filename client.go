@@ -22,6 +22,10 @@ type Client struct {
 	APIKey           string
 	Region           string
 	LastJSONResponse string
+	// ResolveNames lets methods such as AttachVolume and DeleteFirewall be given a resource's
+	// name instead of its ID, at the cost of a request to look the name up. Deletes and detaches
+	// only accept a full name or ID, never part of one.
+	ResolveNames bool
 
 	httpClient *http.Client
 }
@@ -81,8 +85,9 @@ func NewClientWithURL(apiKey, civoAPIURL, region string) (*Client, error) {
 		return nil, err
 	}
 
-	var httpTransport = &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
+	httpTransport, err := newTransport(TransportOptions{})
+	if err != nil {
+		return nil, err
 	}
 
 	client := &Client{
@@ -190,6 +195,15 @@ func (c *Client) prepareClientURL(requestURL string) *url.URL {
 	return u
 }
 
+// worker returns a copy of the client for use from another goroutine. Requests record their
+// response in LastJSONResponse, so concurrent calls sharing one client would race on it.
+func (c *Client) worker() *Client {
+	worker := *c
+	httpClient := *c.httpClient
+	worker.httpClient = &httpClient
+	return &worker
+}
+
 func (c *Client) sendRequest(req *http.Request) ([]byte, error) {
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", c.UserAgent)
@@ -197,10 +211,6 @@ func (c *Client) sendRequest(req *http.Request) ([]byte, error) {
 	req.Header.Set("Content-Encoding", "gzip")
 	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", c.APIKey))
 
-	c.httpClient.Transport = &http.Transport{
-		DisableCompression: false,
-	}
-
 	if req.Method == "GET" || req.Method == "DELETE" {
 		// add the region param
 		param := req.URL.Query()
@@ -0,0 +1,79 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+)
+
+// deleteStoppedInstances stands in for consumer code that depends on Clienter
+func deleteStoppedInstances(c Clienter) (int, error) {
+	instances, err := c.ListAllInstances()
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, instance := range instances {
+		if instance.Status != InstanceStatusShutoff {
+			continue
+		}
+		if _, err := c.DeleteInstance(instance.ID); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+func TestClienterMock(t *testing.T) {
+	mock := &ClienterMock{
+		ListAllInstancesFunc: func() ([]Instance, error) {
+			return []Instance{{ID: "i-1", Status: InstanceStatusActive}, {ID: "i-2", Status: InstanceStatusShutoff}}, nil
+		},
+		DeleteInstanceFunc: func(id string) (*SimpleResponse, error) {
+			return &SimpleResponse{ID: id, Result: ResultSuccess}, nil
+		},
+	}
+
+	deleted, err := deleteStoppedInstances(mock)
+	if err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+	if deleted != 1 {
+		t.Errorf("Expected 1 instance to be deleted, got %d", deleted)
+	}
+
+	calls := mock.DeleteInstanceCalls()
+	if len(calls) != 1 || calls[0].ID != "i-2" {
+		t.Errorf("Expected i-2 to be deleted, got %+v", calls)
+	}
+	if len(mock.ListAllInstancesCalls()) != 1 {
+		t.Errorf("Expected the instances to be listed once, got %d", len(mock.ListAllInstancesCalls()))
+	}
+}
+
+func TestClienterMockError(t *testing.T) {
+	listErr := errors.New("boom")
+	mock := &ClienterMock{
+		ListAllInstancesFunc: func() ([]Instance, error) {
+			return nil, listErr
+		},
+	}
+
+	if _, err := deleteStoppedInstances(mock); !errors.Is(err, listErr) {
+		t.Errorf("Expected %s, got %v", listErr, err)
+	}
+	if len(mock.DeleteInstanceCalls()) != 0 {
+		t.Errorf("Expected no instances to be deleted")
+	}
+}
+
+func TestClienterMockUnsetMethod(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected calling a method without a Func to panic")
+		}
+	}()
+
+	(&ClienterMock{}).Ping()
+}
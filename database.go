@@ -7,6 +7,15 @@ import (
 	"strings"
 )
 
+// Database engines supported by Civo
+const (
+	DatabaseSoftwareMySQL      = "MySQL"
+	DatabaseSoftwarePostgreSQL = "PostgreSQL"
+)
+
+// DatabaseStatusReady is the status of a database that's accepting connections
+const DatabaseStatusReady = "Ready"
+
 // DatabaseUserInfo represents the user information
 type DatabaseUserInfo struct {
 	Username string `json:"username"`
@@ -79,6 +88,38 @@ type RestoreDatabaseRequest struct {
 	Region string `json:"region"`
 }
 
+// Validate checks the database has a name, a size and a supported engine
+func (v *CreateDatabaseRequest) Validate() error {
+	if v.Name == "" {
+		err := fmt.Errorf("the database name is empty")
+		return InvalidDatabaseConfigError.wrap(err)
+	}
+	if v.Size == "" {
+		err := fmt.Errorf("the database size is empty")
+		return InvalidDatabaseConfigError.wrap(err)
+	}
+	if !strings.EqualFold(v.Software, DatabaseSoftwareMySQL) && !strings.EqualFold(v.Software, DatabaseSoftwarePostgreSQL) {
+		err := fmt.Errorf("invalid database software %q, valid software is %s and %s", v.Software, DatabaseSoftwareMySQL, DatabaseSoftwarePostgreSQL)
+		return InvalidDatabaseConfigError.wrap(err)
+	}
+	if v.Nodes < 0 {
+		err := fmt.Errorf("the number of nodes can't be negative")
+		return InvalidDatabaseConfigError.wrap(err)
+	}
+
+	return nil
+}
+
+// Validate checks a database isn't being scaled down to zero nodes
+func (v *UpdateDatabaseRequest) Validate() error {
+	if v.Nodes != nil && *v.Nodes < 1 {
+		err := fmt.Errorf("a database needs at least 1 node, got %d", *v.Nodes)
+		return InvalidDatabaseConfigError.wrap(err)
+	}
+
+	return nil
+}
+
 // ListDatabases returns a list of all databases
 func (c *Client) ListDatabases() (*PaginatedDatabases, error) {
 	resp, err := c.SendGetRequest("/v2/databases")
@@ -121,6 +162,14 @@ func (c *Client) DeleteDatabase(id string) (*SimpleResponse, error) {
 
 // NewDatabase creates a new database
 func (c *Client) NewDatabase(v *CreateDatabaseRequest) (*Database, error) {
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+
+	if v.Region == "" {
+		v.Region = c.Region
+	}
+
 	body, err := c.SendPostRequest("/v2/databases", v)
 	if err != nil {
 		return nil, decodeError(err)
@@ -136,6 +185,14 @@ func (c *Client) NewDatabase(v *CreateDatabaseRequest) (*Database, error) {
 
 // UpdateDatabase updates a database
 func (c *Client) UpdateDatabase(id string, v *UpdateDatabaseRequest) (*Database, error) {
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+
+	if v.Region == "" {
+		v.Region = c.Region
+	}
+
 	body, err := c.SendPutRequest(fmt.Sprintf("/v2/databases/%s", id), v)
 	if err != nil {
 		return nil, decodeError(err)
@@ -200,6 +257,15 @@ func (c *Client) ListDBVersions() (map[string][]SupportedSoftwareVersion, error)
 
 // RestoreDatabase restore a database
 func (c *Client) RestoreDatabase(id string, v *RestoreDatabaseRequest) (*SimpleResponse, error) {
+	if v.Backup == "" {
+		err := fmt.Errorf("the backup to restore from is empty")
+		return nil, InvalidDatabaseBackupError.wrap(err)
+	}
+
+	if v.Region == "" {
+		v.Region = c.Region
+	}
+
 	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/databases/%s/restore", id), v)
 	if err != nil {
 		return nil, decodeError(err)
@@ -8,6 +8,15 @@ import (
 	"time"
 )
 
+// DatabaseBackupTypeManual is the type of a one-off backup, as opposed to a scheduled one
+const DatabaseBackupTypeManual = "manual"
+
+// Statuses of a database backup
+const (
+	DatabaseBackupStatusReady  = "Ready"
+	DatabaseBackupStatusFailed = "Failed"
+)
+
 // DatabaseBackup represents a backup
 type DatabaseBackup struct {
 	ID           string    `json:"id,omitempty"`
@@ -51,6 +60,35 @@ type DatabaseBackupUpdateRequest struct {
 	Region string `json:"region"`
 }
 
+// checkDatabaseBackupSchedule checks a backup schedule with checkCronExpression
+func checkDatabaseBackupSchedule(schedule string) error {
+	if err := checkCronExpression(schedule); err != nil {
+		return InvalidDatabaseBackupError.wrap(err)
+	}
+	return nil
+}
+
+// checkCronExpression checks an expression is a five field cron expression or one of the
+// @hourly, @daily, @weekly or @monthly shortcuts
+func checkCronExpression(expression string) error {
+	switch expression {
+	case "@hourly", "@daily", "@weekly", "@monthly":
+		return nil
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return fmt.Errorf("invalid schedule %q, expected a cron expression with 5 fields", expression)
+	}
+	for _, field := range fields {
+		if strings.Trim(field, "0123456789*/,-") != "" {
+			return fmt.Errorf("invalid schedule %q, unexpected field %q", expression, field)
+		}
+	}
+
+	return nil
+}
+
 // ListDatabaseBackup lists backups for database
 func (c *Client) ListDatabaseBackup(did string) (*PaginatedDatabaseBackup, error) {
 	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/databases/%s/backups", did))
@@ -68,6 +106,16 @@ func (c *Client) ListDatabaseBackup(did string) (*PaginatedDatabaseBackup, error
 
 // UpdateDatabaseBackup update database backup
 func (c *Client) UpdateDatabaseBackup(did string, v *DatabaseBackupUpdateRequest) (*DatabaseBackup, error) {
+	if v.Schedule != "" {
+		if err := checkDatabaseBackupSchedule(v.Schedule); err != nil {
+			return nil, err
+		}
+	}
+
+	if v.Region == "" {
+		v.Region = c.Region
+	}
+
 	body, err := c.SendPutRequest(fmt.Sprintf("/v2/databases/%s/backups", did), v)
 	if err != nil {
 		return nil, decodeError(err)
@@ -81,8 +129,25 @@ func (c *Client) UpdateDatabaseBackup(did string, v *DatabaseBackupUpdateRequest
 	return result, nil
 }
 
-// CreateDatabaseBackup create database backup
+// UpdateDatabaseBackupSchedule changes when scheduled backups of the database are taken
+func (c *Client) UpdateDatabaseBackupSchedule(did, schedule string) (*DatabaseBackup, error) {
+	return c.UpdateDatabaseBackup(did, &DatabaseBackupUpdateRequest{Schedule: schedule})
+}
+
+// CreateDatabaseBackup create database backup, a request without a schedule is taken as a manual backup
 func (c *Client) CreateDatabaseBackup(did string, v *DatabaseBackupCreateRequest) (*DatabaseBackup, error) {
+	if v.Schedule != "" {
+		if err := checkDatabaseBackupSchedule(v.Schedule); err != nil {
+			return nil, err
+		}
+	} else if v.Type == "" {
+		v.Type = DatabaseBackupTypeManual
+	}
+
+	if v.Region == "" {
+		v.Region = c.Region
+	}
+
 	body, err := c.SendPostRequest(fmt.Sprintf("/v2/databases/%s/backups", did), v)
 	if err != nil {
 		return nil, decodeError(err)
@@ -154,3 +219,54 @@ func (c *Client) FindDatabaseBackup(dbid, search string) (*DatabaseBackup, error
 		return nil, ZeroMatchesError.wrap(err)
 	}
 }
+
+// WaitForDatabaseBackup polls a backup until it's ready, returning an error if it fails
+func (c *Client) WaitForDatabaseBackup(dbid, id string, opts WaitOptions) (*DatabaseBackup, error) {
+	var backup *DatabaseBackup
+	err := waitFor(opts, func() (bool, error) {
+		var err error
+		backup, err = c.GetDatabaseBackup(dbid, id)
+		if err != nil {
+			return false, err
+		}
+
+		if strings.EqualFold(backup.Status, DatabaseBackupStatusFailed) {
+			err := fmt.Errorf("backup %s of database %s failed", id, dbid)
+			return false, InvalidDatabaseBackupError.wrap(err)
+		}
+		return strings.EqualFold(backup.Status, DatabaseBackupStatusReady), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+// RestoreDatabaseAndWait restores a database from a backup and waits for it to be ready again
+func (c *Client) RestoreDatabaseAndWait(id string, v *RestoreDatabaseRequest, opts WaitOptions) (*Database, error) {
+	if _, err := c.RestoreDatabase(id, v); err != nil {
+		return nil, err
+	}
+
+	return c.WaitForDatabaseReady(id, opts)
+}
+
+// WaitForDatabaseReady polls a database until its status is Ready
+func (c *Client) WaitForDatabaseReady(id string, opts WaitOptions) (*Database, error) {
+	var db *Database
+	err := waitFor(opts, func() (bool, error) {
+		var err error
+		db, err = c.GetDatabase(id)
+		if err != nil {
+			return false, err
+		}
+
+		return strings.EqualFold(db.Status, DatabaseStatusReady), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
@@ -0,0 +1,120 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCheckDatabaseBackupSchedule(t *testing.T) {
+	for _, schedule := range []string{"0 */6 * * *", "30 2 * * 1-5", "@daily"} {
+		if err := checkDatabaseBackupSchedule(schedule); err != nil {
+			t.Errorf("Expected %q to be valid, got %s", schedule, err)
+		}
+	}
+
+	for _, schedule := range []string{"", "0 * * *", "every day", "0 2 * * MON"} {
+		if err := checkDatabaseBackupSchedule(schedule); !errors.Is(err, InvalidDatabaseBackupError) {
+			t.Errorf("Expected InvalidDatabaseBackupError for %q, got %v", schedule, err)
+		}
+	}
+}
+
+func TestCreateDatabaseBackupManual(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"before-upgrade","schedule":"","type":"manual","region":"TEST"}`,
+					URL:          "/v2/databases/12345/backups",
+					ResponseBody: `{"id": "b-1", "name": "before-upgrade", "database_id": "12345", "status": "Pending"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateDatabaseBackup("12345", &DatabaseBackupCreateRequest{Name: "before-upgrade"})
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	if got.ID != "b-1" {
+		t.Errorf("Unexpected backup %+v", got)
+	}
+}
+
+func TestUpdateDatabaseBackupSchedule(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"","schedule":"0 3 * * *","region":"TEST"}`,
+					URL:          "/v2/databases/12345/backups",
+					ResponseBody: `{"name": "nightly", "database_id": "12345", "schedule": "0 3 * * *", "is_scheduled": true}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.UpdateDatabaseBackupSchedule("12345", "0 3 * * *")
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	if got.Schedule != "0 3 * * *" || !got.IsScheduled {
+		t.Errorf("Unexpected backup %+v", got)
+	}
+
+	if _, err := client.UpdateDatabaseBackupSchedule("12345", "nightly"); !errors.Is(err, InvalidDatabaseBackupError) {
+		t.Errorf("Expected InvalidDatabaseBackupError, got %v", err)
+	}
+}
+
+func TestWaitForDatabaseBackup(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/databases/12345/backups/b-1": {
+			`{"id": "b-1", "status": "Pending"}`,
+			`{"id": "b-1", "status": "Ready"}`,
+		},
+		"GET /v2/databases/12345/backups/b-2": {`{"id": "b-2", "status": "Failed"}`},
+	})
+	defer server.Close()
+
+	opts := WaitOptions{Timeout: time.Second, Interval: time.Millisecond}
+	got, err := client.WaitForDatabaseBackup("12345", "b-1", opts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if got.Status != DatabaseBackupStatusReady {
+		t.Errorf("Unexpected backup %+v", got)
+	}
+
+	if _, err := client.WaitForDatabaseBackup("12345", "b-2", opts); !errors.Is(err, InvalidDatabaseBackupError) {
+		t.Errorf("Expected InvalidDatabaseBackupError, got %v", err)
+	}
+}
+
+func TestRestoreDatabaseAndWait(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"POST /v2/databases/12345/restore": {`{"result": "success"}`},
+		"GET /v2/databases/12345": {
+			`{"id": "12345", "status": "Restoring"}`,
+			`{"id": "12345", "status": "Ready"}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.RestoreDatabaseAndWait("12345", &RestoreDatabaseRequest{Name: "restored", Backup: "nightly"}, WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if got.Status != DatabaseStatusReady {
+		t.Errorf("Unexpected database %+v", got)
+	}
+
+	if _, err := client.RestoreDatabase("12345", &RestoreDatabaseRequest{Name: "restored"}); !errors.Is(err, InvalidDatabaseBackupError) {
+		t.Errorf("Expected InvalidDatabaseBackupError, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -108,3 +109,27 @@ func TestDeleteDatabase(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestCreateDatabaseRequestValidate(t *testing.T) {
+	valid := []CreateDatabaseRequest{
+		{Name: "db", Size: "g3.db.xsmall", Software: DatabaseSoftwareMySQL},
+		{Name: "db", Size: "g3.db.xsmall", Software: "postgresql", Nodes: 3},
+	}
+	for _, req := range valid {
+		if err := req.Validate(); err != nil {
+			t.Errorf("Expected %+v to be valid, got %s", req, err)
+		}
+	}
+
+	invalid := []CreateDatabaseRequest{
+		{Size: "g3.db.xsmall", Software: DatabaseSoftwareMySQL},
+		{Name: "db", Software: DatabaseSoftwareMySQL},
+		{Name: "db", Size: "g3.db.xsmall", Software: "MongoDB"},
+		{Name: "db", Size: "g3.db.xsmall", Software: DatabaseSoftwareMySQL, Nodes: -1},
+	}
+	for _, req := range invalid {
+		if err := req.Validate(); !errors.Is(err, InvalidDatabaseConfigError) {
+			t.Errorf("Expected InvalidDatabaseConfigError for %+v, got %v", req, err)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package civogo
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// States a disk image goes through, custom images wait for an upload and are then
+// checked before they become available
+const (
+	DiskImageStateUploading = "uploading"
+	DiskImageStateAvailable = "available"
+	DiskImageStateFailed    = "failed"
+)
+
+// CreateDiskImageParams describes a custom disk image, the checksums and size are of the
+// image file that will be uploaded and are used to verify it once the upload finishes
+type CreateDiskImageParams struct {
+	Name         string `json:"name"`
+	Distribution string `json:"distribution"`
+	Version      string `json:"version"`
+	OS           string `json:"os,omitempty"`
+	ImageSHA256  string `json:"image_sha256"`
+	ImageMD5     string `json:"image_md5"`
+	ImageSize    int64  `json:"image_size_bytes"`
+	Region       string `json:"region"`
+}
+
+// CreateDiskImageResponse is returned when a custom disk image is created, the image
+// file needs to be uploaded to UploadURL before it expires
+type CreateDiskImageResponse struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Distribution string `json:"distribution"`
+	Version      string `json:"version"`
+	UploadURL    string `json:"upload_url"`
+}
+
+// Validate checks the image has a name, distribution and version and that the checksums look right
+func (p *CreateDiskImageParams) Validate() error {
+	if p.Name == "" || p.Distribution == "" || p.Version == "" {
+		err := fmt.Errorf("a name, distribution and version are needed")
+		return InvalidDiskImageError.wrap(err)
+	}
+	if !isHexDigest(p.ImageSHA256, sha256.Size) {
+		err := fmt.Errorf("%q isn't a valid SHA256 checksum", p.ImageSHA256)
+		return InvalidDiskImageError.wrap(err)
+	}
+	if !isHexDigest(p.ImageMD5, md5.Size) {
+		err := fmt.Errorf("%q isn't a valid MD5 checksum", p.ImageMD5)
+		return InvalidDiskImageError.wrap(err)
+	}
+	if p.ImageSize <= 0 {
+		err := fmt.Errorf("the image size must be more than zero, got %d", p.ImageSize)
+		return InvalidDiskImageError.wrap(err)
+	}
+
+	return nil
+}
+
+// isHexDigest reports whether s is a hex encoded digest of size bytes
+func isHexDigest(s string, size int) bool {
+	b, err := hex.DecodeString(s)
+	return err == nil && len(b) == size
+}
+
+// CreateDiskImage registers a custom disk image and returns the signed URL to upload it to
+func (c *Client) CreateDiskImage(params *CreateDiskImageParams) (*CreateDiskImageResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if params.Region == "" {
+		params.Region = c.Region
+	}
+
+	resp, err := c.SendPostRequest("/v2/disk_images", params)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	diskImage := &CreateDiskImageResponse{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(diskImage); err != nil {
+		return nil, err
+	}
+
+	return diskImage, nil
+}
+
+// UploadDiskImage sends the image file to the signed upload URL, the URL carries its own
+// authorisation so the API key isn't sent with it
+func (c *Client) UploadDiskImage(uploadURL string, image io.Reader, size int64) error {
+	req, err := http.NewRequest("PUT", uploadURL, image)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return decodeError(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		err := fmt.Errorf("uploading the image failed - status: %s, reason: %s", resp.Status, string(body))
+		return InvalidDiskImageError.wrap(err)
+	}
+
+	return nil
+}
+
+// FinalizeDiskImageUpload tells the API the image file has been uploaded so it can be checked
+func (c *Client) FinalizeDiskImageUpload(id string) (*SimpleResponse, error) {
+	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/disk_images/%s/finalize", id), map[string]string{
+		"region": c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
+// WaitForDiskImage polls a disk image until it's available, returning an error if it fails
+func (c *Client) WaitForDiskImage(id string, opts WaitOptions) (*DiskImage, error) {
+	var diskImage *DiskImage
+	err := waitFor(opts, func() (bool, error) {
+		var err error
+		diskImage, err = c.GetDiskImage(id)
+		if err != nil {
+			return false, err
+		}
+
+		if strings.EqualFold(diskImage.State, DiskImageStateFailed) {
+			err := fmt.Errorf("disk image %s failed verification", id)
+			return false, InvalidDiskImageError.wrap(err)
+		}
+		return strings.EqualFold(diskImage.State, DiskImageStateAvailable), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return diskImage, nil
+}
+
+// CreateDiskImageFromFile uploads an image file as a custom disk image and waits for it to be
+// available, the checksums and size in params are filled in from the file
+func (c *Client) CreateDiskImageFromFile(params *CreateDiskImageParams, path string, opts WaitOptions) (*DiskImage, error) {
+	sha, md, size, err := diskImageChecksums(path)
+	if err != nil {
+		return nil, err
+	}
+	params.ImageSHA256, params.ImageMD5, params.ImageSize = sha, md, size
+	if params.Name == "" {
+		params.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	created, err := c.CreateDiskImage(params)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if err := c.UploadDiskImage(created.UploadURL, f, size); err != nil {
+		return nil, err
+	}
+	if _, err := c.FinalizeDiskImageUpload(created.ID); err != nil {
+		return nil, err
+	}
+
+	return c.WaitForDiskImage(created.ID, opts)
+}
+
+// diskImageChecksums returns the hex SHA256 and MD5 checksums and the size of a file
+func diskImageChecksums(path string) (string, string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", "", 0, err
+	}
+	defer f.Close()
+
+	sha, md := sha256.New(), md5.New()
+	size, err := io.Copy(io.MultiWriter(sha, md), f)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	return hex.EncodeToString(sha.Sum(nil)), hex.EncodeToString(md.Sum(nil)), size, nil
+}
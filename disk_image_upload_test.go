@@ -0,0 +1,99 @@
+package civogo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCreateDiskImageParamsValidate(t *testing.T) {
+	valid := &CreateDiskImageParams{
+		Name:         "golden",
+		Distribution: "ubuntu",
+		Version:      "24.04",
+		ImageSHA256:  "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		ImageMD5:     "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		ImageSize:    11,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+
+	noName := *valid
+	noName.Name = ""
+	badSHA := *valid
+	badSHA.ImageSHA256 = "5eb63bbbe01eeed093cb22bb8f5acdc3"
+	badMD5 := *valid
+	badMD5.ImageMD5 = "not hex"
+	noSize := *valid
+	noSize.ImageSize = 0
+
+	for _, params := range []*CreateDiskImageParams{&noName, &badSHA, &badMD5, &noSize} {
+		if err := params.Validate(); !errors.Is(err, InvalidDiskImageError) {
+			t.Errorf("Expected InvalidDiskImageError, got %v", err)
+		}
+	}
+}
+
+func TestCreateDiskImageFromFile(t *testing.T) {
+	var uploaded string
+	upload := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "" {
+			rw.WriteHeader(http.StatusForbidden)
+			return
+		}
+		body, _ := io.ReadAll(req.Body)
+		uploaded = string(body)
+	}))
+	defer upload.Close()
+
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"POST /v2/disk_images":                {fmt.Sprintf(`{"id": "img-1", "name": "golden", "distribution": "ubuntu", "version": "24.04", "upload_url": "%s/img-1"}`, upload.URL)},
+		"POST /v2/disk_images/img-1/finalize": {`{"result": "success"}`},
+		"GET /v2/disk_images/img-1": {
+			`{"id": "img-1", "name": "golden", "state": "uploading"}`,
+			`{"id": "img-1", "name": "golden", "state": "available"}`,
+		},
+	})
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "golden.qcow2")
+	if err := os.WriteFile(path, []byte("hello world"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	params := &CreateDiskImageParams{Distribution: "ubuntu", Version: "24.04"}
+	got, err := client.CreateDiskImageFromFile(params, path, WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.State != DiskImageStateAvailable {
+		t.Errorf("Expected the image to be available, got %s", got.State)
+	}
+	if uploaded != "hello world" {
+		t.Errorf("Expected the file to be uploaded, got %q", uploaded)
+	}
+	if params.Name != "golden" || params.ImageSize != 11 || params.ImageMD5 != "5eb63bbbe01eeed093cb22bb8f5acdc3" {
+		t.Errorf("Unexpected params %+v", params)
+	}
+}
+
+func TestWaitForDiskImageFailed(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/disk_images/img-1": {`{"id": "img-1", "name": "golden", "state": "failed"}`},
+	})
+	defer server.Close()
+
+	_, err := client.WaitForDiskImage("img-1", WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if !errors.Is(err, InvalidDiskImageError) {
+		t.Errorf("Expected InvalidDiskImageError, got %v", err)
+	}
+}
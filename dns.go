@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -24,7 +26,7 @@ type dnsDomainConfig struct {
 	Name string `json:"name"`
 }
 
-// DNSRecordType represents the allowed record types: a, cname, mx or txt
+// DNSRecordType represents the allowed record types: A, AAAA, CNAME, ALIAS, MX, SRV, TXT, NS or CAA
 type DNSRecordType string
 
 // DNSRecord represents a DNS record registered within Civo's infrastructure
@@ -54,22 +56,31 @@ type DNSRecordConfig struct {
 
 const (
 	// DNSRecordTypeA represents an A record
-	DNSRecordTypeA = "A"
+	DNSRecordTypeA DNSRecordType = "A"
+
+	// DNSRecordTypeAAAA represents an AAAA record
+	DNSRecordTypeAAAA DNSRecordType = "AAAA"
 
 	// DNSRecordTypeCName represents an CNAME record
-	DNSRecordTypeCName = "CNAME"
+	DNSRecordTypeCName DNSRecordType = "CNAME"
+
+	// DNSRecordTypeAlias represents an ALIAS record, a CNAME that can be used at the zone apex
+	DNSRecordTypeAlias DNSRecordType = "ALIAS"
 
 	// DNSRecordTypeMX represents an MX record
-	DNSRecordTypeMX = "MX"
+	DNSRecordTypeMX DNSRecordType = "MX"
 
 	// DNSRecordTypeSRV represents an SRV record
-	DNSRecordTypeSRV = "SRV"
+	DNSRecordTypeSRV DNSRecordType = "SRV"
 
 	// DNSRecordTypeTXT represents an TXT record
-	DNSRecordTypeTXT = "TXT"
+	DNSRecordTypeTXT DNSRecordType = "TXT"
 
 	// DNSRecordTypeNS represents an NS record
-	DNSRecordTypeNS = "NS"
+	DNSRecordTypeNS DNSRecordType = "NS"
+
+	// DNSRecordTypeCAA represents a CAA record
+	DNSRecordTypeCAA DNSRecordType = "CAA"
 )
 
 var (
@@ -80,6 +91,109 @@ var (
 	ErrDNSRecordNotFound = fmt.Errorf("record not found")
 )
 
+// Validate checks the record's value matches its type. As every field is optional, a
+// config without a type (e.g. an update that only renames the record) isn't type checked.
+// MX and SRV records need a priority, SRV values are "weight port target" and CAA values
+// are "flags tag value"
+func (r *DNSRecordConfig) Validate() error {
+	if r.TTL < 0 {
+		err := fmt.Errorf("the TTL can't be negative")
+		return InvalidDNSRecordError.wrap(err)
+	}
+	if r.Priority < 0 || r.Priority > 65535 {
+		err := fmt.Errorf("invalid priority %d", r.Priority)
+		return InvalidDNSRecordError.wrap(err)
+	}
+
+	switch r.Type {
+	case "":
+		return nil
+	case DNSRecordTypeA, DNSRecordTypeAAAA:
+		ip := net.ParseIP(r.Value)
+		if ip == nil || (r.Type == DNSRecordTypeA) != (ip.To4() != nil) {
+			err := fmt.Errorf("%q isn't a valid value for an %s record", r.Value, r.Type)
+			return InvalidDNSRecordError.wrap(err)
+		}
+	case DNSRecordTypeCName:
+		if r.Name == "@" {
+			err := fmt.Errorf("a CNAME record can't be created at the zone apex, use an ALIAS record instead")
+			return ParameterDNSRecordCnameApexError.wrap(err)
+		}
+		return validateDNSRecordValue(r)
+	case DNSRecordTypeAlias, DNSRecordTypeNS, DNSRecordTypeTXT:
+		return validateDNSRecordValue(r)
+	case DNSRecordTypeMX:
+		if r.Priority == 0 {
+			err := fmt.Errorf("MX records need a priority")
+			return InvalidDNSRecordError.wrap(err)
+		}
+		return validateDNSRecordValue(r)
+	case DNSRecordTypeSRV:
+		if r.Priority == 0 {
+			err := fmt.Errorf("SRV records need a priority")
+			return InvalidDNSRecordError.wrap(err)
+		}
+		return validateSRVValue(r.Value)
+	case DNSRecordTypeCAA:
+		return validateCAAValue(r.Value)
+	default:
+		err := fmt.Errorf("unknown record type %q", r.Type)
+		return ParameterDNSRecordTypeError.wrap(err)
+	}
+
+	return nil
+}
+
+func validateDNSRecordValue(r *DNSRecordConfig) error {
+	if strings.TrimSpace(r.Value) == "" {
+		err := fmt.Errorf("%s records need a value", r.Type)
+		return InvalidDNSRecordError.wrap(err)
+	}
+	return nil
+}
+
+// validateSRVValue checks an SRV value is made of a weight, a port and a target
+func validateSRVValue(value string) error {
+	fields := strings.Fields(value)
+	if len(fields) != 3 {
+		err := fmt.Errorf("SRV value %q should be \"weight port target\"", value)
+		return InvalidDNSRecordError.wrap(err)
+	}
+	if _, err := strconv.ParseUint(fields[0], 10, 16); err != nil {
+		err := fmt.Errorf("invalid SRV weight %q", fields[0])
+		return InvalidDNSRecordError.wrap(err)
+	}
+	if _, err := strconv.ParseUint(fields[1], 10, 16); err != nil {
+		err := fmt.Errorf("invalid SRV port %q", fields[1])
+		return InvalidDNSRecordError.wrap(err)
+	}
+	return nil
+}
+
+// validateCAAValue checks a CAA value is made of flags, one of the tags defined in RFC 8659 and a value
+func validateCAAValue(value string) error {
+	fields := strings.SplitN(strings.TrimSpace(value), " ", 3)
+	if len(fields) != 3 || strings.TrimSpace(fields[2]) == "" {
+		err := fmt.Errorf("CAA value %q should be \"flags tag value\"", value)
+		return InvalidDNSRecordError.wrap(err)
+	}
+
+	flags, err := strconv.ParseUint(fields[0], 10, 8)
+	if err != nil || (flags != 0 && flags != 128) {
+		err := fmt.Errorf("invalid CAA flags %q, only 0 and 128 (critical) are defined", fields[0])
+		return InvalidDNSRecordError.wrap(err)
+	}
+
+	switch fields[1] {
+	case "issue", "issuewild", "iodef":
+	default:
+		err := fmt.Errorf("invalid CAA tag %q, valid tags are issue, issuewild and iodef", fields[1])
+		return InvalidDNSRecordError.wrap(err)
+	}
+
+	return nil
+}
+
 // ListDNSDomains returns all Domains owned by the calling API account
 func (c *Client) ListDNSDomains() ([]DNSDomain, error) {
 	url := "/v2/dns"
@@ -199,6 +313,10 @@ func (c *Client) CreateDNSRecord(domainID string, r *DNSRecordConfig) (*DNSRecor
 		return nil, fmt.Errorf("r.DomainID is empty")
 	}
 
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
 	url := fmt.Sprintf("/v2/dns/%s/records", domainID)
 	body, err := c.SendPostRequest(url, r)
 	if err != nil {
@@ -248,6 +366,10 @@ func (c *Client) GetDNSRecord(domainID, domainRecordID string) (*DNSRecord, erro
 
 // UpdateDNSRecord updates the DNS record
 func (c *Client) UpdateDNSRecord(r *DNSRecord, rc *DNSRecordConfig) (*DNSRecord, error) {
+	if err := rc.Validate(); err != nil {
+		return nil, err
+	}
+
 	url := fmt.Sprintf("/v2/dns/%s/records/%s", r.DNSDomainID, r.ID)
 	body, err := c.SendPutRequest(url, rc)
 	if err != nil {
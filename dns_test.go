@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -310,3 +311,49 @@ func TestGetRecord(t *testing.T) {
 		return
 	}
 }
+
+func TestDNSRecordConfigValidate(t *testing.T) {
+	valid := []DNSRecordConfig{
+		{Name: "email"},
+		{Type: DNSRecordTypeA, Name: "www", Value: "10.0.0.1"},
+		{Type: DNSRecordTypeAAAA, Name: "www", Value: "2001:db8::1"},
+		{Type: DNSRecordTypeAlias, Name: "@", Value: "lb.example.com"},
+		{Type: DNSRecordTypeMX, Name: "@", Value: "mail.example.com", Priority: 10},
+		{Type: DNSRecordTypeSRV, Name: "_sip._tcp", Value: "5 5060 sip.example.com", Priority: 10},
+		{Type: DNSRecordTypeCAA, Name: "@", Value: `0 issue "letsencrypt.org"`},
+		{Type: DNSRecordTypeNS, Name: "sub", Value: "ns1.example.com"},
+	}
+	for _, cfg := range valid {
+		if err := cfg.Validate(); err != nil {
+			t.Errorf("Expected %+v to be valid, got %s", cfg, err)
+		}
+	}
+
+	invalid := []DNSRecordConfig{
+		{Type: "PTR", Name: "www", Value: "example.com"},
+		{Type: DNSRecordTypeA, Name: "www", Value: "2001:db8::1"},
+		{Type: DNSRecordTypeMX, Name: "@", Value: "mail.example.com"},
+		{Type: DNSRecordTypeSRV, Name: "_sip._tcp", Value: "sip.example.com", Priority: 10},
+		{Type: DNSRecordTypeSRV, Name: "_sip._tcp", Value: "5 5060 sip.example.com"},
+		{Type: DNSRecordTypeCAA, Name: "@", Value: `1 issue "letsencrypt.org"`},
+		{Type: DNSRecordTypeCAA, Name: "@", Value: `0 issuer "letsencrypt.org"`},
+		{Type: DNSRecordTypeCName, Name: "@", Value: "example.com"},
+		{Type: DNSRecordTypeNS, Name: "sub"},
+		{Type: DNSRecordTypeTXT, Name: "www", Value: "v=spf1", TTL: -1},
+	}
+	for _, cfg := range invalid {
+		if err := cfg.Validate(); err == nil {
+			t.Errorf("Expected %+v to be invalid", cfg)
+		}
+	}
+}
+
+func TestCreateDNSRecordInvalid(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{})
+	defer server.Close()
+
+	_, err := client.CreateDNSRecord("12346", &DNSRecordConfig{Type: DNSRecordTypeMX, Name: "mail", Value: "mail.example.com"})
+	if !errors.Is(err, InvalidDNSRecordError) {
+		t.Errorf("Expected InvalidDNSRecordError, got %v", err)
+	}
+}
@@ -0,0 +1,474 @@
+package civogo
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// dnsZoneToken is a single field of a zone file line, quoted strings are kept apart
+// so TXT and CAA values can be told from names
+type dnsZoneToken struct {
+	text   string
+	quoted bool
+}
+
+// dnsZoneLine is a logical zone file line, after joining parentheses and removing comments
+type dnsZoneLine struct {
+	number     int
+	blankOwner bool
+	tokens     []dnsZoneToken
+}
+
+// ParseDNSZone reads an RFC 1035 zone file for the given domain and returns the records in
+// it as configs ready to be created. Names are made relative to the domain, with "@" for the
+// apex. SOA and apex NS records are skipped as Civo manages them for every domain
+func ParseDNSZone(domain string, zonefile io.Reader) ([]DNSRecordConfig, error) {
+	lines, err := tokenizeDNSZone(zonefile)
+	if err != nil {
+		return nil, InvalidDNSZoneError.wrap(err)
+	}
+
+	zone := strings.ToLower(strings.TrimSuffix(domain, ".")) + "."
+	origin := zone
+	var defaultTTL int
+	var owner string
+	records := make([]DNSRecordConfig, 0)
+
+	for _, line := range lines {
+		tokens := line.tokens
+		switch strings.ToUpper(tokens[0].text) {
+		case "$ORIGIN":
+			if len(tokens) < 2 {
+				return nil, dnsZoneError(line, "$ORIGIN needs a domain")
+			}
+			origin = dnsZoneFQDN(tokens[1].text, origin)
+			continue
+		case "$TTL":
+			if len(tokens) < 2 {
+				return nil, dnsZoneError(line, "$TTL needs a value")
+			}
+			if defaultTTL, err = parseDNSZoneTTL(tokens[1].text); err != nil {
+				return nil, dnsZoneError(line, err.Error())
+			}
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, dnsZoneError(line, fmt.Sprintf("%s isn't supported", tokens[0].text))
+		}
+
+		if !line.blankOwner {
+			owner = dnsZoneFQDN(tokens[0].text, origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, dnsZoneError(line, "the first record has no name")
+		}
+
+		ttl := defaultTTL
+		var recordType string
+		for len(tokens) > 0 && recordType == "" {
+			field := strings.ToUpper(tokens[0].text)
+			tokens = tokens[1:]
+			switch {
+			case field == "IN":
+			case field == "CH" || field == "HS":
+				return nil, dnsZoneError(line, fmt.Sprintf("class %s isn't supported", field))
+			case field != "" && field[0] >= '0' && field[0] <= '9':
+				if ttl, err = parseDNSZoneTTL(field); err != nil {
+					return nil, dnsZoneError(line, err.Error())
+				}
+			default:
+				recordType = field
+			}
+		}
+		if recordType == "" {
+			return nil, dnsZoneError(line, "the record has no type")
+		}
+
+		name, ok := dnsZoneRelativeName(owner, zone)
+		if !ok {
+			return nil, dnsZoneError(line, fmt.Sprintf("%s isn't part of %s", owner, zone))
+		}
+
+		record, skip, err := parseDNSZoneRecord(recordType, name, tokens, origin)
+		if err != nil {
+			return nil, dnsZoneError(line, err.Error())
+		}
+		if skip {
+			continue
+		}
+		record.TTL = ttl
+
+		if err := record.Validate(); err != nil {
+			return nil, dnsZoneError(line, err.Error())
+		}
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+// parseDNSZoneRecord converts the record data of a zone file line to a record config, skip
+// is true for records Civo manages itself
+func parseDNSZoneRecord(recordType, name string, data []dnsZoneToken, origin string) (record *DNSRecordConfig, skip bool, err error) {
+	need := func(n int) error {
+		if len(data) < n {
+			return fmt.Errorf("%s record needs %d fields, got %d", recordType, n, len(data))
+		}
+		return nil
+	}
+	host := func(value string) string {
+		return strings.TrimSuffix(dnsZoneFQDN(value, origin), ".")
+	}
+
+	record = &DNSRecordConfig{Type: DNSRecordType(recordType), Name: name}
+	switch record.Type {
+	case "SOA":
+		return nil, true, nil
+	case DNSRecordTypeNS:
+		if name == "@" {
+			return nil, true, nil
+		}
+		if err := need(1); err != nil {
+			return nil, false, err
+		}
+		record.Value = host(data[0].text)
+	case DNSRecordTypeA, DNSRecordTypeAAAA:
+		if err := need(1); err != nil {
+			return nil, false, err
+		}
+		record.Value = data[0].text
+	case DNSRecordTypeCName, DNSRecordTypeAlias:
+		if err := need(1); err != nil {
+			return nil, false, err
+		}
+		record.Value = host(data[0].text)
+	case DNSRecordTypeMX:
+		if err := need(2); err != nil {
+			return nil, false, err
+		}
+		if record.Priority, err = strconv.Atoi(data[0].text); err != nil {
+			return nil, false, fmt.Errorf("invalid MX priority %q", data[0].text)
+		}
+		record.Value = host(data[1].text)
+	case DNSRecordTypeSRV:
+		if err := need(4); err != nil {
+			return nil, false, err
+		}
+		if record.Priority, err = strconv.Atoi(data[0].text); err != nil {
+			return nil, false, fmt.Errorf("invalid SRV priority %q", data[0].text)
+		}
+		record.Value = fmt.Sprintf("%s %s %s", data[1].text, data[2].text, host(data[3].text))
+	case DNSRecordTypeTXT:
+		var value strings.Builder
+		for _, token := range data {
+			value.WriteString(token.text)
+		}
+		record.Value = value.String()
+	case DNSRecordTypeCAA:
+		if err := need(3); err != nil {
+			return nil, false, err
+		}
+		record.Value = fmt.Sprintf("%s %s %s", data[0].text, data[1].text, strconv.Quote(data[2].text))
+	default:
+		return nil, false, fmt.Errorf("record type %s isn't supported", recordType)
+	}
+
+	return record, false, nil
+}
+
+// WriteDNSZone writes the records of a domain out as an RFC 1035 zone file, sorted by name and type
+func WriteDNSZone(w io.Writer, domain string, records []DNSRecord) error {
+	sorted := make([]DNSRecord, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Type < sorted[j].Type
+	})
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "$ORIGIN %s.\n", strings.TrimSuffix(domain, "."))
+
+	for _, record := range sorted {
+		name := record.Name
+		if name == "" {
+			name = "@"
+		}
+
+		ttl := ""
+		if record.TTL > 0 {
+			ttl = strconv.Itoa(record.TTL)
+		}
+
+		var data string
+		switch record.Type {
+		case DNSRecordTypeCName, DNSRecordTypeAlias, DNSRecordTypeNS:
+			data = dnsZoneAbsolute(record.Value)
+		case DNSRecordTypeMX:
+			data = fmt.Sprintf("%d %s", record.Priority, dnsZoneAbsolute(record.Value))
+		case DNSRecordTypeSRV:
+			fields := strings.Fields(record.Value)
+			if len(fields) > 0 {
+				fields[len(fields)-1] = dnsZoneAbsolute(fields[len(fields)-1])
+			}
+			data = fmt.Sprintf("%d %s", record.Priority, strings.Join(fields, " "))
+		case DNSRecordTypeTXT:
+			data = quoteDNSZoneText(record.Value)
+		default:
+			data = record.Value
+		}
+
+		fmt.Fprintf(bw, "%s\t%s\tIN\t%s\t%s\n", name, ttl, record.Type, data)
+	}
+
+	return bw.Flush()
+}
+
+// ImportDNSZone creates every record of an RFC 1035 zone file in the domain. The whole file
+// is parsed and validated before any record is created; if creating a record fails, the
+// records created so far are returned with the error
+func (c *Client) ImportDNSZone(domainID string, zonefile io.Reader) ([]DNSRecord, error) {
+	domain, err := c.getDNSDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := ParseDNSZone(domain.Name, zonefile)
+	if err != nil {
+		return nil, err
+	}
+
+	created := make([]DNSRecord, 0, len(configs))
+	for i := range configs {
+		record, err := c.CreateDNSRecord(domain.ID, &configs[i])
+		if err != nil {
+			return created, err
+		}
+		created = append(created, *record)
+	}
+
+	return created, nil
+}
+
+// ExportDNSZone returns all the records of the domain as an RFC 1035 zone file
+func (c *Client) ExportDNSZone(domainID string) ([]byte, error) {
+	domain, err := c.getDNSDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.ListDNSRecords(domain.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDNSZone(&buf, domain.Name, records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c *Client) getDNSDomainByID(id string) (*DNSDomain, error) {
+	domains, err := c.ListDNSDomains()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, domain := range domains {
+		if domain.ID == id {
+			return &domain, nil
+		}
+	}
+
+	return nil, ErrDNSDomainNotFound
+}
+
+// tokenizeDNSZone splits a zone file into logical lines, removing comments and joining
+// lines wrapped in parentheses
+func tokenizeDNSZone(zonefile io.Reader) ([]dnsZoneLine, error) {
+	content, err := io.ReadAll(zonefile)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]dnsZoneLine, 0)
+	number, depth := 1, 0
+	current := dnsZoneLine{number: 1}
+	var token strings.Builder
+	inToken, inQuote, atLineStart := false, false, true
+
+	endToken := func(quoted bool) {
+		if inToken || quoted {
+			current.tokens = append(current.tokens, dnsZoneToken{text: token.String(), quoted: quoted})
+		}
+		token.Reset()
+		inToken = false
+	}
+
+	for i := 0; i < len(content); i++ {
+		ch := content[i]
+
+		if inQuote {
+			switch ch {
+			case '\\':
+				if i+1 < len(content) {
+					i++
+					token.WriteByte(content[i])
+				}
+			case '"':
+				inQuote = false
+				endToken(true)
+			case '\n':
+				return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+			default:
+				token.WriteByte(ch)
+			}
+			continue
+		}
+
+		if atLineStart && depth == 0 {
+			current.blankOwner = ch == ' ' || ch == '\t'
+			atLineStart = false
+		}
+
+		switch ch {
+		case ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case '"':
+			endToken(false)
+			inQuote = true
+		case '(':
+			endToken(false)
+			depth++
+		case ')':
+			endToken(false)
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+			}
+			depth--
+		case ' ', '\t', '\r':
+			endToken(false)
+		case '\n':
+			endToken(false)
+			number++
+			if depth == 0 {
+				if len(current.tokens) > 0 {
+					lines = append(lines, current)
+				}
+				current = dnsZoneLine{number: number}
+				atLineStart = true
+			}
+		default:
+			token.WriteByte(ch)
+			inToken = true
+		}
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+	}
+	endToken(false)
+	if len(current.tokens) > 0 {
+		lines = append(lines, current)
+	}
+
+	return lines, nil
+}
+
+// parseDNSZoneTTL parses a TTL in seconds, or with BIND style units such as 1h30m
+func parseDNSZoneTTL(value string) (int, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return seconds, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, number := 0, ""
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+		if ch >= '0' && ch <= '9' {
+			number += string(ch)
+			continue
+		}
+
+		multiplier, ok := units[ch|0x20]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		n, _ := strconv.Atoi(number)
+		total += n * multiplier
+		number = ""
+	}
+	if number != "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+
+	return total, nil
+}
+
+// dnsZoneFQDN makes a zone file name absolute, with a trailing dot
+func dnsZoneFQDN(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + origin
+	}
+}
+
+// dnsZoneRelativeName returns the name of an absolute owner within the zone, "@" for the apex
+func dnsZoneRelativeName(owner, zone string) (string, bool) {
+	if owner == zone {
+		return "@", true
+	}
+	if strings.HasSuffix(owner, "."+zone) {
+		return strings.TrimSuffix(owner, "."+zone), true
+	}
+	return "", false
+}
+
+// dnsZoneAbsolute adds the trailing dot zone files use for fully qualified names
+func dnsZoneAbsolute(host string) string {
+	if host == "" || strings.HasSuffix(host, ".") {
+		return host
+	}
+	return host + "."
+}
+
+// quoteDNSZoneText quotes a TXT value, splitting it into the 255 byte strings zone files allow
+func quoteDNSZoneText(value string) string {
+	if value == "" {
+		return `""`
+	}
+
+	chunks := make([]string, 0, len(value)/255+1)
+	for len(value) > 0 {
+		n := len(value)
+		if n > 255 {
+			n = 255
+		}
+		escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value[:n])
+		chunks = append(chunks, `"`+escaped+`"`)
+		value = value[n:]
+	}
+
+	return strings.Join(chunks, " ")
+}
+
+func dnsZoneError(line dnsZoneLine, message string) error {
+	err := fmt.Errorf("line %d: %s", line.number, message)
+	return InvalidDNSZoneError.wrap(err)
+}
@@ -0,0 +1,136 @@
+package civogo
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. admin.example.com. (
+		2024010101 ; serial
+		7200       ; refresh
+		3600 1209600 3600 )
+@		IN	NS	ns1.other-provider.net.
+@	300	IN	A	192.0.2.1
+	300	IN	MX	10 mail
+www		IN	CNAME	example.com.
+_sip._tcp	IN	SRV	10 5 5060 sip.example.com.
+@		IN	TXT	"v=spf1 include:_spf.example.com" " ~all" ; spf
+@		IN	CAA	0 issue "letsencrypt.org"
+$ORIGIN dev.example.com.
+api	60	IN	AAAA	2001:db8::1
+`
+
+func TestParseDNSZone(t *testing.T) {
+	got, err := ParseDNSZone("example.com", strings.NewReader(testZoneFile))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []DNSRecordConfig{
+		{Type: DNSRecordTypeA, Name: "@", Value: "192.0.2.1", TTL: 300},
+		{Type: DNSRecordTypeMX, Name: "@", Value: "mail.example.com", Priority: 10, TTL: 300},
+		{Type: DNSRecordTypeCName, Name: "www", Value: "example.com", TTL: 3600},
+		{Type: DNSRecordTypeSRV, Name: "_sip._tcp", Value: "5 5060 sip.example.com", Priority: 10, TTL: 3600},
+		{Type: DNSRecordTypeTXT, Name: "@", Value: "v=spf1 include:_spf.example.com ~all", TTL: 3600},
+		{Type: DNSRecordTypeCAA, Name: "@", Value: `0 issue "letsencrypt.org"`, TTL: 3600},
+		{Type: DNSRecordTypeAAAA, Name: "api.dev", Value: "2001:db8::1", TTL: 60},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestParseDNSZoneInvalid(t *testing.T) {
+	zones := []string{
+		"www IN PTR example.com.\n",
+		"www.other.com. IN A 192.0.2.1\n",
+		"@ IN MX mail.example.com.\n",
+		"@ IN TXT \"unterminated\n",
+		"\tIN A 192.0.2.1\n",
+		"$INCLUDE other.zone\n",
+	}
+	for _, zone := range zones {
+		if _, err := ParseDNSZone("example.com", strings.NewReader(zone)); !errors.Is(err, InvalidDNSZoneError) {
+			t.Errorf("Expected InvalidDNSZoneError for %q, got %v", zone, err)
+		}
+	}
+}
+
+func TestWriteDNSZoneRoundTrip(t *testing.T) {
+	records := []DNSRecord{
+		{Name: "www", Type: DNSRecordTypeCName, Value: "example.com", TTL: 600},
+		{Name: "@", Type: DNSRecordTypeMX, Value: "mail.example.com", Priority: 10, TTL: 600},
+		{Name: "@", Type: DNSRecordTypeTXT, Value: `v=spf1 "quoted" ` + strings.Repeat("x", 300), TTL: 600},
+		{Name: "_sip._tcp", Type: DNSRecordTypeSRV, Value: "5 5060 sip.example.com", Priority: 20, TTL: 600},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDNSZone(&buf, "example.com", records); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !strings.HasPrefix(buf.String(), "$ORIGIN example.com.\n") {
+		t.Errorf("Expected the zone to start with $ORIGIN, got %q", buf.String())
+	}
+
+	got, err := ParseDNSZone("example.com", &buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(got) != len(records) {
+		t.Fatalf("Expected %d records, got %+v", len(records), got)
+	}
+	for _, record := range records {
+		found := false
+		for _, cfg := range got {
+			if cfg.Name == record.Name && cfg.Type == record.Type && cfg.Value == record.Value && cfg.Priority == record.Priority && cfg.TTL == record.TTL {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Record %+v didn't survive the round trip, got %+v", record, got)
+		}
+	}
+}
+
+func TestExportDNSZone(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/dns":               {`[{"id": "12345", "account_id": "1", "name": "example.com"}]`},
+		"GET /v2/dns/12345/records": {`[{"id": "1", "domain_id": "12345", "name": "www", "value": "192.0.2.1", "type": "A", "ttl": 600}]`},
+	})
+	defer server.Close()
+
+	got, err := client.ExportDNSZone("12345")
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+
+	expected := "$ORIGIN example.com.\nwww\t600\tIN\tA\t192.0.2.1\n"
+	if string(got) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(got))
+	}
+}
+
+func TestImportDNSZone(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/dns":                {`[{"id": "12345", "account_id": "1", "name": "example.com"}]`},
+		"POST /v2/dns/12345/records": {`{"id": "1", "domain_id": "12345", "name": "www", "value": "192.0.2.1", "type": "A", "ttl": 600}`},
+	})
+	defer server.Close()
+
+	got, err := client.ImportDNSZone("12345", strings.NewReader("www 600 IN A 192.0.2.1\n"))
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	if len(got) != 1 || got[0].ID != "1" {
+		t.Errorf("Unexpected records %+v", got)
+	}
+
+	if _, err := client.ImportDNSZone("missing", strings.NewReader("")); !errors.Is(err, ErrDNSDomainNotFound) {
+		t.Errorf("Expected ErrDNSDomainNotFound, got %v", err)
+	}
+}
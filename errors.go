@@ -19,6 +19,7 @@ var (
 	IDisEmptyError            = constError("IDisEmptyError")
 	TimeoutError              = constError("TimeoutError")
 	RegionUnavailableError    = constError("RegionUnavailable")
+	UnsupportedFilterError    = constError("UnsupportedFilterError")
 
 	CivoStatsdRecordFailedError = constError("CivoStatsdRecordFailedError")
 	AuthenticationFailedError   = constError("AuthenticationFailedError")
@@ -29,6 +30,82 @@ var (
 	CannotRestoreNewVolumeError             = constError("CannotRestoreNewVolumeError")
 	CannotScaleAlreadyRescalingClusterError = constError("CannotScaleAlreadyRescalingClusterError")
 	VolumeInvalidSizeError                  = constError("VolumeInvalidSizeError")
+	VolumeNotBootableError                  = constError("VolumeNotBootableError")
+	VolumeAlreadyAttachedError              = constError("VolumeAlreadyAttachedError")
+	VolumeRestoreNotConfirmedError          = constError("VolumeRestoreNotConfirmedError")
+
+	// Instance Error
+	InstanceFailedError        = constError("InstanceFailedError")
+	InstanceInvalidSizeError   = constError("InstanceInvalidSizeError")
+	DiskImageNotFoundError     = constError("DiskImageNotFoundError")
+	InvalidInstanceConfigError = constError("InvalidInstanceConfigError")
+
+	// Snapshot Schedule Error
+	InvalidSnapshotScheduleError = constError("InvalidSnapshotScheduleError")
+
+	// Kubernetes Error
+	KubernetesClusterNotReadyError         = constError("KubernetesClusterNotReadyError")
+	InvalidKubeconfigError                 = constError("InvalidKubeconfigError")
+	KubernetesPoolInvalidAutoscalingError  = constError("KubernetesPoolInvalidAutoscalingError")
+	InvalidKubernetesPoolError             = constError("InvalidKubernetesPoolError")
+	KubernetesClusterInvalidTypeError      = constError("KubernetesClusterInvalidTypeError")
+	KubernetesClusterInvalidCNIPluginError = constError("KubernetesClusterInvalidCNIPluginError")
+	InvalidKubernetesBackupError           = constError("InvalidKubernetesBackupError")
+	KubernetesClusterInvalidVersionError   = constError("KubernetesClusterInvalidVersionError")
+	KubernetesClusterAlreadyAtVersionError = constError("KubernetesClusterAlreadyAtVersionError")
+
+	// Network Error
+	InvalidNetworkConfigError  = constError("InvalidNetworkConfigError")
+	InvalidNetworkPeeringError = constError("InvalidNetworkPeeringError")
+
+	// Database Error
+	InvalidDatabaseConfigError = constError("InvalidDatabaseConfigError")
+	InvalidDatabaseBackupError = constError("InvalidDatabaseBackupError")
+
+	// DNS Error
+	InvalidDNSRecordError = constError("InvalidDNSRecordError")
+	InvalidDNSZoneError   = constError("InvalidDNSZoneError")
+
+	// ObjectStore Error
+	InvalidObjectStoreSizeError = constError("InvalidObjectStoreSizeError")
+
+	// SSH Key Error
+	InvalidSSHKeyError = constError("InvalidSSHKeyError")
+
+	// Cleanup Error
+	InvalidCleanupError       = constError("InvalidCleanupError")
+	InvalidResourceGraphError = constError("InvalidResourceGraphError")
+	InvalidResourceSpecError  = constError("InvalidResourceSpecError")
+	UnknownResourceTypeError  = constError("UnknownResourceTypeError")
+
+	// Region Error
+	UnknownRegionFeatureError = constError("UnknownRegionFeatureError")
+
+	// Charge Error
+	InvalidChargePeriodError = constError("InvalidChargePeriodError")
+
+	// Quota Error
+	UnknownQuotaResourceError = constError("UnknownQuotaResourceError")
+
+	// Role Error
+	InvalidPermissionError = constError("InvalidPermissionError")
+
+	// API Key Error
+	InvalidAPIKeyError = constError("InvalidAPIKeyError")
+
+	// DiskImage Error
+	InvalidDiskImageError = constError("InvalidDiskImageError")
+
+	// LoadBalancer Error
+	InvalidLoadBalancerConfigError      = constError("InvalidLoadBalancerConfigError")
+	InvalidLoadBalancerCertificateError = constError("InvalidLoadBalancerCertificateError")
+
+	// Webhook Error
+	InvalidWebhookConfigError    = constError("InvalidWebhookConfigError")
+	InvalidWebhookSignatureError = constError("InvalidWebhookSignatureError")
+
+	// Transport Error
+	InvalidTransportOptionsError = constError("InvalidTransportOptionsError")
 
 	DatabaseAccountDestroyError      = constError("DatabaseAccountDestroyError")
 	DatabaseAccountNotFoundError     = constError("DatabaseAccountNotFoundError")
@@ -76,6 +153,7 @@ var (
 	DatabaseFirewallRulesFindError        = constError("DatabaseFirewallRulesFindError")
 	DatabaseListingFirewallsError         = constError("DatabaseListingFirewallsError")
 	FirewallDuplicateError                = constError("FirewallDuplicateError")
+	InvalidFirewallRuleError              = constError("InvalidFirewallRuleError")
 
 	// Instances Errors
 	DatabaseInstanceAlreadyinRescueStateError              = constError("DatabaseInstanceAlreadyinRescueStateError")
@@ -337,7 +415,7 @@ func decodeError(err error) error {
 			err := fmt.Errorf("DNS error")
 			return TimeoutError.wrap(err)
 		}
-	case wrapError:
+	case wrapError, *QuotaExceededError:
 		return err
 	case HTTPError:
 		errorData := err
@@ -921,8 +999,7 @@ func decodeError(err error) error {
 			err := errors.New(msg.String())
 			return ParameterValueMissingError.wrap(err)
 		case "quota_limit_reached":
-			err := errors.New(msg.String())
-			return QuotaLimitReachedError.wrap(err)
+			return &QuotaExceededError{Reason: msg.String()}
 		case "sshkey_duplicate":
 			err := errors.New(msg.String())
 			return SSHKeyDuplicateError.wrap(err)
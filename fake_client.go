@@ -1,11 +1,19 @@
 package civogo
 
 import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/civo/civogo/netutil"
+	"github.com/civo/civogo/utils"
+	"golang.org/x/mod/semver"
 )
 
 // FakeClient is a temporary storage structure for use when you don't want to communicate with a real Civo API server
@@ -19,27 +27,48 @@ type FakeClient struct {
 	InstanceSizes           []InstanceSize
 	Instances               []Instance
 	Clusters                []KubernetesCluster
+	ClusterBackups          []KubernetesClusterBackup
 	IP                      []IP
 	Networks                []Network
+	Subnets                 []Subnet
+	ObjectStores            []ObjectStore
+	ObjectStoreCredentials  []ObjectStoreCredential
+	Databases               []Database
+	DatabaseBackups         []DatabaseBackup
+	NetworkPeerings         []NetworkPeering
 	Volumes                 []Volume
 	VolumeSnapshots         []VolumeSnapshot
+	InstanceSnapshots       []InstanceSnapshot
+	SnapshotSchedules       []SnapshotSchedule
 	SSHKeys                 []SSHKey
 	Webhooks                []Webhook
 	DiskImage               []DiskImage
 	Quota                   Quota
+	Account                 Account
+	APIKeys                 []APIKey
 	Organisation            Organisation
 	OrganisationAccounts    []Account
 	OrganisationRoles       []Role
 	OrganisationTeams       []Team
 	OrganisationTeamMembers map[string][]TeamMember
 	LoadBalancers           []LoadBalancer
+	Certificates            []LoadBalancerCertificate
 	Pools                   []KubernetesPool
+	Applications            []Application
+	KfClusters              []KfCluster
+	InstanceRecovery        map[string]string
 	PingErr                 error
 	// Snapshots            []Snapshot
 	// Templates            []Template
 }
 
-// Clienter is the interface the real civogo.Client and civogo.FakeClient implement
+//go:generate moq -out clienter_mock.go . Clienter
+
+// Clienter is the interface the real civogo.Client and civogo.FakeClient implement, it covers
+// every API operation so code can depend on it instead of *Client and be tested with the
+// FakeClient or the generated ClienterMock. Helpers built on top of these operations, such as
+// the Wait*, Watch*, Ensure* and import/export functions, poll or combine several calls and
+// are left out; the low-level Send*Request methods are left out too.
 type Clienter interface {
 	// Charges
 	ListCharges(from, to time.Time) ([]Charge, error)
@@ -59,19 +88,26 @@ type Clienter interface {
 
 	// Firewalls
 	ListFirewalls() ([]Firewall, error)
+	GetFirewall(id string) (*Firewall, error)
 	FindFirewall(search string) (*Firewall, error)
 	NewFirewall(*FirewallConfig) (*FirewallResult, error)
 	RenameFirewall(id string, f *FirewallConfig) (*SimpleResponse, error)
+	UpdateFirewall(id string, f *FirewallConfig) (*SimpleResponse, error)
 	DeleteFirewall(id string) (*SimpleResponse, error)
 	NewFirewallRule(r *FirewallRuleConfig) (*FirewallRule, error)
 	ListFirewallRules(id string) ([]FirewallRule, error)
 	FindFirewallRule(firewallID string, search string) (*FirewallRule, error)
+	UpdateFirewallRule(firewallID, ruleID string, r *FirewallRuleConfig) (*FirewallRule, error)
 	DeleteFirewallRule(id string, ruleID string) (*SimpleResponse, error)
+	ListFirewallRulesWithFilter(id string, filter *FirewallRuleFilter) (*PaginatedFirewallRules, error)
+	ListFirewallAttachments(id string) (*FirewallAttachments, error)
+	IsUsingDefaultRules(firewallID string) (bool, error)
 
 	// Instances
 	ListInstances(page int, perPage int) (*PaginatedInstanceList, error)
 	ListAllInstances() ([]Instance, error)
 	FindInstance(search string) (*Instance, error)
+	FindInstancesByTag(tag string) ([]Instance, error)
 	GetInstance(id string) (*Instance, error)
 	NewInstanceConfig() (*InstanceConfig, error)
 	CreateInstance(config *InstanceConfig) (*Instance, error)
@@ -87,10 +123,29 @@ type Clienter interface {
 	UpgradeInstance(id, newSize string) (*SimpleResponse, error)
 	MovePublicIPToInstance(id, ipAddress string) (*SimpleResponse, error)
 	SetInstanceFirewall(id, firewallID string) (*SimpleResponse, error)
+	ListInstancesWithFilter(filter *InstanceFilter) (*PaginatedInstanceList, error)
+	CreateInstanceFromVolume(volumeID string, config InstanceFromVolumeConfig) (*Instance, error)
+	AddInstanceTags(id string, tags []string) (*SimpleResponse, error)
+	RemoveInstanceTags(id string, tags []string) (*SimpleResponse, error)
+	ReplaceInstanceTags(id string, tags []string) (*SimpleResponse, error)
+	ResizeInstance(id, newSize string) (*SimpleResponse, error)
+	GetInstanceConsole(id string) (*InstanceConsole, error)
+	GetInstanceInitialPassword(id string) (*InstancePassword, error)
+	ResetInstancePassword(id string) (*InstancePassword, error)
+	GetInstanceMetrics(id string, r MetricsRequest) (*InstanceMetrics, error)
+	GetInstanceUserData(id string) (string, error)
+	SetInstanceUserData(id, script string) (*SimpleResponse, error)
+	GetInstanceVnc(id string) (InstanceVnc, error)
+	EnableInstanceVNC(id string, duration time.Duration) (*InstanceVnc, error)
+	DisableInstanceVNC(id string) (*SimpleResponse, error)
+	GetInstanceRecoveryStatus(id string) (*InstanceRecoveryStatus, error)
+	EnableInstanceRecovery(id string) (*SimpleResponse, error)
+	DisableInstanceRecovery(id string) (*SimpleResponse, error)
 
 	// Instance sizes
 	ListInstanceSizes() ([]InstanceSize, error)
 	FindInstanceSizes(search string) (*InstanceSize, error)
+	ListInstanceSizesByType(sizeType string) ([]InstanceSize, error)
 
 	// Clusters
 	ListKubernetesClusters() (*PaginatedKubernetesClusters, error)
@@ -98,37 +153,118 @@ type Clienter interface {
 	NewKubernetesClusters(kc *KubernetesClusterConfig) (*KubernetesCluster, error)
 	GetKubernetesCluster(id string) (*KubernetesCluster, error)
 	UpdateKubernetesCluster(id string, i *KubernetesClusterConfig) (*KubernetesCluster, error)
+	GetKubernetesClusterFirewall(id string) (*Firewall, error)
+	SetKubernetesClusterFirewall(id, firewallID string) (*KubernetesCluster, error)
+	UpgradeKubernetesCluster(id, version string) (*KubernetesCluster, error)
 	ListKubernetesMarketplaceApplications() ([]KubernetesMarketplaceApplication, error)
+	InstallKubernetesClusterApplication(clusterID, app, plan string) (*KubernetesCluster, error)
+	RemoveKubernetesClusterApplication(clusterID, app string) (*SimpleResponse, error)
 	DeleteKubernetesCluster(id string) (*SimpleResponse, error)
 	RecycleKubernetesCluster(id string, hostname string) (*SimpleResponse, error)
+	RecycleKubernetesNode(clusterID, nodeName string) (*SimpleResponse, error)
+	ListKubernetesVersions() ([]KubernetesVersion, error)
 	ListAvailableKubernetesVersions() ([]KubernetesVersion, error)
+	GetDefaultKubernetesVersion() (*KubernetesVersion, error)
 	ListKubernetesClusterInstances(id string) ([]Instance, error)
+	ListKubernetesClusterEvents(id string) ([]KubernetesClusterEvent, error)
 	FindKubernetesClusterInstance(clusterID, search string) (*Instance, error)
+	GetKubernetesClusterKubeconfig(id string) ([]byte, error)
+	ListKubernetesClusterTypes() ([]string, error)
+
+	// KubernetesClusterBackup
+	CreateKubernetesClusterBackup(clusterID string, config *KubernetesClusterBackupConfig) (*KubernetesClusterBackup, error)
+	ListKubernetesClusterBackups(clusterID string) ([]KubernetesClusterBackup, error)
+	RestoreKubernetesClusterBackup(clusterID, backupID string) (*SimpleResponse, error)
 
 	//Pools
 	ListKubernetesClusterPools(cid string) ([]KubernetesPool, error)
 	GetKubernetesClusterPool(cid, pid string) (*KubernetesPool, error)
 	FindKubernetesClusterPool(cid, search string) (*KubernetesPool, error)
 	DeleteKubernetesClusterPoolInstance(cid, pid, id string) (*SimpleResponse, error)
+	CreateKubernetesClusterPool(id string, i *KubernetesClusterPoolConfig) (*SimpleResponse, error)
 	UpdateKubernetesClusterPool(cid, pid string, config *KubernetesClusterPoolUpdateConfig) (*KubernetesPool, error)
+	DeleteKubernetesClusterPool(id, poolID string) (*SimpleResponse, error)
+	SetKubernetesClusterPoolAutoscaling(cid, pid string, minNodes, maxNodes int) (*KubernetesPool, error)
+	DisableKubernetesClusterPoolAutoscaling(cid, pid string) (*KubernetesPool, error)
+	ScaleKubernetesClusterPool(cid, pid string, count int) (*KubernetesPool, error)
 
 	// Networks
 	GetDefaultNetwork() (*Network, error)
 	NewNetwork(label string) (*NetworkResult, error)
 	CreateNetwork(configs NetworkConfig) (*NetworkResult, error)
+	GetNetwork(id string) (*Network, error)
+	UpdateNetwork(id string, nc NetworkConfig) (*NetworkResult, error)
 	ListNetworks() ([]Network, error)
 	FindNetwork(search string) (*Network, error)
 	RenameNetwork(label, id string) (*NetworkResult, error)
 	DeleteNetwork(id string) (*SimpleResponse, error)
+	GetNetworkDetail(id string) (*NetworkDetail, error)
+
+	// Subnets
+	GetSubnet(networkID, subnetID string) (*Subnet, error)
+	ListSubnets(networkID string) ([]Subnet, error)
+	CreateSubnet(networkID string, subnet SubnetConfig) (*Subnet, error)
+	FindSubnet(search, networkID string) (*Subnet, error)
+	DeleteSubnet(networkID, subnetID string) (*SimpleResponse, error)
+	AttachSubnetToInstance(networkID, subnetID string, route *CreateRoute) (*Route, error)
+	DetachSubnetFromInstance(networkID, subnetID string) (*SimpleResponse, error)
+
+	// Network peerings
+	ListNetworkPeerings() ([]NetworkPeering, error)
+	CreateNetworkPeering(p *NetworkPeeringConfig) (*NetworkPeering, error)
+	DeleteNetworkPeering(id string) (*SimpleResponse, error)
+
+	// ObjectStores
+	ListObjectStores() (*PaginatedObjectstores, error)
+	GetObjectStore(id string) (*ObjectStore, error)
+	FindObjectStore(search string) (*ObjectStore, error)
+	NewObjectStore(v *CreateObjectStoreRequest) (*ObjectStore, error)
+	UpdateObjectStore(id string, v *UpdateObjectStoreRequest) (*ObjectStore, error)
+	DeleteObjectStore(id string) (*SimpleResponse, error)
+	GetObjectStoreStats(id string) (*ObjectStoreStats, error)
+	GetObjectStoreS3Config(id string) (*ObjectStoreS3Config, error)
+	ListObjectStoreCredentials(page, perPage int) (*PaginatedObjectStoreCredentials, error)
+	GetObjectStoreCredential(id string) (*ObjectStoreCredential, error)
+	FindObjectStoreCredential(search string) (*ObjectStoreCredential, error)
+	NewObjectStoreCredential(v *CreateObjectStoreCredentialRequest) (*ObjectStoreCredential, error)
+	UpdateObjectStoreCredential(id string, v *UpdateObjectStoreCredentialRequest) (*ObjectStoreCredential, error)
+	DeleteObjectStoreCredential(id string) (*SimpleResponse, error)
+
+	// Databases
+	ListDatabases() (*PaginatedDatabases, error)
+	GetDatabase(id string) (*Database, error)
+	FindDatabase(search string) (*Database, error)
+	NewDatabase(v *CreateDatabaseRequest) (*Database, error)
+	UpdateDatabase(id string, v *UpdateDatabaseRequest) (*Database, error)
+	DeleteDatabase(id string) (*SimpleResponse, error)
+	ListDBVersions() (map[string][]SupportedSoftwareVersion, error)
+	ListDatabaseBackup(did string) (*PaginatedDatabaseBackup, error)
+	GetDatabaseBackup(dbid, id string) (*DatabaseBackup, error)
+	CreateDatabaseBackup(did string, v *DatabaseBackupCreateRequest) (*DatabaseBackup, error)
+	UpdateDatabaseBackup(did string, v *DatabaseBackupUpdateRequest) (*DatabaseBackup, error)
+	DeleteDatabaseBackup(dbid, id string) (*SimpleResponse, error)
+	RestoreDatabase(id string, v *RestoreDatabaseRequest) (*SimpleResponse, error)
+	FindDatabaseBackup(dbid, search string) (*DatabaseBackup, error)
+	UpdateDatabaseBackupSchedule(did, schedule string) (*DatabaseBackup, error)
+	ListDatabaseUsers(id string) ([]DatabaseUserInfo, error)
+	RotateDatabaseUserPassword(id, username string) (*DatabaseUserInfo, error)
+	ListDatabaseAllowedSources(id string) ([]string, error)
+	AllowDatabaseSource(id, cidr string) (*FirewallRule, error)
+	RevokeDatabaseSource(id, cidr string) error
 
 	// Quota
 	GetQuota() (*Quota, error)
+	QuotaHeadroom(resource string) (int, error)
+	CheckVolumeQuota(ctx context.Context, sizeGB int) error
 
 	// Regions
 	ListRegions() ([]Region, error)
+	FindRegion(search string) (*Region, error)
+	GetDefaultRegion() (*Region, error)
 	CreateRegion(r *CreateRegionRequest) (*Region, error)
 	ConnectRegion(r *ConnectRegionRequest) error
 	DisconnectRegion(r *DisconnectRegionRequest) error
+	RegionSupports(region string, feature RegionFeature) (bool, error)
 
 	// Snapshots
 	// CreateSnapshot(name string, r *SnapshotConfig) (*Snapshot, error)
@@ -142,6 +278,7 @@ type Clienter interface {
 	UpdateSSHKey(name string, sshKeyID string) (*SSHKey, error)
 	FindSSHKey(search string) (*SSHKey, error)
 	DeleteSSHKey(id string) (*SimpleResponse, error)
+	FindSSHKeyByPublicKey(publicKey string) (*SSHKey, error)
 
 	// Templates
 	// ListTemplates() ([]Template, error)
@@ -155,6 +292,10 @@ type Clienter interface {
 	ListDiskImages() ([]DiskImage, error)
 	GetDiskImage(id string) (*DiskImage, error)
 	FindDiskImage(search string) (*DiskImage, error)
+	CreateDiskImage(params *CreateDiskImageParams) (*CreateDiskImageResponse, error)
+	FinalizeDiskImageUpload(id string) (*SimpleResponse, error)
+	GetDiskImageByName(name string) (*DiskImage, error)
+	GetMostRecentDistro(name string) (*DiskImage, error)
 
 	// Volumes
 	ListVolumes() ([]Volume, error)
@@ -165,6 +306,23 @@ type Clienter interface {
 	AttachVolume(id string, cfg VolumeAttachConfig) (*SimpleResponse, error)
 	DetachVolume(id string) (*SimpleResponse, error)
 	DeleteVolume(id string) (*SimpleResponse, error)
+	ListVolumesForCluster(clusterID string) ([]Volume, error)
+	ListDanglingVolumes() ([]Volume, error)
+	ListVolumeTypes() ([]VolumeType, error)
+
+	// InstanceSnapshot
+	CreateInstanceSnapshot(instanceID string, config *InstanceSnapshotConfig) (*InstanceSnapshot, error)
+	ListInstanceSnapshots(instanceID string) ([]InstanceSnapshot, error)
+	GetInstanceSnapshot(instanceID, snapshotID string) (*InstanceSnapshot, error)
+	RestoreInstanceSnapshot(instanceID, snapshotID string) (*SimpleResponse, error)
+	DeleteInstanceSnapshot(instanceID, snapshotID string) (*SimpleResponse, error)
+
+	// SnapshotSchedule
+	CreateSnapshotSchedule(r *CreateSnapshotScheduleRequest) (*SnapshotSchedule, error)
+	ListSnapshotSchedules() ([]SnapshotSchedule, error)
+	GetSnapshotSchedule(id string) (*SnapshotSchedule, error)
+	UpdateSnapshotSchedule(id string, r *UpdateSnapshotScheduleRequest) (*SnapshotSchedule, error)
+	DeleteSnapshotSchedule(id string) (*SimpleResponse, error)
 
 	// VolumeSnapshot
 	GetVolumeSnapshotByVolumeID(volumeID, snapshotID string) (*VolumeSnapshot, error)
@@ -174,6 +332,7 @@ type Clienter interface {
 	ListVolumeSnapshots() ([]VolumeSnapshot, error)
 	GetVolumeSnapshot(id string) (*VolumeSnapshot, error)
 	DeleteVolumeSnapshot(id string) (*SimpleResponse, error)
+	RestoreVolumeSnapshotInPlace(volumeID, snapshotID string, overwrite bool) (*SimpleResponse, error)
 
 	// Webhooks
 	CreateWebhook(r *WebhookConfig) (*Webhook, error)
@@ -191,6 +350,9 @@ type Clienter interface {
 	DeleteIP(id string) (*SimpleResponse, error)
 	AssignIP(id, resourceID, resourceType, region string) (*SimpleResponse, error)
 	UnassignIP(id, region string) (*SimpleResponse, error)
+	AssignIPToInstance(id, instanceID string) (*SimpleResponse, error)
+	AssignIPToLoadBalancer(id, loadBalancerID string) (*SimpleResponse, error)
+	FindInstanceReservedIP(instanceID string) (*IP, error)
 
 	// LoadBalancer
 	ListLoadBalancers() ([]LoadBalancer, error)
@@ -199,6 +361,69 @@ type Clienter interface {
 	CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, error)
 	UpdateLoadBalancer(id string, r *LoadBalancerUpdateConfig) (*LoadBalancer, error)
 	DeleteLoadBalancer(id string) (*SimpleResponse, error)
+	GetLoadBalancerHealth(id string) (*LoadBalancerHealth, error)
+	ListLoadBalancerCertificates() ([]LoadBalancerCertificate, error)
+	UploadLoadBalancerCertificate(r *LoadBalancerCertificateConfig) (*LoadBalancerCertificate, error)
+	DeleteLoadBalancerCertificate(id string) (*SimpleResponse, error)
+	SetLoadBalancerCertificate(id string, port int32, certificateID string) (*LoadBalancer, error)
+
+	// Applications
+	ListApplications() (*PaginatedApplications, error)
+	GetApplication(id string) (*Application, error)
+	FindApplication(search string) (*Application, error)
+	NewApplicationConfig() (*ApplicationConfig, error)
+	CreateApplication(config *ApplicationConfig) (*Application, error)
+	UpdateApplication(id string, application *UpdateApplicationRequest) (*Application, error)
+	DeleteApplication(id string) (*SimpleResponse, error)
+	GetApplicationLogAuth(id string) (string, error)
+
+	// Kubeflow clusters
+	ListKfClusters() (*PaginatedKfClusters, error)
+	GetKfCluster(id string) (*KfCluster, error)
+	FindKfCluster(search string) (*KfCluster, error)
+	CreateKfCluster(req CreateKfClusterReq) (*KfCluster, error)
+	UpdateKfCluster(id string, kfc *UpdateKfClusterReq) (*KfCluster, error)
+	DeleteKfCluster(id string) (*SimpleResponse, error)
+
+	// Account and API keys
+	GetAccount() (*Account, error)
+	ListAPIKeys() ([]APIKey, error)
+	CreateAPIKey(k *CreateAPIKeyConfig) (*APIKey, error)
+	RevokeAPIKey(id string) (*SimpleResponse, error)
+	ListAccounts() (*PaginatedAccounts, error)
+	GetAccountID() string
+
+	// Organisation
+	GetOrganisation() (*Organisation, error)
+	CreateOrganisation(name string) (*Organisation, error)
+	RenameOrganisation(name string) (*Organisation, error)
+	AddAccountToOrganisation(organisationID, organisationToken string) ([]Account, error)
+	ListAccountsInOrganisation() ([]Account, error)
+
+	// Users
+	GetUserEverything(userID string) (*UserEverything, error)
+
+	// Roles and permissions
+	ListPermissions() ([]Permission, error)
+	ListRoles() ([]Role, error)
+	CreateRole(name, permissions string) (*Role, error)
+	DeleteRole(id string) (*SimpleResponse, error)
+	CreateRoleWithPermissions(name string, permissions []string) (*Role, error)
+
+	// Teams
+	ListTeams() ([]Team, error)
+	CreateTeam(name string) (*Team, error)
+	FindTeam(search string) (*Team, error)
+	RenameTeam(teamID, name string) (*Team, error)
+	DeleteTeam(id string) (*SimpleResponse, error)
+	ListTeamMembers(teamID string) ([]TeamMember, error)
+	AddTeamMember(teamID, userID, permissions, roles string) ([]TeamMember, error)
+	UpdateTeamMember(teamID, teamMemberID, permissions, roles string) (*TeamMember, error)
+	RemoveTeamMember(teamID, teamMemberID string) (*SimpleResponse, error)
+	UpdateTeamMemberRoles(teamID, teamMemberID string, roles []string) (*TeamMember, error)
+
+	// Actions
+	ListActions(listRequest *ActionListRequest) (*PaginateActionList, error)
 
 	// Ping
 	Ping() error
@@ -223,18 +448,21 @@ func NewFakeClient() (*FakeClient, error) {
 		},
 		InstanceSizes: []InstanceSize{
 			{
+				Type:          "instance",
 				Name:          "g3.xsmall",
 				CPUCores:      1,
 				RAMMegabytes:  1024,
 				DiskGigabytes: 10,
 			},
 			{
+				Type:          "instance",
 				Name:          "g3.small",
 				CPUCores:      2,
 				RAMMegabytes:  2048,
 				DiskGigabytes: 20,
 			},
 			{
+				Type:          "instance",
 				Name:          "g3.medium",
 				CPUCores:      4,
 				RAMMegabytes:  4096,
@@ -308,7 +536,19 @@ func (c *FakeClient) generatePublicIP() string {
 
 // ListCharges implemented in a fake way for automated tests
 func (c *FakeClient) ListCharges(from, to time.Time) ([]Charge, error) {
-	return []Charge{}, nil
+	if to.Before(from) {
+		err := fmt.Errorf("the end of the period (%s) is before the start (%s)", to.Format(time.RFC3339), from.Format(time.RFC3339))
+		return nil, InvalidChargePeriodError.wrap(err)
+	}
+
+	charges := make([]Charge, 0)
+	for _, charge := range c.Charges {
+		if charge.To.After(from) && charge.From.Before(to) {
+			charges = append(charges, charge)
+		}
+	}
+
+	return charges, nil
 }
 
 // ListDNSDomains implemented in a fake way for automated tests
@@ -376,12 +616,18 @@ func (c *FakeClient) DeleteDNSDomain(d *DNSDomain) (*SimpleResponse, error) {
 
 // CreateDNSRecord implemented in a fake way for automated tests
 func (c *FakeClient) CreateDNSRecord(domainID string, r *DNSRecordConfig) (*DNSRecord, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
 	record := DNSRecord{
 		ID:          c.generateID(),
 		DNSDomainID: domainID,
 		Name:        r.Name,
 		Value:       r.Value,
 		Type:        r.Type,
+		Priority:    r.Priority,
+		TTL:         r.TTL,
 	}
 
 	c.DomainRecords = append(c.DomainRecords, record)
@@ -406,6 +652,10 @@ func (c *FakeClient) GetDNSRecord(domainID, domainRecordID string) (*DNSRecord,
 
 // UpdateDNSRecord implemented in a fake way for automated tests
 func (c *FakeClient) UpdateDNSRecord(r *DNSRecord, rc *DNSRecordConfig) (*DNSRecord, error) {
+	if err := rc.Validate(); err != nil {
+		return nil, err
+	}
+
 	for i, record := range c.DomainRecords {
 		if record.ID == r.ID {
 			record := DNSRecord{
@@ -414,6 +664,8 @@ func (c *FakeClient) UpdateDNSRecord(r *DNSRecord, rc *DNSRecordConfig) (*DNSRec
 				Name:        rc.Name,
 				Value:       rc.Value,
 				Type:        rc.Type,
+				Priority:    rc.Priority,
+				TTL:         rc.TTL,
 			}
 
 			c.DomainRecords[i] = record
@@ -442,14 +694,50 @@ func (c *FakeClient) ListFirewalls() ([]Firewall, error) {
 	return c.Firewalls, nil
 }
 
-// FindFirewall implemented in a fake way for automated tests
-func (c *FakeClient) FindFirewall(search string) (*Firewall, error) {
+// GetFirewall implemented in a fake way for automated tests
+func (c *FakeClient) GetFirewall(id string) (*Firewall, error) {
 	for _, firewall := range c.Firewalls {
-		if strings.Contains(firewall.Name, search) {
+		if firewall.ID == id {
+			firewall.Rules = []FirewallRule{}
+			for _, rule := range c.FirewallRules {
+				if rule.FirewallID == id {
+					firewall.Rules = append(firewall.Rules, rule)
+				}
+			}
+			firewall.RulesCount = len(firewall.Rules)
 			return &firewall, nil
 		}
 	}
 
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindFirewall implemented in a fake way for automated tests
+func (c *FakeClient) FindFirewall(search string) (*Firewall, error) {
+	exactMatch := false
+	partialMatchesCount := 0
+	result := Firewall{}
+
+	for _, value := range c.Firewalls {
+		if value.Name == search || value.ID == search {
+			exactMatch = true
+			result = value
+		} else if strings.Contains(value.Name, search) || strings.Contains(value.ID, search) {
+			if !exactMatch {
+				result = value
+				partialMatchesCount++
+			}
+		}
+	}
+
+	if exactMatch || partialMatchesCount == 1 {
+		return &result, nil
+	} else if partialMatchesCount > 1 {
+		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
+		return nil, MultipleMatchesError.wrap(err)
+	}
+
 	err := fmt.Errorf("unable to find %s, zero matches", search)
 	return nil, ZeroMatchesError.wrap(err)
 }
@@ -482,6 +770,22 @@ func (c *FakeClient) RenameFirewall(id string, f *FirewallConfig) (*SimpleRespon
 	return nil, ZeroMatchesError.wrap(err)
 }
 
+// UpdateFirewall implemented in a fake way for automated tests
+func (c *FakeClient) UpdateFirewall(id string, f *FirewallConfig) (*SimpleResponse, error) {
+	for i, firewall := range c.Firewalls {
+		if firewall.ID == id {
+			c.Firewalls[i].Name = f.Name
+			if f.NetworkID != "" {
+				c.Firewalls[i].NetworkID = f.NetworkID
+			}
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // DeleteFirewall implemented in a fake way for automated tests
 func (c *FakeClient) DeleteFirewall(id string) (*SimpleResponse, error) {
 	for i, firewall := range c.Firewalls {
@@ -517,7 +821,10 @@ func (c *FakeClient) ListFirewallRules(id string) ([]FirewallRule, error) {
 // FindFirewallRule implemented in a fake way for automated tests
 func (c *FakeClient) FindFirewallRule(firewallID string, search string) (*FirewallRule, error) {
 	for _, rule := range c.FirewallRules {
-		if rule.FirewallID == firewallID && strings.Contains(rule.Label, search) {
+		if rule.FirewallID != firewallID {
+			continue
+		}
+		if rule.ID == search || strings.Contains(rule.Label, search) || firewallRulePorts(rule.StartPort, rule.EndPort, rule.Ports) == search || findString(rule.Cidr, search) {
 			return &rule, nil
 		}
 	}
@@ -526,6 +833,30 @@ func (c *FakeClient) FindFirewallRule(firewallID string, search string) (*Firewa
 	return nil, ZeroMatchesError.wrap(err)
 }
 
+// UpdateFirewallRule implemented in a fake way for automated tests
+func (c *FakeClient) UpdateFirewallRule(firewallID, ruleID string, r *FirewallRuleConfig) (*FirewallRule, error) {
+	for i, rule := range c.FirewallRules {
+		if rule.ID == ruleID {
+			c.FirewallRules[i] = FirewallRule{
+				ID:         rule.ID,
+				FirewallID: firewallID,
+				Protocol:   r.Protocol,
+				StartPort:  r.StartPort,
+				EndPort:    r.EndPort,
+				Cidr:       r.Cidr,
+				Direction:  r.Direction,
+				Action:     r.Action,
+				Label:      r.Label,
+				Ports:      r.Ports,
+			}
+			return &c.FirewallRules[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", ruleID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // DeleteFirewallRule implemented in a fake way for automated tests
 func (c *FakeClient) DeleteFirewallRule(id string, ruleID string) (*SimpleResponse, error) {
 	for i, rule := range c.FirewallRules {
@@ -566,6 +897,18 @@ func (c *FakeClient) FindInstance(search string) (*Instance, error) {
 	return nil, ZeroMatchesError.wrap(err)
 }
 
+// FindInstancesByTag implemented in a fake way for automated tests
+func (c *FakeClient) FindInstancesByTag(tag string) ([]Instance, error) {
+	tagged := make([]Instance, 0)
+	for _, instance := range c.Instances {
+		if findString(instance.Tags, tag) {
+			tagged = append(tagged, instance)
+		}
+	}
+
+	return tagged, nil
+}
+
 // GetInstance implemented in a fake way for automated tests
 func (c *FakeClient) GetInstance(id string) (*Instance, error) {
 	for _, instance := range c.Instances {
@@ -585,12 +928,17 @@ func (c *FakeClient) NewInstanceConfig() (*InstanceConfig, error) {
 
 // CreateInstance implemented in a fake way for automated tests
 func (c *FakeClient) CreateInstance(config *InstanceConfig) (*Instance, error) {
+	templateID := config.TemplateID
+	if config.DiskImageID != "" {
+		templateID = config.DiskImageID
+	}
+
 	instance := Instance{
 		ID:          c.generateID(),
 		Hostname:    config.Hostname,
 		Size:        config.Size,
 		Region:      config.Region,
-		TemplateID:  config.TemplateID,
+		TemplateID:  templateID,
 		InitialUser: config.InitialUser,
 		SSHKey:      config.SSHKeyID,
 		Tags:        config.Tags,
@@ -604,7 +952,7 @@ func (c *FakeClient) CreateInstance(config *InstanceConfig) (*Instance, error) {
 func (c *FakeClient) SetInstanceTags(i *Instance, tags string) (*SimpleResponse, error) {
 	for idx, instance := range c.Instances {
 		if instance.ID == i.ID {
-			c.Instances[idx].Tags = strings.Split(tags, " ")
+			c.Instances[idx].Tags = strings.Fields(tags)
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -867,11 +1215,72 @@ func (c *FakeClient) UpdateKubernetesCluster(id string, kc *KubernetesClusterCon
 	return nil, ZeroMatchesError.wrap(err)
 }
 
+// GetKubernetesClusterFirewall implemented in a fake way for automated tests
+func (c *FakeClient) GetKubernetesClusterFirewall(id string) (*Firewall, error) {
+	cluster, err := c.GetKubernetesCluster(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetFirewall(cluster.FirewallID)
+}
+
+// SetKubernetesClusterFirewall implemented in a fake way for automated tests
+func (c *FakeClient) SetKubernetesClusterFirewall(id, firewallID string) (*KubernetesCluster, error) {
+	for i, cluster := range c.Clusters {
+		if cluster.ID == id {
+			c.Clusters[i].FirewallID = firewallID
+			return &c.Clusters[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // ListKubernetesMarketplaceApplications implemented in a fake way for automated tests
 func (c *FakeClient) ListKubernetesMarketplaceApplications() ([]KubernetesMarketplaceApplication, error) {
 	return []KubernetesMarketplaceApplication{}, nil
 }
 
+// InstallKubernetesClusterApplication implemented in a fake way for automated tests
+func (c *FakeClient) InstallKubernetesClusterApplication(clusterID, app, plan string) (*KubernetesCluster, error) {
+	for i, cluster := range c.Clusters {
+		if cluster.ID == clusterID {
+			c.Clusters[i].InstalledApplications = append(c.Clusters[i].InstalledApplications, KubernetesInstalledApplication{
+				Application: app,
+				Name:        app,
+				Plan:        plan,
+				Installed:   true,
+			})
+			return &c.Clusters[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to get kubernetes cluster %s", clusterID)
+	return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+}
+
+// RemoveKubernetesClusterApplication implemented in a fake way for automated tests
+func (c *FakeClient) RemoveKubernetesClusterApplication(clusterID, app string) (*SimpleResponse, error) {
+	for i, cluster := range c.Clusters {
+		if cluster.ID != clusterID {
+			continue
+		}
+		for ai, installed := range cluster.InstalledApplications {
+			if strings.EqualFold(installed.Name, app) {
+				c.Clusters[i].InstalledApplications = append(cluster.InstalledApplications[:ai], cluster.InstalledApplications[ai+1:]...)
+				return &SimpleResponse{Result: "success"}, nil
+			}
+		}
+		err := fmt.Errorf("unable to find application %s on cluster %s", app, clusterID)
+		return nil, DatabaseKubernetesApplicationNotFoundError.wrap(err)
+	}
+
+	err := fmt.Errorf("unable to get kubernetes cluster %s", clusterID)
+	return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+}
+
 // DeleteKubernetesCluster implemented in a fake way for automated tests
 func (c *FakeClient) DeleteKubernetesCluster(id string) (*SimpleResponse, error) {
 	for i, cluster := range c.Clusters {
@@ -885,21 +1294,111 @@ func (c *FakeClient) DeleteKubernetesCluster(id string) (*SimpleResponse, error)
 	return &SimpleResponse{Result: "failed"}, nil
 }
 
+// UpgradeKubernetesCluster implemented in a fake way for automated tests
+func (c *FakeClient) UpgradeKubernetesCluster(id, version string) (*KubernetesCluster, error) {
+	for i, cluster := range c.Clusters {
+		if cluster.ID == id {
+			if cluster.KubernetesVersion == version {
+				err := fmt.Errorf("the cluster is already running kubernetes version %s", version)
+				return nil, KubernetesClusterAlreadyAtVersionError.wrap(err)
+			}
+			c.Clusters[i].KubernetesVersion = version
+			return &c.Clusters[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // RecycleKubernetesCluster implemented in a fake way for automated tests
 func (c *FakeClient) RecycleKubernetesCluster(id string, hostname string) (*SimpleResponse, error) {
 	return &SimpleResponse{Result: "success"}, nil
 }
 
-// ListAvailableKubernetesVersions implemented in a fake way for automated tests
-func (c *FakeClient) ListAvailableKubernetesVersions() ([]KubernetesVersion, error) {
+// RecycleKubernetesNode implemented in a fake way for automated tests
+func (c *FakeClient) RecycleKubernetesNode(clusterID, nodeName string) (*SimpleResponse, error) {
+	return c.RecycleKubernetesCluster(clusterID, nodeName)
+}
+
+// ListKubernetesVersions implemented in a fake way for automated tests
+func (c *FakeClient) ListKubernetesVersions() ([]KubernetesVersion, error) {
 	return []KubernetesVersion{
 		{
 			Version: "1.20+k3s1",
 			Type:    "stable",
+			Default: true,
 		},
 	}, nil
 }
 
+// ListAvailableKubernetesVersions implemented in a fake way for automated tests
+func (c *FakeClient) ListAvailableKubernetesVersions() ([]KubernetesVersion, error) {
+	return c.ListKubernetesVersions()
+}
+
+// GetDefaultKubernetesVersion implemented in a fake way for automated tests
+func (c *FakeClient) GetDefaultKubernetesVersion() (*KubernetesVersion, error) {
+	versions, _ := c.ListKubernetesVersions()
+	return &versions[0], nil
+}
+
+// ListKubernetesClusterEvents implemented in a fake way for automated tests
+func (c *FakeClient) ListKubernetesClusterEvents(id string) ([]KubernetesClusterEvent, error) {
+	for _, cluster := range c.Clusters {
+		if cluster.ID == id {
+			return []KubernetesClusterEvent{}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to get kubernetes cluster %s", id)
+	return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+}
+
+// CreateKubernetesClusterBackup implemented in a fake way for automated tests
+func (c *FakeClient) CreateKubernetesClusterBackup(clusterID string, config *KubernetesClusterBackupConfig) (*KubernetesClusterBackup, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	backup := KubernetesClusterBackup{
+		ID:            c.generateID(),
+		ClusterID:     clusterID,
+		Name:          config.Name,
+		Status:        "completed",
+		Schedule:      config.Schedule,
+		RetentionDays: config.RetentionDays,
+		CreatedAt:     time.Now(),
+	}
+	c.ClusterBackups = append(c.ClusterBackups, backup)
+
+	return &backup, nil
+}
+
+// ListKubernetesClusterBackups implemented in a fake way for automated tests
+func (c *FakeClient) ListKubernetesClusterBackups(clusterID string) ([]KubernetesClusterBackup, error) {
+	backups := make([]KubernetesClusterBackup, 0)
+	for _, backup := range c.ClusterBackups {
+		if backup.ClusterID == clusterID {
+			backups = append(backups, backup)
+		}
+	}
+
+	return backups, nil
+}
+
+// RestoreKubernetesClusterBackup implemented in a fake way for automated tests
+func (c *FakeClient) RestoreKubernetesClusterBackup(clusterID, backupID string) (*SimpleResponse, error) {
+	for _, backup := range c.ClusterBackups {
+		if backup.ClusterID == clusterID && backup.ID == backupID {
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find kubernetes cluster backup %s, zero matches", backupID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // GetDefaultNetwork implemented in a fake way for automated tests
 func (c *FakeClient) GetDefaultNetwork() (*Network, error) {
 	for _, network := range c.Networks {
@@ -946,9 +1445,11 @@ func (c *FakeClient) CreateNetwork(config NetworkConfig) (*NetworkResult, error)
 	if config.VLanConfig != nil {
 		newNetwork.VlanID = config.VLanConfig.VlanID
 		newNetwork.PhysicalInterface = config.VLanConfig.PhysicalInterface
+		newNetwork.HardwareAddr = config.VLanConfig.HardwareAddr
+		newNetwork.CIDR = config.VLanConfig.CIDRv4
 		newNetwork.GatewayIPv4 = config.VLanConfig.GatewayIPv4
 		newNetwork.AllocationPoolV4Start = config.VLanConfig.AllocationPoolV4Start
-		newNetwork.AllocationPoolV4Start = config.VLanConfig.AllocationPoolV4End
+		newNetwork.AllocationPoolV4End = config.VLanConfig.AllocationPoolV4End
 	}
 
 	// Append the newly created network to the networks slice
@@ -962,6 +1463,47 @@ func (c *FakeClient) CreateNetwork(config NetworkConfig) (*NetworkResult, error)
 	}, nil
 }
 
+// GetNetwork implemented in a fake way for automated tests
+func (c *FakeClient) GetNetwork(id string) (*Network, error) {
+	for _, network := range c.Networks {
+		if network.ID == id {
+			return &network, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// UpdateNetwork implemented in a fake way for automated tests
+func (c *FakeClient) UpdateNetwork(id string, nc NetworkConfig) (*NetworkResult, error) {
+	if err := nc.Validate(); err != nil {
+		return nil, err
+	}
+
+	for i, network := range c.Networks {
+		if network.ID == id {
+			if nc.Label != "" {
+				c.Networks[i].Label = nc.Label
+			}
+			if nc.CIDRv4 != "" {
+				c.Networks[i].CIDR = nc.CIDRv4
+			}
+			if nc.NameserversV4 != nil {
+				c.Networks[i].NameserversV4 = nc.NameserversV4
+			}
+			return &NetworkResult{
+				ID:     id,
+				Label:  c.Networks[i].Label,
+				Result: "success",
+			}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // ListNetworks implemented in a fake way for automated tests
 func (c *FakeClient) ListNetworks() ([]Network, error) {
 	return c.Networks, nil
@@ -1009,132 +1551,196 @@ func (c *FakeClient) DeleteNetwork(id string) (*SimpleResponse, error) {
 	return &SimpleResponse{Result: "failed"}, nil
 }
 
-// GetQuota implemented in a fake way for automated tests
-func (c *FakeClient) GetQuota() (*Quota, error) {
-	return &c.Quota, nil
+// ListNetworkPeerings implemented in a fake way for automated tests
+func (c *FakeClient) ListNetworkPeerings() ([]NetworkPeering, error) {
+	return c.NetworkPeerings, nil
 }
 
-// ListRegions implemented in a fake way for automated tests
-func (c *FakeClient) ListRegions() ([]Region, error) {
-	return []Region{
-		{
-			Code:    "FAKE1",
-			Name:    "Fake testing region",
-			Default: true,
-		},
-	}, nil
+// CreateNetworkPeering implemented in a fake way for automated tests, peerings within the
+// account are active straight away and peerings with another account stay pending
+func (c *FakeClient) CreateNetworkPeering(p *NetworkPeeringConfig) (*NetworkPeering, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	networks := []string{p.NetworkID}
+	if p.PeerAccountID == "" {
+		networks = append(networks, p.PeerNetworkID)
+	}
+	for _, id := range networks {
+		if _, err := c.GetNetwork(id); err != nil {
+			return nil, err
+		}
+	}
+
+	peering := NetworkPeering{
+		ID:            c.generateID(),
+		Name:          p.Name,
+		NetworkID:     p.NetworkID,
+		PeerNetworkID: p.PeerNetworkID,
+		PeerAccountID: p.PeerAccountID,
+		Status:        NetworkPeeringStatusActive,
+		CreatedAt:     time.Now(),
+	}
+	if p.PeerAccountID != "" {
+		peering.Status = NetworkPeeringStatusPending
+	}
+
+	c.NetworkPeerings = append(c.NetworkPeerings, peering)
+	return &peering, nil
 }
 
-// CreateRegion implemented in a fake way for automated tests
-func (c *FakeClient) CreateRegion(r *CreateRegionRequest) (*Region, error) {
-	region := Region{
-		Code:          r.Code,
-		Name:          r.Code,
-		OutOfCapacity: false,
-		Country:       r.CountryISOCode,
+// DeleteNetworkPeering implemented in a fake way for automated tests
+func (c *FakeClient) DeleteNetworkPeering(id string) (*SimpleResponse, error) {
+	for i, peering := range c.NetworkPeerings {
+		if peering.ID == id {
+			c.NetworkPeerings = append(c.NetworkPeerings[:i], c.NetworkPeerings[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
 	}
-	return &region, nil
+
+	err := fmt.Errorf("unable to find network peering %s", id)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// ConnectRegion implemented in a fake way for automated tests
-func (c *FakeClient) ConnectRegion(r *ConnectRegionRequest) error {
-	return nil
+// GetSubnet implemented in a fake way for automated tests
+func (c *FakeClient) GetSubnet(networkID, subnetID string) (*Subnet, error) {
+	for _, subnet := range c.Subnets {
+		if subnet.NetworkID == networkID && subnet.ID == subnetID {
+			return &subnet, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", subnetID)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// DisconnectRegion implemented in a fake way for automated tests
-func (c *FakeClient) DisconnectRegion(r *DisconnectRegionRequest) error {
-	return nil
+// ListSubnets implemented in a fake way for automated tests
+func (c *FakeClient) ListSubnets(networkID string) ([]Subnet, error) {
+	subnets := make([]Subnet, 0)
+	for _, subnet := range c.Subnets {
+		if subnet.NetworkID == networkID {
+			subnets = append(subnets, subnet)
+		}
+	}
+
+	return subnets, nil
 }
 
-// CreateSnapshot implemented in a fake way for automated tests
-// func (c *FakeClient) CreateSnapshot(name string, r *SnapshotConfig) (*Snapshot, error) {
-// 	snapshot := Snapshot{
-// 		ID:         c.generateID(),
-// 		Name:       name,
-// 		InstanceID: r.InstanceID,
-// 		Cron:       r.Cron,
-// 	}
-// 	c.Snapshots = append(c.Snapshots, snapshot)
+// CreateSubnet implemented in a fake way for automated tests
+func (c *FakeClient) CreateSubnet(networkID string, config SubnetConfig) (*Subnet, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 
-// 	return &snapshot, nil
-// }
+	subnet := Subnet{
+		ID:        c.generateID(),
+		Name:      config.Name,
+		NetworkID: networkID,
+		CIDR:      config.CIDR,
+		Status:    "success",
+	}
+	c.Subnets = append(c.Subnets, subnet)
 
-// ListSnapshots implemented in a fake way for automated tests
-// func (c *FakeClient) ListSnapshots() ([]Snapshot, error) {
-// 	return c.Snapshots, nil
-// }
+	return &subnet, nil
+}
 
-// FindSnapshot implemented in a fake way for automated tests
-// func (c *FakeClient) FindSnapshot(search string) (*Snapshot, error) {
-// 	for _, snapshot := range c.Snapshots {
-// 		if strings.Contains(snapshot.Name, search) {
-// 			return &snapshot, nil
-// 		}
-// 	}
+// FindSubnet implemented in a fake way for automated tests
+func (c *FakeClient) FindSubnet(search, networkID string) (*Subnet, error) {
+	for _, subnet := range c.Subnets {
+		if subnet.NetworkID == networkID && (subnet.ID == search || strings.Contains(subnet.Name, search)) {
+			return &subnet, nil
+		}
+	}
 
-// 	err := fmt.Errorf("unable to find %s, zero matches", search)
-// 	return nil, ZeroMatchesError.wrap(err)
-// }
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
 
-// DeleteSnapshot implemented in a fake way for automated tests
-// func (c *FakeClient) DeleteSnapshot(name string) (*SimpleResponse, error) {
-// 	for i, snapshot := range c.Snapshots {
-// 		if snapshot.Name == name {
-// 			c.Snapshots[len(c.Snapshots)-1], c.Snapshots[i] = c.Snapshots[i], c.Snapshots[len(c.Snapshots)-1]
-// 			c.Snapshots = c.Snapshots[:len(c.Snapshots)-1]
-// 			return &SimpleResponse{Result: "success"}, nil
-// 		}
-// 	}
+// DeleteSubnet implemented in a fake way for automated tests
+func (c *FakeClient) DeleteSubnet(networkID, subnetID string) (*SimpleResponse, error) {
+	for i, subnet := range c.Subnets {
+		if subnet.NetworkID == networkID && subnet.ID == subnetID {
+			c.Subnets[len(c.Subnets)-1], c.Subnets[i] = c.Subnets[i], c.Subnets[len(c.Subnets)-1]
+			c.Subnets = c.Subnets[:len(c.Subnets)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
 
-// 	return &SimpleResponse{Result: "failed"}, nil
-// }
+	return &SimpleResponse{Result: "failed"}, nil
+}
 
-// ListSSHKeys implemented in a fake way for automated tests
-func (c *FakeClient) ListSSHKeys() ([]SSHKey, error) {
-	return c.SSHKeys, nil
+// ListObjectStores implemented in a fake way for automated tests
+func (c *FakeClient) ListObjectStores() (*PaginatedObjectstores, error) {
+	return &PaginatedObjectstores{Page: 1, PerPage: len(c.ObjectStores), Pages: 1, Items: c.ObjectStores}, nil
 }
 
-// NewSSHKey implemented in a fake way for automated tests
-func (c *FakeClient) NewSSHKey(name string, publicKey string) (*SimpleResponse, error) {
-	sshKey := SSHKey{
-		Name:        name,
-		Fingerprint: publicKey, // This is weird, but we're just storing a value
+// GetObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) GetObjectStore(id string) (*ObjectStore, error) {
+	for _, store := range c.ObjectStores {
+		if store.ID == id {
+			return &store, nil
+		}
 	}
-	c.SSHKeys = append(c.SSHKeys, sshKey)
-	return &SimpleResponse{Result: "success"}, nil
+
+	err := fmt.Errorf("unable to find objectstore %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// UpdateSSHKey implemented in a fake way for automated tests
-func (c *FakeClient) UpdateSSHKey(name string, sshKeyID string) (*SSHKey, error) {
-	for i, sshKey := range c.SSHKeys {
-		if sshKey.ID == sshKeyID {
-			c.SSHKeys[i].Name = name
-			return &sshKey, nil
+// FindObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) FindObjectStore(search string) (*ObjectStore, error) {
+	for _, store := range c.ObjectStores {
+		if strings.Contains(store.Name, search) || store.ID == search {
+			return &store, nil
 		}
 	}
 
-	err := fmt.Errorf("unable to find SSH key %s, zero matches", sshKeyID)
+	err := fmt.Errorf("unable to find %s, zero matches", search)
 	return nil, ZeroMatchesError.wrap(err)
 }
 
-// FindSSHKey implemented in a fake way for automated tests
-func (c *FakeClient) FindSSHKey(search string) (*SSHKey, error) {
-	for _, sshKey := range c.SSHKeys {
-		if strings.Contains(sshKey.Name, search) {
-			return &sshKey, nil
+// NewObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) NewObjectStore(v *CreateObjectStoreRequest) (*ObjectStore, error) {
+	if err := checkObjectStoreSize(v.MaxSizeGB); err != nil {
+		return nil, err
+	}
+
+	store := ObjectStore{
+		ID:        c.generateID(),
+		Name:      v.Name,
+		MaxSize:   int(v.MaxSizeGB),
+		OwnerInfo: BucketOwner{AccessKeyID: v.AccessKeyID},
+		BucketURL: "objectstore.civo.com",
+		Status:    ObjectStoreStatusActive,
+	}
+
+	c.ObjectStores = append(c.ObjectStores, store)
+	return &store, nil
+}
+
+// UpdateObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) UpdateObjectStore(id string, v *UpdateObjectStoreRequest) (*ObjectStore, error) {
+	if err := checkObjectStoreSize(v.MaxSizeGB); err != nil {
+		return nil, err
+	}
+
+	for i, store := range c.ObjectStores {
+		if store.ID == id {
+			c.ObjectStores[i].MaxSize = int(v.MaxSizeGB)
+			store = c.ObjectStores[i]
+			return &store, nil
 		}
 	}
 
-	err := fmt.Errorf("unable to find SSH key %s, zero matches", search)
+	err := fmt.Errorf("unable to find objectstore %s, zero matches", id)
 	return nil, ZeroMatchesError.wrap(err)
 }
 
-// DeleteSSHKey implemented in a fake way for automated tests
-func (c *FakeClient) DeleteSSHKey(id string) (*SimpleResponse, error) {
-	for i, sshKey := range c.SSHKeys {
-		if sshKey.ID == id {
-			c.SSHKeys[len(c.SSHKeys)-1], c.SSHKeys[i] = c.SSHKeys[i], c.SSHKeys[len(c.SSHKeys)-1]
-			c.SSHKeys = c.SSHKeys[:len(c.SSHKeys)-1]
+// DeleteObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) DeleteObjectStore(id string) (*SimpleResponse, error) {
+	for i, store := range c.ObjectStores {
+		if store.ID == id {
+			c.ObjectStores = append(c.ObjectStores[:i], c.ObjectStores[i+1:]...)
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -1142,197 +1748,281 @@ func (c *FakeClient) DeleteSSHKey(id string) (*SimpleResponse, error) {
 	return &SimpleResponse{Result: "failed"}, nil
 }
 
-// ListTemplates implemented in a fake way for automated tests
-// func (c *FakeClient) ListTemplates() ([]Template, error) {
-// 	return c.Templates, nil
-// }
+// ListObjectStoreCredentials implemented in a fake way for automated tests
+func (c *FakeClient) ListObjectStoreCredentials(page, perPage int) (*PaginatedObjectStoreCredentials, error) {
+	return &PaginatedObjectStoreCredentials{Page: 1, PerPage: len(c.ObjectStoreCredentials), Pages: 1, Items: c.ObjectStoreCredentials}, nil
+}
 
-// NewTemplate implemented in a fake way for automated tests
-// func (c *FakeClient) NewTemplate(conf *Template) (*SimpleResponse, error) {
-// 	template := Template{
-// 		ID:          conf.ID,
-// 		Name:        conf.Name,
-// 		CloudConfig: conf.CloudConfig,
-// 		ImageID:     conf.ImageID,
-// 	}
-// 	c.Templates = append(c.Templates, template)
-// 	return &SimpleResponse{Result: "success"}, nil
-// }
+// GetObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) GetObjectStoreCredential(id string) (*ObjectStoreCredential, error) {
+	for _, credential := range c.ObjectStoreCredentials {
+		if credential.ID == id {
+			return &credential, nil
+		}
+	}
 
-// UpdateTemplate implemented in a fake way for automated tests
-// func (c *FakeClient) UpdateTemplate(id string, conf *Template) (*Template, error) {
-// 	for i, template := range c.Templates {
-// 		if template.ID == id {
-// 			c.Templates[i].Name = conf.Name
-// 			c.Templates[i].CloudConfig = conf.CloudConfig
-// 			c.Templates[i].ImageID = conf.ImageID
-// 			return &template, nil
-// 		}
-// 	}
+	err := fmt.Errorf("unable to find objectstore credential %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
 
-// 	err := fmt.Errorf("unable to find SSH key %s, zero matches", id)
-// 	return nil, ZeroMatchesError.wrap(err)
-// }
+// FindObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) FindObjectStoreCredential(search string) (*ObjectStoreCredential, error) {
+	for _, credential := range c.ObjectStoreCredentials {
+		if credential.AccessKeyID == search || credential.ID == search || strings.Contains(credential.Name, search) {
+			return &credential, nil
+		}
+	}
 
-// GetTemplateByCode implemented in a fake way for automated tests
-// func (c *FakeClient) GetTemplateByCode(code string) (*Template, error) {
-// 	for _, template := range c.Templates {
-// 		if template.Code == code {
-// 			return &template, nil
-// 		}
-// 	}
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
 
-// 	err := fmt.Errorf("unable to find SSH key %s, zero matches", code)
-// 	return nil, ZeroMatchesError.wrap(err)
-// }
+// NewObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) NewObjectStoreCredential(v *CreateObjectStoreCredentialRequest) (*ObjectStoreCredential, error) {
+	credential := ObjectStoreCredential{
+		ID:     c.generateID(),
+		Name:   v.Name,
+		Status: "ready",
+	}
+	credential.AccessKeyID = "AK" + credential.ID
+	credential.SecretAccessKeyID = "SK" + credential.ID
+	if v.AccessKeyID != nil {
+		credential.AccessKeyID = *v.AccessKeyID
+	}
+	if v.SecretAccessKeyID != nil {
+		credential.SecretAccessKeyID = *v.SecretAccessKeyID
+	}
+	if v.MaxSizeGB != nil {
+		credential.MaxSizeGB = *v.MaxSizeGB
+	}
 
-// FindTemplate implemented in a fake way for automated tests
-// func (c *FakeClient) FindTemplate(search string) (*Template, error) {
-// 	for _, template := range c.Templates {
-// 		if strings.Contains(template.Name, search) {
-// 			return &template, nil
-// 		}
-// 	}
+	c.ObjectStoreCredentials = append(c.ObjectStoreCredentials, credential)
+	return &credential, nil
+}
 
-// 	err := fmt.Errorf("unable to find template %s, zero matches", search)
-// 	return nil, ZeroMatchesError.wrap(err)
-// }
+// UpdateObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) UpdateObjectStoreCredential(id string, v *UpdateObjectStoreCredentialRequest) (*ObjectStoreCredential, error) {
+	for i, credential := range c.ObjectStoreCredentials {
+		if credential.ID == id {
+			if v.AccessKeyID != nil {
+				credential.AccessKeyID = *v.AccessKeyID
+			}
+			if v.SecretAccessKeyID != nil {
+				credential.SecretAccessKeyID = *v.SecretAccessKeyID
+			}
+			if v.MaxSizeGB != nil {
+				credential.MaxSizeGB = *v.MaxSizeGB
+			}
 
-// DeleteTemplate implemented in a fake way for automated tests
-// func (c *FakeClient) DeleteTemplate(id string) (*SimpleResponse, error) {
-// 	for i, template := range c.Templates {
-// 		if template.ID == id {
-// 			c.Templates[len(c.Templates)-1], c.Templates[i] = c.Templates[i], c.Templates[len(c.Templates)-1]
-// 			c.Templates = c.Templates[:len(c.Templates)-1]
-// 			return &SimpleResponse{Result: "success"}, nil
-// 		}
-// 	}
+			c.ObjectStoreCredentials[i] = credential
+			return &credential, nil
+		}
+	}
 
-// 	return &SimpleResponse{Result: "failed"}, nil
-// }
+	err := fmt.Errorf("unable to find objectstore credential %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
 
-// ListDiskImages implemented in a fake way for automated tests
-func (c *FakeClient) ListDiskImages() ([]DiskImage, error) {
-	return c.DiskImage, nil
+// DeleteObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) DeleteObjectStoreCredential(id string) (*SimpleResponse, error) {
+	for i, credential := range c.ObjectStoreCredentials {
+		if credential.ID == id {
+			c.ObjectStoreCredentials = append(c.ObjectStoreCredentials[:i], c.ObjectStoreCredentials[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
 }
 
-// GetDiskImage implemented in a fake way for automated tests
-func (c *FakeClient) GetDiskImage(id string) (*DiskImage, error) {
-	for k, v := range c.DiskImage {
-		if v.ID == id {
-			return &c.DiskImage[k], nil
+// ListDatabases implemented in a fake way for automated tests
+func (c *FakeClient) ListDatabases() (*PaginatedDatabases, error) {
+	return &PaginatedDatabases{Page: 1, PerPage: len(c.Databases), Pages: 1, Items: c.Databases}, nil
+}
+
+// GetDatabase implemented in a fake way for automated tests
+func (c *FakeClient) GetDatabase(id string) (*Database, error) {
+	for _, db := range c.Databases {
+		if db.ID == id {
+			return &db, nil
 		}
 	}
 
-	err := fmt.Errorf("unable to find disk image %s, zero matches", id)
+	err := fmt.Errorf("unable to find database %s, zero matches", id)
 	return nil, ZeroMatchesError.wrap(err)
 }
 
-// FindDiskImage implemented in a fake way for automated tests
-func (c *FakeClient) FindDiskImage(search string) (*DiskImage, error) {
-	for _, diskimage := range c.DiskImage {
-		if strings.Contains(diskimage.Name, search) || strings.Contains(diskimage.ID, search) {
-			return &diskimage, nil
+// FindDatabase implemented in a fake way for automated tests
+func (c *FakeClient) FindDatabase(search string) (*Database, error) {
+	for _, db := range c.Databases {
+		if db.ID == search || strings.Contains(strings.ToUpper(db.Name), strings.ToUpper(search)) {
+			return &db, nil
 		}
 	}
 
-	err := fmt.Errorf("unable to find volume %s, zero matches", search)
+	err := fmt.Errorf("unable to find %s, zero matches", search)
 	return nil, ZeroMatchesError.wrap(err)
 }
 
-// ListVolumes implemented in a fake way for automated tests
-func (c *FakeClient) ListVolumes() ([]Volume, error) {
-	return c.Volumes, nil
+// NewDatabase implemented in a fake way for automated tests
+func (c *FakeClient) NewDatabase(v *CreateDatabaseRequest) (*Database, error) {
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+
+	db := Database{
+		ID:              c.generateID(),
+		Name:            v.Name,
+		Nodes:           v.Nodes,
+		Size:            v.Size,
+		Software:        v.Software,
+		SoftwareVersion: v.SoftwareVersion,
+		NetworkID:       v.NetworkID,
+		FirewallID:      v.FirewallID,
+		PublicIPv4:      c.generatePublicIP(),
+		Status:          DatabaseStatusReady,
+	}
+	if db.Nodes == 0 {
+		db.Nodes = 1
+	}
+	if db.FirewallID == "" {
+		db.FirewallID = c.generateID()
+	}
+
+	c.Databases = append(c.Databases, db)
+	return &db, nil
 }
 
-// GetVolume implemented in a fake way for automated tests
-func (c *FakeClient) GetVolume(id string) (*Volume, error) {
-	for _, volume := range c.Volumes {
-		if volume.ID == id {
-			return &volume, nil
+// UpdateDatabase implemented in a fake way for automated tests
+func (c *FakeClient) UpdateDatabase(id string, v *UpdateDatabaseRequest) (*Database, error) {
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+
+	for i, db := range c.Databases {
+		if db.ID == id {
+			if v.Name != "" {
+				db.Name = v.Name
+			}
+			if v.Nodes != nil {
+				db.Nodes = *v.Nodes
+			}
+			if v.FirewallID != "" {
+				db.FirewallID = v.FirewallID
+			}
+
+			c.Databases[i] = db
+			return &db, nil
 		}
 	}
 
-	err := fmt.Errorf("unable to get volume %s", id)
+	err := fmt.Errorf("unable to find database %s, zero matches", id)
 	return nil, ZeroMatchesError.wrap(err)
 }
 
-// FindVolume implemented in a fake way for automated tests
-func (c *FakeClient) FindVolume(search string) (*Volume, error) {
-	for _, volume := range c.Volumes {
-		if strings.Contains(volume.Name, search) || strings.Contains(volume.ID, search) {
-			return &volume, nil
+// DeleteDatabase implemented in a fake way for automated tests
+func (c *FakeClient) DeleteDatabase(id string) (*SimpleResponse, error) {
+	for i, db := range c.Databases {
+		if db.ID == id {
+			c.Databases = append(c.Databases[:i], c.Databases[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
 
-	err := fmt.Errorf("unable to find volume %s, zero matches", search)
-	return nil, ZeroMatchesError.wrap(err)
+	return &SimpleResponse{Result: "failed"}, nil
 }
 
-// NewVolume implemented in a fake way for automated tests
-func (c *FakeClient) NewVolume(v *VolumeConfig) (*VolumeResult, error) {
-	volume := Volume{
-		ID:            c.generateID(),
-		Name:          v.Name,
-		SizeGigabytes: v.SizeGigabytes,
-		Status:        "available",
+// ListDBVersions implemented in a fake way for automated tests
+func (c *FakeClient) ListDBVersions() (map[string][]SupportedSoftwareVersion, error) {
+	return map[string][]SupportedSoftwareVersion{
+		DatabaseSoftwareMySQL:      {{SoftwareVersion: "8.0", Default: true}},
+		DatabaseSoftwarePostgreSQL: {{SoftwareVersion: "14", Default: true}},
+	}, nil
+}
+
+// ListDatabaseBackup implemented in a fake way for automated tests
+func (c *FakeClient) ListDatabaseBackup(did string) (*PaginatedDatabaseBackup, error) {
+	backups := make([]DatabaseBackup, 0)
+	for _, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == did {
+			backups = append(backups, backup)
+		}
 	}
-	c.Volumes = append(c.Volumes, volume)
 
-	return &VolumeResult{
-		ID:     volume.ID,
-		Name:   volume.Name,
-		Result: "success",
-	}, nil
+	return &PaginatedDatabaseBackup{Page: 1, PerPage: len(backups), Pages: 1, Items: backups}, nil
 }
 
-// ResizeVolume implemented in a fake way for automated tests
-func (c *FakeClient) ResizeVolume(id string, size int) (*SimpleResponse, error) {
-	for i, volume := range c.Volumes {
-		if volume.ID == id {
-			c.Volumes[i].SizeGigabytes = size
-			return &SimpleResponse{Result: "success"}, nil
+// GetDatabaseBackup implemented in a fake way for automated tests
+func (c *FakeClient) GetDatabaseBackup(dbid, id string) (*DatabaseBackup, error) {
+	for _, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == dbid && backup.ID == id {
+			return &backup, nil
 		}
 	}
 
-	err := fmt.Errorf("unable to find volume %s, zero matches", id)
+	err := fmt.Errorf("unable to find backup %s, zero matches", id)
 	return nil, ZeroMatchesError.wrap(err)
 }
 
-// AttachVolume implemented in a fake way for automated tests
-func (c *FakeClient) AttachVolume(id string, cfg VolumeAttachConfig) (*SimpleResponse, error) {
-	for i, volume := range c.Volumes {
-		if volume.ID == id {
-			c.Volumes[i].InstanceID = cfg.InstanceID
-			c.Volumes[i].Status = "attached"
-			return &SimpleResponse{Result: "success"}, nil
+// CreateDatabaseBackup implemented in a fake way for automated tests, backups are ready straight away
+func (c *FakeClient) CreateDatabaseBackup(did string, v *DatabaseBackupCreateRequest) (*DatabaseBackup, error) {
+	db, err := c.GetDatabase(did)
+	if err != nil {
+		return nil, err
+	}
+	if v.Schedule != "" {
+		if err := checkDatabaseBackupSchedule(v.Schedule); err != nil {
+			return nil, err
 		}
 	}
 
-	err := fmt.Errorf("unable to find volume %s, zero matches", id)
-	return nil, ZeroMatchesError.wrap(err)
+	backup := DatabaseBackup{
+		ID:           c.generateID(),
+		Name:         v.Name,
+		Software:     db.Software,
+		Status:       DatabaseBackupStatusReady,
+		Schedule:     v.Schedule,
+		DatabaseName: db.Name,
+		DatabaseID:   db.ID,
+		IsScheduled:  v.Schedule != "",
+		CreatedAt:    time.Now(),
+	}
+
+	c.DatabaseBackups = append(c.DatabaseBackups, backup)
+	return &backup, nil
 }
 
-// DetachVolume implemented in a fake way for automated tests
-func (c *FakeClient) DetachVolume(id string) (*SimpleResponse, error) {
-	for i, volume := range c.Volumes {
-		if volume.ID == id {
-			c.Volumes[i].InstanceID = ""
-			c.Volumes[i].Status = "available"
-			return &SimpleResponse{Result: "success"}, nil
+// UpdateDatabaseBackup implemented in a fake way for automated tests, updating the scheduled backup
+func (c *FakeClient) UpdateDatabaseBackup(did string, v *DatabaseBackupUpdateRequest) (*DatabaseBackup, error) {
+	if v.Schedule != "" {
+		if err := checkDatabaseBackupSchedule(v.Schedule); err != nil {
+			return nil, err
 		}
 	}
 
-	err := fmt.Errorf("unable to find volume %s, zero matches", id)
+	for i, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == did && backup.IsScheduled {
+			if v.Name != "" {
+				backup.Name = v.Name
+			}
+			if v.Schedule != "" {
+				backup.Schedule = v.Schedule
+			}
+
+			c.DatabaseBackups[i] = backup
+			return &backup, nil
+		}
+	}
+
+	err := fmt.Errorf("database %s has no scheduled backup", did)
 	return nil, ZeroMatchesError.wrap(err)
 }
 
-// DeleteVolume implemented in a fake way for automated tests
-func (c *FakeClient) DeleteVolume(id string) (*SimpleResponse, error) {
-	for i, volume := range c.Volumes {
-		if volume.ID == id {
-			c.Volumes[len(c.Volumes)-1], c.Volumes[i] = c.Volumes[i], c.Volumes[len(c.Volumes)-1]
-			c.Volumes = c.Volumes[:len(c.Volumes)-1]
+// DeleteDatabaseBackup implemented in a fake way for automated tests
+func (c *FakeClient) DeleteDatabaseBackup(dbid, id string) (*SimpleResponse, error) {
+	for i, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == dbid && backup.ID == id {
+			c.DatabaseBackups = append(c.DatabaseBackups[:i], c.DatabaseBackups[i+1:]...)
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -1340,19 +2030,444 @@ func (c *FakeClient) DeleteVolume(id string) (*SimpleResponse, error) {
 	return &SimpleResponse{Result: "failed"}, nil
 }
 
-// GetVolumeSnapshotByVolumeID implemented in a fake way for automated tests
-func (c *FakeClient) GetVolumeSnapshotByVolumeID(volumeID, snapshotID string) (*VolumeSnapshot, error) {
-	for _, snapshot := range c.VolumeSnapshots {
-		if snapshot.VolumeID == volumeID && snapshot.SnapshotID == snapshotID {
-			return &snapshot, nil
+// RestoreDatabase implemented in a fake way for automated tests
+func (c *FakeClient) RestoreDatabase(id string, v *RestoreDatabaseRequest) (*SimpleResponse, error) {
+	for _, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == id && (backup.Name == v.Backup || backup.ID == v.Backup) {
+			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
 
-	err := fmt.Errorf("unable to find volume snapshot %s, zero matches", snapshotID)
+	err := fmt.Errorf("unable to find backup %s, zero matches", v.Backup)
 	return nil, ZeroMatchesError.wrap(err)
 }
 
-// ListVolumeSnapshotsByVolumeID implemented in a fake way for automated tests
+// GetQuota implemented in a fake way for automated tests
+func (c *FakeClient) GetQuota() (*Quota, error) {
+	return &c.Quota, nil
+}
+
+// ListRegions implemented in a fake way for automated tests
+func (c *FakeClient) ListRegions() ([]Region, error) {
+	return []Region{
+		{
+			Code:    "FAKE1",
+			Name:    "Fake testing region",
+			Default: true,
+			Features: Feature{
+				Iaas:         true,
+				Kubernetes:   true,
+				ObjectStore:  true,
+				LoadBalancer: true,
+				DBaaS:        true,
+				Volume:       true,
+			},
+		},
+	}, nil
+}
+
+// FindRegion implemented in a fake way for automated tests
+func (c *FakeClient) FindRegion(search string) (*Region, error) {
+	regions, _ := c.ListRegions()
+	for _, region := range regions {
+		if strings.EqualFold(region.Code, search) || strings.EqualFold(region.Name, search) {
+			return &region, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// GetDefaultRegion implemented in a fake way for automated tests
+func (c *FakeClient) GetDefaultRegion() (*Region, error) {
+	regions, _ := c.ListRegions()
+	for _, region := range regions {
+		if region.Default {
+			return &region, nil
+		}
+	}
+
+	err := fmt.Errorf("no default region found")
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// CreateRegion implemented in a fake way for automated tests
+func (c *FakeClient) CreateRegion(r *CreateRegionRequest) (*Region, error) {
+	region := Region{
+		Code:          r.Code,
+		Name:          r.Code,
+		OutOfCapacity: false,
+		Country:       r.CountryISOCode,
+	}
+	return &region, nil
+}
+
+// ConnectRegion implemented in a fake way for automated tests
+func (c *FakeClient) ConnectRegion(r *ConnectRegionRequest) error {
+	return nil
+}
+
+// DisconnectRegion implemented in a fake way for automated tests
+func (c *FakeClient) DisconnectRegion(r *DisconnectRegionRequest) error {
+	return nil
+}
+
+// CreateSnapshot implemented in a fake way for automated tests
+// func (c *FakeClient) CreateSnapshot(name string, r *SnapshotConfig) (*Snapshot, error) {
+// 	snapshot := Snapshot{
+// 		ID:         c.generateID(),
+// 		Name:       name,
+// 		InstanceID: r.InstanceID,
+// 		Cron:       r.Cron,
+// 	}
+// 	c.Snapshots = append(c.Snapshots, snapshot)
+
+// 	return &snapshot, nil
+// }
+
+// ListSnapshots implemented in a fake way for automated tests
+// func (c *FakeClient) ListSnapshots() ([]Snapshot, error) {
+// 	return c.Snapshots, nil
+// }
+
+// FindSnapshot implemented in a fake way for automated tests
+// func (c *FakeClient) FindSnapshot(search string) (*Snapshot, error) {
+// 	for _, snapshot := range c.Snapshots {
+// 		if strings.Contains(snapshot.Name, search) {
+// 			return &snapshot, nil
+// 		}
+// 	}
+
+// 	err := fmt.Errorf("unable to find %s, zero matches", search)
+// 	return nil, ZeroMatchesError.wrap(err)
+// }
+
+// DeleteSnapshot implemented in a fake way for automated tests
+// func (c *FakeClient) DeleteSnapshot(name string) (*SimpleResponse, error) {
+// 	for i, snapshot := range c.Snapshots {
+// 		if snapshot.Name == name {
+// 			c.Snapshots[len(c.Snapshots)-1], c.Snapshots[i] = c.Snapshots[i], c.Snapshots[len(c.Snapshots)-1]
+// 			c.Snapshots = c.Snapshots[:len(c.Snapshots)-1]
+// 			return &SimpleResponse{Result: "success"}, nil
+// 		}
+// 	}
+
+// 	return &SimpleResponse{Result: "failed"}, nil
+// }
+
+// ListSSHKeys implemented in a fake way for automated tests
+func (c *FakeClient) ListSSHKeys() ([]SSHKey, error) {
+	return c.SSHKeys, nil
+}
+
+// NewSSHKey implemented in a fake way for automated tests
+func (c *FakeClient) NewSSHKey(name string, publicKey string) (*SimpleResponse, error) {
+	sshKey := SSHKey{
+		ID:          c.generateID(),
+		Name:        name,
+		PublicKey:   publicKey,
+		Fingerprint: publicKey, // keys that can't be parsed just store the value
+		CreatedAt:   time.Now(),
+	}
+	if fingerprint, err := SSHKeyFingerprint(publicKey); err == nil {
+		sshKey.Fingerprint = fingerprint
+	}
+
+	c.SSHKeys = append(c.SSHKeys, sshKey)
+	return &SimpleResponse{Result: "success", ID: sshKey.ID}, nil
+}
+
+// UpdateSSHKey implemented in a fake way for automated tests
+func (c *FakeClient) UpdateSSHKey(name string, sshKeyID string) (*SSHKey, error) {
+	for i, sshKey := range c.SSHKeys {
+		if sshKey.ID == sshKeyID {
+			c.SSHKeys[i].Name = name
+			return &sshKey, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find SSH key %s, zero matches", sshKeyID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindSSHKey implemented in a fake way for automated tests
+func (c *FakeClient) FindSSHKey(search string) (*SSHKey, error) {
+	exactMatch := false
+	partialMatchesCount := 0
+	result := SSHKey{}
+
+	for _, sshKey := range c.SSHKeys {
+		if sshKey.Name == search || sshKey.ID == search {
+			exactMatch = true
+			result = sshKey
+		} else if strings.Contains(sshKey.Name, search) || strings.Contains(sshKey.ID, search) {
+			if !exactMatch {
+				result = sshKey
+				partialMatchesCount++
+			}
+		}
+	}
+
+	if exactMatch || partialMatchesCount == 1 {
+		return &result, nil
+	} else if partialMatchesCount > 1 {
+		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
+		return nil, MultipleMatchesError.wrap(err)
+	}
+
+	err := fmt.Errorf("unable to find SSH key %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteSSHKey implemented in a fake way for automated tests
+func (c *FakeClient) DeleteSSHKey(id string) (*SimpleResponse, error) {
+	for i, sshKey := range c.SSHKeys {
+		if sshKey.ID == id {
+			c.SSHKeys[len(c.SSHKeys)-1], c.SSHKeys[i] = c.SSHKeys[i], c.SSHKeys[len(c.SSHKeys)-1]
+			c.SSHKeys = c.SSHKeys[:len(c.SSHKeys)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// ListTemplates implemented in a fake way for automated tests
+// func (c *FakeClient) ListTemplates() ([]Template, error) {
+// 	return c.Templates, nil
+// }
+
+// NewTemplate implemented in a fake way for automated tests
+// func (c *FakeClient) NewTemplate(conf *Template) (*SimpleResponse, error) {
+// 	template := Template{
+// 		ID:          conf.ID,
+// 		Name:        conf.Name,
+// 		CloudConfig: conf.CloudConfig,
+// 		ImageID:     conf.ImageID,
+// 	}
+// 	c.Templates = append(c.Templates, template)
+// 	return &SimpleResponse{Result: "success"}, nil
+// }
+
+// UpdateTemplate implemented in a fake way for automated tests
+// func (c *FakeClient) UpdateTemplate(id string, conf *Template) (*Template, error) {
+// 	for i, template := range c.Templates {
+// 		if template.ID == id {
+// 			c.Templates[i].Name = conf.Name
+// 			c.Templates[i].CloudConfig = conf.CloudConfig
+// 			c.Templates[i].ImageID = conf.ImageID
+// 			return &template, nil
+// 		}
+// 	}
+
+// 	err := fmt.Errorf("unable to find SSH key %s, zero matches", id)
+// 	return nil, ZeroMatchesError.wrap(err)
+// }
+
+// GetTemplateByCode implemented in a fake way for automated tests
+// func (c *FakeClient) GetTemplateByCode(code string) (*Template, error) {
+// 	for _, template := range c.Templates {
+// 		if template.Code == code {
+// 			return &template, nil
+// 		}
+// 	}
+
+// 	err := fmt.Errorf("unable to find SSH key %s, zero matches", code)
+// 	return nil, ZeroMatchesError.wrap(err)
+// }
+
+// FindTemplate implemented in a fake way for automated tests
+// func (c *FakeClient) FindTemplate(search string) (*Template, error) {
+// 	for _, template := range c.Templates {
+// 		if strings.Contains(template.Name, search) {
+// 			return &template, nil
+// 		}
+// 	}
+
+// 	err := fmt.Errorf("unable to find template %s, zero matches", search)
+// 	return nil, ZeroMatchesError.wrap(err)
+// }
+
+// DeleteTemplate implemented in a fake way for automated tests
+// func (c *FakeClient) DeleteTemplate(id string) (*SimpleResponse, error) {
+// 	for i, template := range c.Templates {
+// 		if template.ID == id {
+// 			c.Templates[len(c.Templates)-1], c.Templates[i] = c.Templates[i], c.Templates[len(c.Templates)-1]
+// 			c.Templates = c.Templates[:len(c.Templates)-1]
+// 			return &SimpleResponse{Result: "success"}, nil
+// 		}
+// 	}
+
+// 	return &SimpleResponse{Result: "failed"}, nil
+// }
+
+// ListDiskImages implemented in a fake way for automated tests
+func (c *FakeClient) ListDiskImages() ([]DiskImage, error) {
+	return c.DiskImage, nil
+}
+
+// GetDiskImage implemented in a fake way for automated tests
+func (c *FakeClient) GetDiskImage(id string) (*DiskImage, error) {
+	for k, v := range c.DiskImage {
+		if v.ID == id {
+			return &c.DiskImage[k], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find disk image %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindDiskImage implemented in a fake way for automated tests
+func (c *FakeClient) FindDiskImage(search string) (*DiskImage, error) {
+	for _, diskimage := range c.DiskImage {
+		if strings.Contains(diskimage.Name, search) || strings.Contains(diskimage.ID, search) {
+			return &diskimage, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find volume %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// CreateDiskImage implemented in a fake way for automated tests
+func (c *FakeClient) CreateDiskImage(params *CreateDiskImageParams) (*CreateDiskImageResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	diskImage := DiskImage{
+		ID:           c.generateID(),
+		Name:         params.Name,
+		Version:      params.Version,
+		State:        DiskImageStateUploading,
+		Distribution: params.Distribution,
+	}
+	c.DiskImage = append(c.DiskImage, diskImage)
+
+	return &CreateDiskImageResponse{
+		ID:           diskImage.ID,
+		Name:         diskImage.Name,
+		Distribution: diskImage.Distribution,
+		Version:      diskImage.Version,
+		UploadURL:    fmt.Sprintf("https://upload.example.com/%s", diskImage.ID),
+	}, nil
+}
+
+// ListVolumes implemented in a fake way for automated tests
+func (c *FakeClient) ListVolumes() ([]Volume, error) {
+	return c.Volumes, nil
+}
+
+// GetVolume implemented in a fake way for automated tests
+func (c *FakeClient) GetVolume(id string) (*Volume, error) {
+	for _, volume := range c.Volumes {
+		if volume.ID == id {
+			return &volume, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to get volume %s", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindVolume implemented in a fake way for automated tests
+func (c *FakeClient) FindVolume(search string) (*Volume, error) {
+	for _, volume := range c.Volumes {
+		if strings.Contains(volume.Name, search) || strings.Contains(volume.ID, search) {
+			return &volume, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find volume %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// NewVolume implemented in a fake way for automated tests
+func (c *FakeClient) NewVolume(v *VolumeConfig) (*VolumeResult, error) {
+	volume := Volume{
+		ID:            c.generateID(),
+		Name:          v.Name,
+		SizeGigabytes: v.SizeGigabytes,
+		Status:        "available",
+	}
+	c.Volumes = append(c.Volumes, volume)
+
+	return &VolumeResult{
+		ID:     volume.ID,
+		Name:   volume.Name,
+		Result: "success",
+	}, nil
+}
+
+// ResizeVolume implemented in a fake way for automated tests
+func (c *FakeClient) ResizeVolume(id string, size int) (*SimpleResponse, error) {
+	for i, volume := range c.Volumes {
+		if volume.ID == id {
+			c.Volumes[i].SizeGigabytes = size
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find volume %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// AttachVolume implemented in a fake way for automated tests
+func (c *FakeClient) AttachVolume(id string, cfg VolumeAttachConfig) (*SimpleResponse, error) {
+	for i, volume := range c.Volumes {
+		if volume.ID == id {
+			c.Volumes[i].InstanceID = cfg.InstanceID
+			c.Volumes[i].Status = "attached"
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find volume %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DetachVolume implemented in a fake way for automated tests
+func (c *FakeClient) DetachVolume(id string) (*SimpleResponse, error) {
+	for i, volume := range c.Volumes {
+		if volume.ID == id {
+			c.Volumes[i].InstanceID = ""
+			c.Volumes[i].Status = "available"
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find volume %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteVolume implemented in a fake way for automated tests
+func (c *FakeClient) DeleteVolume(id string) (*SimpleResponse, error) {
+	for i, volume := range c.Volumes {
+		if volume.ID == id {
+			c.Volumes[len(c.Volumes)-1], c.Volumes[i] = c.Volumes[i], c.Volumes[len(c.Volumes)-1]
+			c.Volumes = c.Volumes[:len(c.Volumes)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// GetVolumeSnapshotByVolumeID implemented in a fake way for automated tests
+func (c *FakeClient) GetVolumeSnapshotByVolumeID(volumeID, snapshotID string) (*VolumeSnapshot, error) {
+	for _, snapshot := range c.VolumeSnapshots {
+		if snapshot.VolumeID == volumeID && snapshot.SnapshotID == snapshotID {
+			return &snapshot, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find volume snapshot %s, zero matches", snapshotID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// ListVolumeSnapshotsByVolumeID implemented in a fake way for automated tests
 func (c *FakeClient) ListVolumeSnapshotsByVolumeID(volumeID string) ([]VolumeSnapshot, error) {
 	snapshots := make([]VolumeSnapshot, 0)
 	for _, snapshot := range c.VolumeSnapshots {
@@ -1361,111 +2476,1316 @@ func (c *FakeClient) ListVolumeSnapshotsByVolumeID(volumeID string) ([]VolumeSna
 		}
 	}
 
-	return snapshots, nil
+	return snapshots, nil
+}
+
+// CreateVolumeSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) CreateVolumeSnapshot(volumeID string, config *VolumeSnapshotConfig) (*VolumeSnapshot, error) {
+	snapshot := VolumeSnapshot{
+		SnapshotID: c.generateID(),
+		Name:       config.Name,
+		VolumeID:   volumeID,
+		State:      "Ready",
+	}
+	c.VolumeSnapshots = append(c.VolumeSnapshots, snapshot)
+
+	return &snapshot, nil
+}
+
+// DeleteVolumeAndAllSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) DeleteVolumeAndAllSnapshot(volumeID string) (*SimpleResponse, error) {
+	for i, volume := range c.Volumes {
+		if volume.ID == volumeID {
+			c.Volumes[len(c.Volumes)-1], c.Volumes[i] = c.Volumes[i], c.Volumes[len(c.Volumes)-1]
+			c.Volumes = c.Volumes[:len(c.Volumes)-1]
+			break
+		}
+	}
+
+	for i := 0; i < len(c.VolumeSnapshots); i++ {
+		if c.VolumeSnapshots[i].VolumeID == volumeID {
+			c.VolumeSnapshots = append(c.VolumeSnapshots[:i], c.VolumeSnapshots[i+1:]...)
+			i--
+		}
+	}
+
+	return &SimpleResponse{Result: "success"}, nil
+}
+
+// ListVolumeSnapshots implemented in a fake way for automated tests
+func (c *FakeClient) ListVolumeSnapshots() ([]VolumeSnapshot, error) {
+	return c.VolumeSnapshots, nil
+}
+
+// GetVolumeSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) GetVolumeSnapshot(snapshotID string) (*VolumeSnapshot, error) {
+	for _, snapshot := range c.VolumeSnapshots {
+		if snapshot.SnapshotID == snapshotID {
+			return &snapshot, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find volume snapshot %s, zero matches", snapshotID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteVolumeSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) DeleteVolumeSnapshot(snapshotID string) (*SimpleResponse, error) {
+	for i, snapshot := range c.VolumeSnapshots {
+		if snapshot.SnapshotID == snapshotID {
+			c.VolumeSnapshots[len(c.VolumeSnapshots)-1], c.VolumeSnapshots[i] = c.VolumeSnapshots[i], c.VolumeSnapshots[len(c.VolumeSnapshots)-1]
+			c.VolumeSnapshots = c.VolumeSnapshots[:len(c.VolumeSnapshots)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// CreateInstanceSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) CreateInstanceSnapshot(instanceID string, config *InstanceSnapshotConfig) (*InstanceSnapshot, error) {
+	snapshot := InstanceSnapshot{
+		ID:          c.generateID(),
+		Name:        config.Name,
+		Description: config.Description,
+		InstanceID:  instanceID,
+		Status:      "available",
+		CreatedAt:   time.Now(),
+	}
+	c.InstanceSnapshots = append(c.InstanceSnapshots, snapshot)
+
+	return &snapshot, nil
+}
+
+// ListInstanceSnapshots implemented in a fake way for automated tests
+func (c *FakeClient) ListInstanceSnapshots(instanceID string) ([]InstanceSnapshot, error) {
+	snapshots := make([]InstanceSnapshot, 0)
+	for _, snapshot := range c.InstanceSnapshots {
+		if snapshot.InstanceID == instanceID {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	return snapshots, nil
+}
+
+// GetInstanceSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) GetInstanceSnapshot(instanceID, snapshotID string) (*InstanceSnapshot, error) {
+	for _, snapshot := range c.InstanceSnapshots {
+		if snapshot.InstanceID == instanceID && snapshot.ID == snapshotID {
+			return &snapshot, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find instance snapshot %s, zero matches", snapshotID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// RestoreInstanceSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) RestoreInstanceSnapshot(instanceID, snapshotID string) (*SimpleResponse, error) {
+	if _, err := c.GetInstanceSnapshot(instanceID, snapshotID); err != nil {
+		return nil, err
+	}
+
+	return &SimpleResponse{Result: "success"}, nil
+}
+
+// DeleteInstanceSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) DeleteInstanceSnapshot(instanceID, snapshotID string) (*SimpleResponse, error) {
+	for i, snapshot := range c.InstanceSnapshots {
+		if snapshot.InstanceID == instanceID && snapshot.ID == snapshotID {
+			c.InstanceSnapshots[len(c.InstanceSnapshots)-1], c.InstanceSnapshots[i] = c.InstanceSnapshots[i], c.InstanceSnapshots[len(c.InstanceSnapshots)-1]
+			c.InstanceSnapshots = c.InstanceSnapshots[:len(c.InstanceSnapshots)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// CreateSnapshotSchedule implemented in a fake way for automated tests
+func (c *FakeClient) CreateSnapshotSchedule(r *CreateSnapshotScheduleRequest) (*SnapshotSchedule, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	schedule := SnapshotSchedule{
+		ID:             c.generateID(),
+		Name:           r.Name,
+		Description:    r.Description,
+		CronExpression: r.CronExpression,
+		Retention:      r.Retention,
+		Instances:      r.Instances,
+		Status:         "active",
+		CreatedAt:      time.Now(),
+	}
+	c.SnapshotSchedules = append(c.SnapshotSchedules, schedule)
+
+	return &schedule, nil
+}
+
+// ListSnapshotSchedules implemented in a fake way for automated tests
+func (c *FakeClient) ListSnapshotSchedules() ([]SnapshotSchedule, error) {
+	return c.SnapshotSchedules, nil
+}
+
+// GetSnapshotSchedule implemented in a fake way for automated tests
+func (c *FakeClient) GetSnapshotSchedule(id string) (*SnapshotSchedule, error) {
+	for _, schedule := range c.SnapshotSchedules {
+		if schedule.ID == id {
+			return &schedule, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find snapshot schedule %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// UpdateSnapshotSchedule implemented in a fake way for automated tests
+func (c *FakeClient) UpdateSnapshotSchedule(id string, r *UpdateSnapshotScheduleRequest) (*SnapshotSchedule, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	for i, schedule := range c.SnapshotSchedules {
+		if schedule.ID == id {
+			if r.Name != "" {
+				c.SnapshotSchedules[i].Name = r.Name
+			}
+			if r.Description != "" {
+				c.SnapshotSchedules[i].Description = r.Description
+			}
+			if r.CronExpression != "" {
+				c.SnapshotSchedules[i].CronExpression = r.CronExpression
+			}
+			if r.Paused != nil {
+				c.SnapshotSchedules[i].Paused = *r.Paused
+			}
+			if r.Retention != nil {
+				c.SnapshotSchedules[i].Retention = *r.Retention
+			}
+			if len(r.Instances) > 0 {
+				c.SnapshotSchedules[i].Instances = r.Instances
+			}
+			return &c.SnapshotSchedules[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find snapshot schedule %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteSnapshotSchedule implemented in a fake way for automated tests
+func (c *FakeClient) DeleteSnapshotSchedule(id string) (*SimpleResponse, error) {
+	for i, schedule := range c.SnapshotSchedules {
+		if schedule.ID == id {
+			c.SnapshotSchedules[len(c.SnapshotSchedules)-1], c.SnapshotSchedules[i] = c.SnapshotSchedules[i], c.SnapshotSchedules[len(c.SnapshotSchedules)-1]
+			c.SnapshotSchedules = c.SnapshotSchedules[:len(c.SnapshotSchedules)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// CreateWebhook implemented in a fake way for automated tests
+func (c *FakeClient) CreateWebhook(r *WebhookConfig) (*Webhook, error) {
+	if r.URL == "" {
+		err := fmt.Errorf("a URL is needed to create a webhook")
+		return nil, InvalidWebhookConfigError.wrap(err)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	webhook := Webhook{
+		ID:     c.generateID(),
+		Events: r.Events,
+		Secret: r.Secret,
+		URL:    r.URL,
+	}
+	c.Webhooks = append(c.Webhooks, webhook)
+
+	return &webhook, nil
+}
+
+// ListWebhooks implemented in a fake way for automated tests
+func (c *FakeClient) ListWebhooks() ([]Webhook, error) {
+	return c.Webhooks, nil
+}
+
+// FindWebhook implemented in a fake way for automated tests
+func (c *FakeClient) FindWebhook(search string) (*Webhook, error) {
+	for _, webhook := range c.Webhooks {
+		if strings.Contains(webhook.Secret, search) || strings.Contains(webhook.URL, search) {
+			return &webhook, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// UpdateWebhook implemented in a fake way for automated tests
+func (c *FakeClient) UpdateWebhook(id string, r *WebhookConfig) (*Webhook, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	for i, webhook := range c.Webhooks {
+		if webhook.ID == id {
+			c.Webhooks[i].Events = r.Events
+			c.Webhooks[i].Secret = r.Secret
+			c.Webhooks[i].URL = r.URL
+
+			updated := c.Webhooks[i]
+			return &updated, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteWebhook implemented in a fake way for automated tests
+func (c *FakeClient) DeleteWebhook(id string) (*SimpleResponse, error) {
+	for i, webhook := range c.Webhooks {
+		if webhook.ID == id {
+			c.Webhooks[len(c.Webhooks)-1], c.Webhooks[i] = c.Webhooks[i], c.Webhooks[len(c.Webhooks)-1]
+			c.Webhooks = c.Webhooks[:len(c.Webhooks)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// ListPermissions implemented in a fake way for automated tests
+func (c *FakeClient) ListPermissions() ([]Permission, error) {
+	return []Permission{
+		{
+			Name:        "instance.create",
+			Description: "Create Compute instances",
+		},
+		{
+			Name:        "kubernetes.*",
+			Description: "Manage Civo Kubernetes clusters",
+		},
+	}, nil
+}
+
+// GetOrganisation implemented in a fake way for automated tests
+func (c *FakeClient) GetOrganisation() (*Organisation, error) {
+	return &c.Organisation, nil
+}
+
+// CreateOrganisation implemented in a fake way for automated tests
+func (c *FakeClient) CreateOrganisation(name string) (*Organisation, error) {
+	c.Organisation.ID = c.generateID()
+	c.Organisation.Name = name
+	return &c.Organisation, nil
+}
+
+// RenameOrganisation implemented in a fake way for automated tests
+func (c *FakeClient) RenameOrganisation(name string) (*Organisation, error) {
+	c.Organisation.Name = name
+	return &c.Organisation, nil
+}
+
+// AddAccountToOrganisation implemented in a fake way for automated tests, the current account
+// joins the organisation if the ID matches
+func (c *FakeClient) AddAccountToOrganisation(organisationID, organisationToken string) ([]Account, error) {
+	if c.Organisation.ID == "" || c.Organisation.ID != organisationID {
+		err := fmt.Errorf("unable to find organisation %s, zero matches", organisationID)
+		return nil, ZeroMatchesError.wrap(err)
+	}
+
+	account := c.Account
+	if account.ID == "" {
+		account.ID = c.generateID()
+	}
+	account.CreatedAt = time.Now()
+	account.UpdatedAt = time.Now()
+	c.OrganisationAccounts = append(c.OrganisationAccounts, account)
+	return c.ListAccountsInOrganisation()
+}
+
+// ListAccountsInOrganisation implemented in a fake way for automated tests
+func (c *FakeClient) ListAccountsInOrganisation() ([]Account, error) {
+	return c.OrganisationAccounts, nil
+}
+
+// GetAccount implemented in a fake way for automated tests
+func (c *FakeClient) GetAccount() (*Account, error) {
+	if c.Account.ID == "" {
+		err := fmt.Errorf("unable to find the current account, zero matches")
+		return nil, ZeroMatchesError.wrap(err)
+	}
+
+	return &c.Account, nil
+}
+
+// ListAPIKeys implemented in a fake way for automated tests
+func (c *FakeClient) ListAPIKeys() ([]APIKey, error) {
+	keys := make([]APIKey, 0, len(c.APIKeys))
+	for _, key := range c.APIKeys {
+		key.Key = ""
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// CreateAPIKey implemented in a fake way for automated tests
+func (c *FakeClient) CreateAPIKey(k *CreateAPIKeyConfig) (*APIKey, error) {
+	if err := k.Validate(); err != nil {
+		return nil, err
+	}
+
+	key := APIKey{
+		ID:          c.generateID(),
+		Name:        k.Name,
+		Key:         c.generateID(),
+		Permissions: k.Permissions,
+		ExpiresAt:   k.ExpiresAt,
+		CreatedAt:   time.Now(),
+	}
+	c.APIKeys = append(c.APIKeys, key)
+
+	return &key, nil
+}
+
+// RevokeAPIKey implemented in a fake way for automated tests
+func (c *FakeClient) RevokeAPIKey(id string) (*SimpleResponse, error) {
+	for i, key := range c.APIKeys {
+		if key.ID == id {
+			c.APIKeys = append(c.APIKeys[:i], c.APIKeys[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// ListRoles implemented in a fake way for automated tests
+func (c *FakeClient) ListRoles() ([]Role, error) {
+	return c.OrganisationRoles, nil
+}
+
+// CreateRole implemented in a fake way for automated tests
+func (c *FakeClient) CreateRole(name, permissions string) (*Role, error) {
+	if err := validateRolePermissions(permissions); err != nil {
+		return nil, err
+	}
+
+	role := Role{
+		ID:          c.generateID(),
+		Name:        name,
+		Permissions: permissions,
+	}
+	c.OrganisationRoles = append(c.OrganisationRoles, role)
+	return &role, nil
+}
+
+// DeleteRole implemented in a fake way for automated tests
+func (c *FakeClient) DeleteRole(id string) (*SimpleResponse, error) {
+	for i, role := range c.OrganisationRoles {
+		if role.ID == id {
+			c.OrganisationRoles[len(c.OrganisationRoles)-1], c.OrganisationRoles[i] = c.OrganisationRoles[i], c.OrganisationRoles[len(c.OrganisationRoles)-1]
+			c.OrganisationRoles = c.OrganisationRoles[:len(c.OrganisationRoles)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, fmt.Errorf("unable to find that role")
+}
+
+// ListTeams implemented in a fake way for automated tests
+func (c *FakeClient) ListTeams() ([]Team, error) {
+	return c.OrganisationTeams, nil
+}
+
+// CreateTeam implemented in a fake way for automated tests
+func (c *FakeClient) CreateTeam(name string) (*Team, error) {
+	team := Team{
+		ID:        c.generateID(),
+		Name:      name,
+		CreatedAt: time.Time{},
+		UpdatedAt: time.Time{},
+	}
+	c.OrganisationTeams = append(c.OrganisationTeams, team)
+	return &team, nil
+}
+
+// RenameTeam implemented in a fake way for automated tests
+func (c *FakeClient) RenameTeam(teamID, name string) (*Team, error) {
+	for i, team := range c.OrganisationTeams {
+		if team.ID == teamID {
+			c.OrganisationTeams[i].Name = name
+			return &c.OrganisationTeams[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find team %s, zero matches", teamID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindTeam implemented in a fake way for automated tests
+func (c *FakeClient) FindTeam(search string) (*Team, error) {
+	for _, team := range c.OrganisationTeams {
+		if team.ID == search || team.Name == search {
+			return &team, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s team, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteTeam implemented in a fake way for automated tests
+func (c *FakeClient) DeleteTeam(id string) (*SimpleResponse, error) {
+	for i, team := range c.OrganisationTeams {
+		if team.ID == id {
+			c.OrganisationTeams[len(c.OrganisationTeams)-1], c.OrganisationTeams[i] = c.OrganisationTeams[i], c.OrganisationTeams[len(c.OrganisationTeams)-1]
+			c.OrganisationTeams = c.OrganisationTeams[:len(c.OrganisationTeams)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failure"}, fmt.Errorf("unable to find that team")
+}
+
+// ListTeamMembers implemented in a fake way for automated tests
+func (c *FakeClient) ListTeamMembers(teamID string) ([]TeamMember, error) {
+	return c.OrganisationTeamMembers[teamID], nil
+}
+
+// AddTeamMember implemented in a fake way for automated tests
+func (c *FakeClient) AddTeamMember(teamID, userID, permissions, roles string) ([]TeamMember, error) {
+	if c.OrganisationTeamMembers == nil {
+		c.OrganisationTeamMembers = map[string][]TeamMember{}
+	}
+	c.OrganisationTeamMembers[teamID] = append(c.OrganisationTeamMembers[teamID], TeamMember{
+		ID:          c.generateID(),
+		TeamID:      teamID,
+		UserID:      userID,
+		Permissions: permissions,
+		Roles:       roles,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	})
+
+	return c.ListTeamMembers(teamID)
+}
+
+// UpdateTeamMember implemented in a fake way for automated tests
+func (c *FakeClient) UpdateTeamMember(teamID, teamMemberID, permissions, roles string) (*TeamMember, error) {
+	members := c.OrganisationTeamMembers[teamID]
+	for i, teamMember := range members {
+		if teamMember.ID == teamMemberID {
+			members[i].Permissions = permissions
+			members[i].Roles = roles
+			return &members[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find team member %s, zero matches", teamMemberID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// RemoveTeamMember implemented in a fake way for automated tests
+func (c *FakeClient) RemoveTeamMember(teamID, teamMemberID string) (*SimpleResponse, error) {
+	for i, teamMember := range c.OrganisationTeamMembers[teamID] {
+		if teamMember.ID == teamMemberID {
+			c.OrganisationTeamMembers[teamID][len(c.OrganisationTeamMembers[teamID])-1], c.OrganisationTeamMembers[teamID][i] = c.OrganisationTeamMembers[teamID][i], c.OrganisationTeamMembers[teamID][len(c.OrganisationTeamMembers[teamID])-1]
+			c.OrganisationTeamMembers[teamID] = c.OrganisationTeamMembers[teamID][:len(c.OrganisationTeamMembers[teamID])-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failure"}, fmt.Errorf("unable to find that team member")
+}
+
+// ListLoadBalancers implemented in a fake way for automated tests
+func (c *FakeClient) ListLoadBalancers() ([]LoadBalancer, error) {
+	return c.LoadBalancers, nil
+}
+
+// GetLoadBalancer implemented in a fake way for automated tests
+func (c *FakeClient) GetLoadBalancer(id string) (*LoadBalancer, error) {
+	for _, lb := range c.LoadBalancers {
+		if lb.ID == id {
+			return &lb, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to get load balancer %s", id)
+	return nil, DatabaseLoadBalancerNotFoundError.wrap(err)
+}
+
+// FindLoadBalancer implemented in a fake way for automated tests
+func (c *FakeClient) FindLoadBalancer(search string) (*LoadBalancer, error) {
+	exactMatch := false
+	partialMatchesCount := 0
+	result := LoadBalancer{}
+
+	for _, lb := range c.LoadBalancers {
+		if lb.ID == search || lb.Name == search {
+			exactMatch = true
+			result = lb
+		} else if strings.Contains(lb.Name, search) || strings.Contains(lb.ID, search) {
+			if !exactMatch {
+				result = lb
+				partialMatchesCount++
+			}
+		}
+	}
+
+	if exactMatch || partialMatchesCount == 1 {
+		return &result, nil
+	} else if partialMatchesCount > 1 {
+		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
+		return nil, MultipleMatchesError.wrap(err)
+	} else {
+		err := fmt.Errorf("unable to find %s, zero matches", search)
+		return nil, ZeroMatchesError.wrap(err)
+	}
+}
+
+// CreateLoadBalancer implemented in a fake way for automated tests
+func (c *FakeClient) CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	loadbalancer := LoadBalancer{
+		ID:                           c.generateID(),
+		Name:                         r.Name,
+		Algorithm:                    r.Algorithm,
+		ExternalTrafficPolicy:        r.ExternalTrafficPolicy,
+		SessionAffinityConfigTimeout: r.SessionAffinityConfigTimeout,
+		SessionAffinity:              r.SessionAffinity,
+		EnableProxyProtocol:          r.EnableProxyProtocol,
+		FirewallID:                   r.FirewallID,
+		ClusterID:                    r.ClusterID,
+		RedirectHTTPToHTTPS:          r.RedirectHTTPToHTTPS,
+	}
+
+	if r.Algorithm == "" {
+		loadbalancer.Algorithm = LoadBalancerAlgorithmRoundRobin
+	}
+	if r.FirewallID == "" {
+		loadbalancer.FirewallID = c.generateID()
+	}
+	if r.ExternalTrafficPolicy == "" {
+		loadbalancer.ExternalTrafficPolicy = LoadBalancerExternalTrafficPolicyCluster
+	}
+
+	backends := make([]LoadBalancerBackend, 0)
+	for _, b := range r.Backends {
+		backend := LoadBalancerBackend{
+			InstanceID:       b.InstanceID,
+			IP:               b.IP,
+			Protocol:         b.Protocol,
+			SourcePort:       b.SourcePort,
+			TargetPort:       b.TargetPort,
+			HealthCheck:      b.HealthCheck,
+			TLSCertificateID: b.TLSCertificateID,
+		}
+		backends = append(backends, backend)
+	}
+	loadbalancer.Backends = backends
+	loadbalancer.PublicIP = c.generatePublicIP()
+	loadbalancer.State = "available"
+
+	c.LoadBalancers = append(c.LoadBalancers, loadbalancer)
+	return &loadbalancer, nil
+}
+
+// UpdateLoadBalancer implemented in a fake way for automated tests
+func (c *FakeClient) UpdateLoadBalancer(id string, r *LoadBalancerUpdateConfig) (*LoadBalancer, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	for i, lb := range c.LoadBalancers {
+		if lb.ID == id {
+			lb.Name = r.Name
+			lb.Algorithm = r.Algorithm
+			lb.EnableProxyProtocol = r.EnableProxyProtocol
+			lb.ExternalTrafficPolicy = r.ExternalTrafficPolicy
+			lb.SessionAffinity = r.SessionAffinity
+			lb.SessionAffinityConfigTimeout = r.SessionAffinityConfigTimeout
+			if r.FirewallID != "" {
+				lb.FirewallID = r.FirewallID
+			}
+			if r.RedirectHTTPToHTTPS != nil {
+				lb.RedirectHTTPToHTTPS = *r.RedirectHTTPToHTTPS
+			}
+
+			backends := make([]LoadBalancerBackend, len(r.Backends))
+			for i, b := range r.Backends {
+				backends[i].InstanceID = b.InstanceID
+				backends[i].IP = b.IP
+				backends[i].Protocol = b.Protocol
+				backends[i].SourcePort = b.SourcePort
+				backends[i].TargetPort = b.TargetPort
+				backends[i].HealthCheck = b.HealthCheck
+				backends[i].TLSCertificateID = b.TLSCertificateID
+			}
+			lb.Backends = backends
+
+			if r.Algorithm == "" {
+				lb.Algorithm = LoadBalancerAlgorithmRoundRobin
+			}
+			if r.ExternalTrafficPolicy == "" {
+				lb.ExternalTrafficPolicy = LoadBalancerExternalTrafficPolicyCluster
+			}
+
+			c.LoadBalancers[i] = lb
+			return &lb, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find load balancer %s", id)
+	return nil, DatabaseLoadBalancerNotFoundError.wrap(err)
+}
+
+// DeleteLoadBalancer implemented in a fake way for automated tests
+func (c *FakeClient) DeleteLoadBalancer(id string) (*SimpleResponse, error) {
+	for i, lb := range c.LoadBalancers {
+		if lb.ID == id {
+			c.LoadBalancers[len(c.LoadBalancers)-1], c.LoadBalancers[i] = c.LoadBalancers[i], c.LoadBalancers[len(c.LoadBalancers)-1]
+			c.LoadBalancers = c.LoadBalancers[:len(c.LoadBalancers)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// GetLoadBalancerHealth implemented in a fake way for automated tests, every backend is reported healthy
+func (c *FakeClient) GetLoadBalancerHealth(id string) (*LoadBalancerHealth, error) {
+	lb, err := c.GetLoadBalancer(id)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &LoadBalancerHealth{ID: lb.ID, State: lb.State, Backends: make([]LoadBalancerBackendHealth, 0, len(lb.Backends))}
+	for _, b := range lb.Backends {
+		health.Backends = append(health.Backends, LoadBalancerBackendHealth{
+			InstanceID: b.InstanceID,
+			IP:         b.IP,
+			SourcePort: b.SourcePort,
+			TargetPort: b.TargetPort,
+			State:      LoadBalancerBackendHealthy,
+		})
+	}
+
+	return health, nil
+}
+
+// ListLoadBalancerCertificates implemented in a fake way for automated tests
+func (c *FakeClient) ListLoadBalancerCertificates() ([]LoadBalancerCertificate, error) {
+	return c.Certificates, nil
+}
+
+// UploadLoadBalancerCertificate implemented in a fake way for automated tests
+func (c *FakeClient) UploadLoadBalancerCertificate(r *LoadBalancerCertificateConfig) (*LoadBalancerCertificate, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	certificate := LoadBalancerCertificate{
+		ID:        c.generateID(),
+		Name:      r.Name,
+		CreatedAt: time.Now(),
+	}
+	if block, _ := pem.Decode([]byte(r.Certificate)); block != nil {
+		if leaf, err := x509.ParseCertificate(block.Bytes); err == nil {
+			certificate.Domains = leaf.DNSNames
+			certificate.NotAfter = leaf.NotAfter
+		}
+	}
+
+	c.Certificates = append(c.Certificates, certificate)
+	return &certificate, nil
+}
+
+// DeleteLoadBalancerCertificate implemented in a fake way for automated tests
+func (c *FakeClient) DeleteLoadBalancerCertificate(id string) (*SimpleResponse, error) {
+	for i, certificate := range c.Certificates {
+		if certificate.ID == id {
+			c.Certificates = append(c.Certificates[:i], c.Certificates[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find certificate %s", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// ListKubernetesClusterPools implemented in a fake way for automated tests
+func (c *FakeClient) ListKubernetesClusterPools(cid string) ([]KubernetesPool, error) {
+	pools := []KubernetesPool{}
+	found := false
+
+	for _, cs := range c.Clusters {
+		if cs.ID == cid {
+			found = true
+			pools = cs.Pools
+			break
+		}
+	}
+
+	if found {
+		return pools, nil
+	}
+
+	err := fmt.Errorf("unable to get kubernetes cluster %s", cid)
+	return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+}
+
+// GetKubernetesClusterPool implemented in a fake way for automated tests
+func (c *FakeClient) GetKubernetesClusterPool(cid, pid string) (*KubernetesPool, error) {
+	pool := &KubernetesPool{}
+	clusterFound := false
+	poolFound := false
+
+	for _, cs := range c.Clusters {
+		if cs.ID == cid {
+			clusterFound = true
+			for _, p := range cs.Pools {
+				if p.ID == pid {
+					poolFound = true
+					pool = &p
+					break
+				}
+			}
+		}
+	}
+
+	if !clusterFound {
+		err := fmt.Errorf("unable to get kubernetes cluster %s", cid)
+		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	}
+
+	if !poolFound {
+		err := fmt.Errorf("unable to get kubernetes pool %s", pid)
+		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	}
+
+	return pool, nil
+}
+
+// FindKubernetesClusterPool implemented in a fake way for automated tests
+func (c *FakeClient) FindKubernetesClusterPool(cid, search string) (*KubernetesPool, error) {
+	pool := &KubernetesPool{}
+	clusterFound := false
+	poolFound := false
+
+	for _, cs := range c.Clusters {
+		if cs.ID == cid {
+			clusterFound = true
+			for _, p := range cs.Pools {
+				if p.ID == search || strings.Contains(p.ID, search) {
+					poolFound = true
+					pool = &p
+					break
+				}
+			}
+		}
+	}
+
+	if !clusterFound {
+		err := fmt.Errorf("unable to get kubernetes cluster %s", cid)
+		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	}
+
+	if !poolFound {
+		err := fmt.Errorf("unable to get kubernetes pool %s", search)
+		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	}
+
+	return pool, nil
+}
+
+// DeleteKubernetesClusterPoolInstance implemented in a fake way for automated tests
+func (c *FakeClient) DeleteKubernetesClusterPoolInstance(cid, pid, id string) (*SimpleResponse, error) {
+	clusterFound := false
+	poolFound := false
+	instanceFound := false
+
+	for ci, cs := range c.Clusters {
+		if cs.ID == cid {
+			clusterFound = true
+			for pi, p := range cs.Pools {
+				if p.ID == pid {
+					poolFound = true
+					for i, in := range p.Instances {
+						if in.ID == id {
+							instanceFound = true
+							p.Instances = append(p.Instances[:i], p.Instances[i+1:]...)
+
+							instanceNames := []string{}
+							for _, in := range p.Instances {
+								instanceNames = append(instanceNames, in.Hostname)
+							}
+							p.InstanceNames = instanceNames
+							c.Clusters[ci].Pools[pi] = p
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if !clusterFound {
+		err := fmt.Errorf("unable to get kubernetes cluster %s", cid)
+		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	}
+
+	if !poolFound {
+		err := fmt.Errorf("unable to get kubernetes pool %s", pid)
+		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	}
+
+	if !instanceFound {
+		err := fmt.Errorf("unable to get kubernetes pool instance %s", id)
+		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	}
+
+	return &SimpleResponse{
+		Result: "success",
+	}, nil
+}
+
+// UpdateKubernetesClusterPool implemented in a fake way for automated tests
+func (c *FakeClient) UpdateKubernetesClusterPool(cid, pid string, config *KubernetesClusterPoolUpdateConfig) (*KubernetesPool, error) {
+	clusterFound := false
+	poolFound := false
+
+	pool := KubernetesPool{}
+	for ci, cs := range c.Clusters {
+		if cs.ID == cid {
+			clusterFound = true
+			for pi, p := range cs.Pools {
+				if p.ID == pid {
+					poolFound = true
+					if config.Count != nil {
+						p.Count = *config.Count
+					}
+					if config.Size != "" {
+						p.Size = config.Size
+					}
+					if config.Autoscaler != nil {
+						p.Autoscaler = config.Autoscaler
+					}
+					if config.Labels != nil {
+						p.Labels = config.Labels
+					}
+					if config.Taints != nil {
+						p.Taints = config.Taints
+					}
+					c.Clusters[ci].Pools[pi] = p
+					pool = p
+				}
+			}
+		}
+	}
+
+	if !clusterFound {
+		err := fmt.Errorf("unable to get kubernetes cluster %s", cid)
+		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	}
+
+	if !poolFound {
+		err := fmt.Errorf("unable to get kubernetes pool %s", pid)
+		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	}
+
+	return &pool, nil
+}
+
+// CreateKubernetesClusterPool implemented in a fake way for automated tests
+func (c *FakeClient) CreateKubernetesClusterPool(id string, i *KubernetesClusterPoolConfig) (*SimpleResponse, error) {
+	for ci, cs := range c.Clusters {
+		if cs.ID == id {
+			poolID := i.ID
+			if poolID == "" {
+				poolID = c.generateID()
+			}
+
+			c.Clusters[ci].Pools = append(c.Clusters[ci].Pools, KubernetesPool{
+				ID:               poolID,
+				Count:            i.Count,
+				Size:             i.Size,
+				Labels:           i.Labels,
+				Taints:           i.Taints,
+				PublicIPNodePool: i.PublicIPNodePool,
+			})
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to get kubernetes cluster %s", id)
+	return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+}
+
+// DeleteKubernetesClusterPool implemented in a fake way for automated tests
+func (c *FakeClient) DeleteKubernetesClusterPool(id, poolID string) (*SimpleResponse, error) {
+	for ci, cs := range c.Clusters {
+		if cs.ID == id {
+			for pi, p := range cs.Pools {
+				if p.ID == poolID {
+					c.Clusters[ci].Pools = append(cs.Pools[:pi], cs.Pools[pi+1:]...)
+					return &SimpleResponse{Result: "success"}, nil
+				}
+			}
+
+			err := fmt.Errorf("unable to get kubernetes pool %s", poolID)
+			return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+		}
+	}
+
+	err := fmt.Errorf("unable to get kubernetes cluster %s", id)
+	return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+}
+
+// SetKubernetesClusterPoolAutoscaling implemented in a fake way for automated tests
+func (c *FakeClient) SetKubernetesClusterPoolAutoscaling(cid, pid string, minNodes, maxNodes int) (*KubernetesPool, error) {
+	return c.UpdateKubernetesClusterPool(cid, pid, &KubernetesClusterPoolUpdateConfig{
+		Autoscaler: &KubernetesPoolAutoscaler{Enabled: true, MinNodes: minNodes, MaxNodes: maxNodes},
+	})
+}
+
+// DisableKubernetesClusterPoolAutoscaling implemented in a fake way for automated tests
+func (c *FakeClient) DisableKubernetesClusterPoolAutoscaling(cid, pid string) (*KubernetesPool, error) {
+	return c.UpdateKubernetesClusterPool(cid, pid, &KubernetesClusterPoolUpdateConfig{
+		Autoscaler: &KubernetesPoolAutoscaler{Enabled: false},
+	})
+}
+
+// ListIPs returns a list of fake IPs
+func (c *FakeClient) ListIPs() (*PaginatedIPs, error) {
+	return &PaginatedIPs{
+		Page:    1,
+		PerPage: 20,
+		Pages:   1,
+		Items:   append([]IP{}, c.IP...),
+	}, nil
+}
+
+// GetIP returns a fake IP
+func (c *FakeClient) GetIP(id string) (*IP, error) {
+	for _, ip := range c.IP {
+		if ip.ID == id {
+			return &ip, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindIP finds a fake IP
+func (c *FakeClient) FindIP(search string) (*IP, error) {
+	for _, ip := range c.IP {
+		if ip.ID == search || ip.IP == search || strings.Contains(ip.Name, search) {
+			return &ip, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// NewIP creates a fake IP
+func (c *FakeClient) NewIP(v *CreateIPRequest) (*IP, error) {
+	ip := IP{
+		ID:   c.generateID(),
+		Name: v.Name,
+		IP:   c.generatePublicIP(),
+	}
+	if ip.Name == "" {
+		ip.Name = ip.IP
+	}
+	c.IP = append(c.IP, ip)
+
+	return &ip, nil
+}
+
+// UpdateIP updates a fake IP
+func (c *FakeClient) UpdateIP(id string, v *UpdateIPRequest) (*IP, error) {
+	for i, ip := range c.IP {
+		if ip.ID == id {
+			c.IP[i].Name = v.Name
+			return &c.IP[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteIP deletes a fake IP
+func (c *FakeClient) DeleteIP(id string) (*SimpleResponse, error) {
+	for i, ip := range c.IP {
+		if ip.ID == id {
+			c.IP = append(c.IP[:i], c.IP[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// AssignIP assigns a fake IP
+func (c *FakeClient) AssignIP(id, resourceID, resourceType, region string) (*SimpleResponse, error) {
+	for i, ip := range c.IP {
+		if ip.ID == id {
+			c.IP[i].AssignedTo = AssignedTo{ID: resourceID, Type: resourceType}
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// UnassignIP unassigns a fake IP
+func (c *FakeClient) UnassignIP(id, region string) (*SimpleResponse, error) {
+	for i, ip := range c.IP {
+		if ip.ID == id {
+			c.IP[i].AssignedTo = AssignedTo{}
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// ListFirewallRulesWithFilter implemented in a fake way for automated tests
+func (c *FakeClient) ListFirewallRulesWithFilter(id string, filter *FirewallRuleFilter) (*PaginatedFirewallRules, error) {
+	if filter == nil {
+		filter = &FirewallRuleFilter{}
+	}
+
+	rules, err := c.ListFirewallRules(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginateFirewallRules(filterFirewallRules(rules, filter), filter.Page, filter.PerPage), nil
+}
+
+// ListFirewallAttachments implemented in a fake way for automated tests
+func (c *FakeClient) ListFirewallAttachments(id string) (*FirewallAttachments, error) {
+	attachments := &FirewallAttachments{
+		Instances:          []Instance{},
+		KubernetesClusters: []KubernetesCluster{},
+		LoadBalancers:      []LoadBalancer{},
+	}
+
+	for _, instance := range c.Instances {
+		if instance.FirewallID == id {
+			attachments.Instances = append(attachments.Instances, instance)
+		}
+	}
+	for _, cluster := range c.Clusters {
+		if cluster.FirewallID == id {
+			attachments.KubernetesClusters = append(attachments.KubernetesClusters, cluster)
+		}
+	}
+	for _, loadbalancer := range c.LoadBalancers {
+		if loadbalancer.FirewallID == id {
+			attachments.LoadBalancers = append(attachments.LoadBalancers, loadbalancer)
+		}
+	}
+
+	return attachments, nil
 }
 
-// CreateVolumeSnapshot implemented in a fake way for automated tests
-func (c *FakeClient) CreateVolumeSnapshot(volumeID string, config *VolumeSnapshotConfig) (*VolumeSnapshot, error) {
-	snapshot := VolumeSnapshot{
-		SnapshotID: c.generateID(),
-		Name:       config.Name,
-		VolumeID:   volumeID,
-		State:      "Ready",
+// IsUsingDefaultRules implemented in a fake way for automated tests
+func (c *FakeClient) IsUsingDefaultRules(firewallID string) (bool, error) {
+	rules, err := c.ListFirewallRules(firewallID)
+	if err != nil {
+		return false, err
 	}
-	c.VolumeSnapshots = append(c.VolumeSnapshots, snapshot)
 
-	return &snapshot, nil
+	return areDefaultRules(rules, defaultFirewallRules), nil
 }
 
-// DeleteVolumeAndAllSnapshot implemented in a fake way for automated tests
-func (c *FakeClient) DeleteVolumeAndAllSnapshot(volumeID string) (*SimpleResponse, error) {
-	for i, volume := range c.Volumes {
-		if volume.ID == volumeID {
-			c.Volumes[len(c.Volumes)-1], c.Volumes[i] = c.Volumes[i], c.Volumes[len(c.Volumes)-1]
-			c.Volumes = c.Volumes[:len(c.Volumes)-1]
-			break
-		}
+// ListInstancesWithFilter implemented in a fake way for automated tests
+func (c *FakeClient) ListInstancesWithFilter(filter *InstanceFilter) (*PaginatedInstanceList, error) {
+	if filter == nil {
+		filter = &InstanceFilter{}
 	}
 
-	for i := 0; i < len(c.VolumeSnapshots); i++ {
-		if c.VolumeSnapshots[i].VolumeID == volumeID {
-			c.VolumeSnapshots = append(c.VolumeSnapshots[:i], c.VolumeSnapshots[i+1:]...)
-			i--
+	filtered := make([]Instance, 0, len(c.Instances))
+	for _, instance := range c.Instances {
+		if filter.matches(instance) && (filter.Region == "" || instance.Region == "" || strings.EqualFold(instance.Region, filter.Region)) {
+			filtered = append(filtered, instance)
 		}
 	}
 
-	return &SimpleResponse{Result: "success"}, nil
+	return &PaginatedInstanceList{
+		Items:   filtered,
+		Page:    filter.Page,
+		PerPage: filter.PerPage,
+		Pages:   filter.Page,
+	}, nil
 }
 
-// ListVolumeSnapshots implemented in a fake way for automated tests
-func (c *FakeClient) ListVolumeSnapshots() ([]VolumeSnapshot, error) {
-	return c.VolumeSnapshots, nil
+// CreateInstanceFromVolume implemented in a fake way for automated tests
+func (c *FakeClient) CreateInstanceFromVolume(volumeID string, config InstanceFromVolumeConfig) (*Instance, error) {
+	volume, err := c.GetVolume(volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !volume.Bootable {
+		err := fmt.Errorf("the volume %s is not bootable", volume.ID)
+		return nil, VolumeNotBootableError.wrap(err)
+	}
+
+	if volume.InstanceID != "" || volume.Status == "attached" {
+		err := fmt.Errorf("the volume %s is already attached to an instance", volume.ID)
+		return nil, VolumeAlreadyAttachedError.wrap(err)
+	}
+
+	networkID := config.NetworkID
+	if networkID == "" {
+		networkID = volume.NetworkID
+	}
+
+	instance, err := c.CreateInstance(&InstanceConfig{
+		Count:       1,
+		Hostname:    config.Hostname,
+		Size:        config.Size,
+		NetworkID:   networkID,
+		SourceType:  "volume",
+		SourceID:    volume.ID,
+		InitialUser: config.InitialUser,
+		SSHKeyID:    config.SSHKeyID,
+		Tags:        config.Tags,
+		FirewallID:  config.FirewallID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.AttachVolume(volume.ID, VolumeAttachConfig{InstanceID: instance.ID})
+	return instance, err
 }
 
-// GetVolumeSnapshot implemented in a fake way for automated tests
-func (c *FakeClient) GetVolumeSnapshot(snapshotID string) (*VolumeSnapshot, error) {
-	for _, snapshot := range c.VolumeSnapshots {
-		if snapshot.SnapshotID == snapshotID {
-			return &snapshot, nil
+// AddInstanceTags implemented in a fake way for automated tests
+func (c *FakeClient) AddInstanceTags(id string, tags []string) (*SimpleResponse, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := append([]string{}, instance.Tags...)
+	for _, tag := range tags {
+		if !findString(merged, tag) {
+			merged = append(merged, tag)
 		}
 	}
 
-	err := fmt.Errorf("unable to find volume snapshot %s, zero matches", snapshotID)
-	return nil, ZeroMatchesError.wrap(err)
+	return c.SetInstanceTags(instance, strings.Join(merged, " "))
 }
 
-// DeleteVolumeSnapshot implemented in a fake way for automated tests
-func (c *FakeClient) DeleteVolumeSnapshot(snapshotID string) (*SimpleResponse, error) {
-	for i, snapshot := range c.VolumeSnapshots {
-		if snapshot.SnapshotID == snapshotID {
-			c.VolumeSnapshots[len(c.VolumeSnapshots)-1], c.VolumeSnapshots[i] = c.VolumeSnapshots[i], c.VolumeSnapshots[len(c.VolumeSnapshots)-1]
-			c.VolumeSnapshots = c.VolumeSnapshots[:len(c.VolumeSnapshots)-1]
-			return &SimpleResponse{Result: "success"}, nil
+// RemoveInstanceTags implemented in a fake way for automated tests
+func (c *FakeClient) RemoveInstanceTags(id string, tags []string) (*SimpleResponse, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := []string{}
+	for _, tag := range instance.Tags {
+		if !findString(tags, tag) {
+			remaining = append(remaining, tag)
 		}
 	}
 
-	return &SimpleResponse{Result: "failed"}, nil
+	return c.SetInstanceTags(instance, strings.Join(remaining, " "))
 }
 
-// CreateWebhook implemented in a fake way for automated tests
-func (c *FakeClient) CreateWebhook(r *WebhookConfig) (*Webhook, error) {
-	webhook := Webhook{
-		ID:     c.generateID(),
-		Events: r.Events,
-		Secret: r.Secret,
-		URL:    r.URL,
+// ReplaceInstanceTags implemented in a fake way for automated tests
+func (c *FakeClient) ReplaceInstanceTags(id string, tags []string) (*SimpleResponse, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
 	}
-	c.Webhooks = append(c.Webhooks, webhook)
 
-	return &webhook, nil
+	return c.SetInstanceTags(instance, strings.Join(tags, " "))
 }
 
-// ListWebhooks implemented in a fake way for automated tests
-func (c *FakeClient) ListWebhooks() ([]Webhook, error) {
-	return c.Webhooks, nil
-}
+// ResizeInstance implemented in a fake way for automated tests
+func (c *FakeClient) ResizeInstance(id, newSize string) (*SimpleResponse, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
 
-// FindWebhook implemented in a fake way for automated tests
-func (c *FakeClient) FindWebhook(search string) (*Webhook, error) {
-	for _, webhook := range c.Webhooks {
-		if strings.Contains(webhook.Secret, search) || strings.Contains(webhook.URL, search) {
-			return &webhook, nil
+	var current, target *InstanceSize
+	for i, size := range c.InstanceSizes {
+		if size.Name == instance.Size {
+			current = &c.InstanceSizes[i]
+		}
+		if size.Name == newSize {
+			target = &c.InstanceSizes[i]
 		}
 	}
 
-	err := fmt.Errorf("unable to find %s, zero matches", search)
-	return nil, ZeroMatchesError.wrap(err)
+	if target == nil {
+		err := fmt.Errorf("unable to find size %s", newSize)
+		return nil, InstanceInvalidSizeError.wrap(err)
+	}
+
+	if current == nil {
+		err := fmt.Errorf("unable to find the current size %s of instance %s", instance.Size, id)
+		return nil, InstanceInvalidSizeError.wrap(err)
+	}
+
+	if target.DiskGigabytes < current.DiskGigabytes {
+		err := fmt.Errorf("size %s has a smaller disk than the current size %s", target.Name, current.Name)
+		return nil, InstanceInvalidSizeError.wrap(err)
+	}
+
+	return c.UpgradeInstance(id, newSize)
 }
 
-// UpdateWebhook implemented in a fake way for automated tests
-func (c *FakeClient) UpdateWebhook(id string, r *WebhookConfig) (*Webhook, error) {
-	for i, webhook := range c.Webhooks {
-		if webhook.ID == id {
-			c.Webhooks[i].Events = r.Events
-			c.Webhooks[i].Secret = r.Secret
-			c.Webhooks[i].URL = r.URL
+// GetInstanceConsole implemented in a fake way for automated tests
+func (c *FakeClient) GetInstanceConsole(id string) (*InstanceConsole, error) {
+	if _, err := c.GetInstance(id); err != nil {
+		return nil, err
+	}
 
-			return &webhook, nil
+	url, _ := c.GetInstanceConsoleURL(id)
+	return &InstanceConsole{URL: url, ExpiresAt: time.Now().Add(time.Hour).Format(time.RFC3339)}, nil
+}
+
+// GetInstanceInitialPassword implemented in a fake way for automated tests
+func (c *FakeClient) GetInstanceInitialPassword(id string) (*InstancePassword, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InstancePassword{Username: instance.InitialUser, Password: instance.InitialPassword}, nil
+}
+
+// ResetInstancePassword implemented in a fake way for automated tests
+func (c *FakeClient) ResetInstancePassword(id string) (*InstancePassword, error) {
+	for i, instance := range c.Instances {
+		if instance.ID == id {
+			c.Instances[i].InitialPassword = "password-" + c.generateID()
+			return &InstancePassword{Username: instance.InitialUser, Password: c.Instances[i].InitialPassword}, nil
 		}
 	}
 
@@ -1473,12 +3793,30 @@ func (c *FakeClient) UpdateWebhook(id string, r *WebhookConfig) (*Webhook, error
 	return nil, ZeroMatchesError.wrap(err)
 }
 
-// DeleteWebhook implemented in a fake way for automated tests
-func (c *FakeClient) DeleteWebhook(id string) (*SimpleResponse, error) {
-	for i, webhook := range c.Webhooks {
-		if webhook.ID == id {
-			c.Webhooks[len(c.Webhooks)-1], c.Webhooks[i] = c.Webhooks[i], c.Webhooks[len(c.Webhooks)-1]
-			c.Webhooks = c.Webhooks[:len(c.Webhooks)-1]
+// GetInstanceMetrics implemented in a fake way for automated tests, the fake doesn't record any usage
+func (c *FakeClient) GetInstanceMetrics(id string, r MetricsRequest) (*InstanceMetrics, error) {
+	if _, err := c.GetInstance(id); err != nil {
+		return nil, err
+	}
+
+	return &InstanceMetrics{InstanceID: id}, nil
+}
+
+// GetInstanceUserData implemented in a fake way for automated tests
+func (c *FakeClient) GetInstanceUserData(id string) (string, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return "", err
+	}
+
+	return instance.Script, nil
+}
+
+// SetInstanceUserData implemented in a fake way for automated tests
+func (c *FakeClient) SetInstanceUserData(id, script string) (*SimpleResponse, error) {
+	for i, instance := range c.Instances {
+		if instance.ID == id {
+			c.Instances[i].Script = script
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -1486,539 +3824,900 @@ func (c *FakeClient) DeleteWebhook(id string) (*SimpleResponse, error) {
 	return &SimpleResponse{Result: "failed"}, nil
 }
 
-// ListPermissions implemented in a fake way for automated tests
-func (c *FakeClient) ListPermissions() ([]Permission, error) {
-	return []Permission{
-		{
-			Name:        "instance.create",
-			Description: "Create Compute instances",
-		},
-		{
-			Name:        "kubernetes.*",
-			Description: "Manage Civo Kubernetes clusters",
-		},
+// GetInstanceVnc implemented in a fake way for automated tests
+func (c *FakeClient) GetInstanceVnc(id string) (InstanceVnc, error) {
+	vnc, err := c.EnableInstanceVNC(id, 0)
+	if err != nil {
+		return InstanceVnc{}, err
+	}
+	return *vnc, nil
+}
+
+// EnableInstanceVNC implemented in a fake way for automated tests
+func (c *FakeClient) EnableInstanceVNC(id string, duration time.Duration) (*InstanceVnc, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if duration <= 0 {
+		duration = time.Hour
+	}
+
+	return &InstanceVnc{
+		URI:        fmt.Sprintf("https://vnc.example.com/%s", id),
+		Result:     "success",
+		Name:       instance.Hostname,
+		Label:      instance.Hostname,
+		Expiration: time.Now().Add(duration).Format(time.RFC3339),
 	}, nil
 }
 
-// GetOrganisation implemented in a fake way for automated tests
-func (c *FakeClient) GetOrganisation() (*Organisation, error) {
-	return &c.Organisation, nil
+// DisableInstanceVNC implemented in a fake way for automated tests
+func (c *FakeClient) DisableInstanceVNC(id string) (*SimpleResponse, error) {
+	if _, err := c.GetInstance(id); err != nil {
+		return nil, err
+	}
+
+	return &SimpleResponse{Result: "success"}, nil
 }
 
-// CreateOrganisation implemented in a fake way for automated tests
-func (c *FakeClient) CreateOrganisation(name string) (*Organisation, error) {
-	c.Organisation.ID = c.generateID()
-	c.Organisation.Name = name
-	return &c.Organisation, nil
+// GetInstanceRecoveryStatus implemented in a fake way for automated tests
+func (c *FakeClient) GetInstanceRecoveryStatus(id string) (*InstanceRecoveryStatus, error) {
+	if _, err := c.GetInstance(id); err != nil {
+		return nil, err
+	}
+
+	status := c.InstanceRecovery[id]
+	if status == "" {
+		status = "disabled"
+	}
+	return &InstanceRecoveryStatus{Status: status}, nil
 }
 
-// RenameOrganisation implemented in a fake way for automated tests
-func (c *FakeClient) RenameOrganisation(name string) (*Organisation, error) {
-	c.Organisation.Name = name
-	return &c.Organisation, nil
+// EnableInstanceRecovery implemented in a fake way for automated tests, the instance is in
+// recovery mode straight away
+func (c *FakeClient) EnableInstanceRecovery(id string) (*SimpleResponse, error) {
+	if _, err := c.GetInstance(id); err != nil {
+		return nil, err
+	}
+
+	if c.InstanceRecovery == nil {
+		c.InstanceRecovery = map[string]string{}
+	}
+	c.InstanceRecovery[id] = "enabled"
+	return &SimpleResponse{Result: "success"}, nil
 }
 
-// AddAccountToOrganisation implemented in a fake way for automated tests
-func (c *FakeClient) AddAccountToOrganisation(accountID string) ([]Account, error) {
-	c.OrganisationAccounts = append(c.OrganisationAccounts, Account{
-		ID:        accountID,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	})
-	return c.ListAccountsInOrganisation()
+// DisableInstanceRecovery implemented in a fake way for automated tests
+func (c *FakeClient) DisableInstanceRecovery(id string) (*SimpleResponse, error) {
+	if _, err := c.GetInstance(id); err != nil {
+		return nil, err
+	}
+
+	delete(c.InstanceRecovery, id)
+	return &SimpleResponse{Result: "success"}, nil
+}
+
+// ListInstanceSizesByType implemented in a fake way for automated tests
+func (c *FakeClient) ListInstanceSizesByType(sizeType string) ([]InstanceSize, error) {
+	sizeType = strings.ToLower(sizeType)
+	switch sizeType {
+	case InstanceSizeTypeGPU, InstanceSizeTypeCPU, InstanceSizeTypeStandard:
+	default:
+		err := fmt.Errorf("unknown size type %q, must be one of gpu, cpu or standard", sizeType)
+		return nil, InstanceInvalidSizeError.wrap(err)
+	}
+
+	filtered := make([]InstanceSize, 0)
+	for _, size := range c.InstanceSizes {
+		if size.Type == instanceSizeTypeInstance && size.sizeType() == sizeType {
+			filtered = append(filtered, size)
+		}
+	}
+
+	return filtered, nil
+}
+
+// GetKubernetesClusterKubeconfig implemented in a fake way for automated tests
+func (c *FakeClient) GetKubernetesClusterKubeconfig(id string) ([]byte, error) {
+	cluster, err := c.GetKubernetesCluster(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.KubeConfig == "" {
+		err := fmt.Errorf("cluster %s doesn't have a kubeconfig yet", id)
+		return nil, KubernetesClusterNotReadyError.wrap(err)
+	}
+
+	return []byte(cluster.KubeConfig), nil
+}
+
+// ListKubernetesClusterTypes implemented in a fake way for automated tests
+func (c *FakeClient) ListKubernetesClusterTypes() ([]string, error) {
+	versions, err := c.ListKubernetesVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	types := []string{}
+	for _, version := range versions {
+		if clusterType := kubernetesVersionClusterType(version); !findString(types, clusterType) {
+			types = append(types, clusterType)
+		}
+	}
+
+	return types, nil
+}
+
+// ScaleKubernetesClusterPool implemented in a fake way for automated tests
+func (c *FakeClient) ScaleKubernetesClusterPool(cid, pid string, count int) (*KubernetesPool, error) {
+	return c.UpdateKubernetesClusterPool(cid, pid, &KubernetesClusterPoolUpdateConfig{Count: &count})
+}
+
+// GetNetworkDetail implemented in a fake way for automated tests
+func (c *FakeClient) GetNetworkDetail(id string) (*NetworkDetail, error) {
+	network, err := c.GetNetwork(id)
+	if err != nil {
+		return nil, err
+	}
+
+	subnets, err := c.ListSubnets(id)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &NetworkDetail{
+		Network:              network,
+		Subnets:              subnets,
+		InstanceIDs:          []string{},
+		KubernetesClusterIDs: []string{},
+		LoadBalancerIDs:      []string{},
+		FirewallIDs:          []string{},
+	}
+	for _, instance := range c.Instances {
+		if instance.NetworkID == id {
+			detail.InstanceIDs = append(detail.InstanceIDs, instance.ID)
+		}
+	}
+	for _, cluster := range c.Clusters {
+		if cluster.NetworkID == id {
+			detail.KubernetesClusterIDs = append(detail.KubernetesClusterIDs, cluster.ID)
+		}
+	}
+	for _, loadbalancer := range c.LoadBalancers {
+		if loadbalancer.NetworkID == id {
+			detail.LoadBalancerIDs = append(detail.LoadBalancerIDs, loadbalancer.ID)
+		}
+	}
+	for _, firewall := range c.Firewalls {
+		if firewall.NetworkID == id {
+			detail.FirewallIDs = append(detail.FirewallIDs, firewall.ID)
+		}
+	}
+
+	return detail, nil
+}
+
+// AttachSubnetToInstance implemented in a fake way for automated tests
+func (c *FakeClient) AttachSubnetToInstance(networkID, subnetID string, route *CreateRoute) (*Route, error) {
+	if _, err := c.GetSubnet(networkID, subnetID); err != nil {
+		return nil, err
+	}
+
+	return &Route{
+		ID:           c.generateID(),
+		SubnetID:     subnetID,
+		NetworkID:    networkID,
+		ResourceID:   route.ResourceID,
+		ResourceType: route.ResourceType,
+	}, nil
+}
+
+// DetachSubnetFromInstance implemented in a fake way for automated tests
+func (c *FakeClient) DetachSubnetFromInstance(networkID, subnetID string) (*SimpleResponse, error) {
+	if _, err := c.GetSubnet(networkID, subnetID); err != nil {
+		return nil, err
+	}
+
+	return &SimpleResponse{Result: "success"}, nil
+}
+
+// GetObjectStoreStats implemented in a fake way for automated tests, the fake stores are always empty
+func (c *FakeClient) GetObjectStoreStats(id string) (*ObjectStoreStats, error) {
+	store, err := c.GetObjectStore(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObjectStoreStats{MaxSizeKB: int64(store.MaxSize) * 1024 * 1024}, nil
+}
+
+// GetObjectStoreS3Config implemented in a fake way for automated tests
+func (c *FakeClient) GetObjectStoreS3Config(id string) (*ObjectStoreS3Config, error) {
+	store, err := c.GetObjectStore(id)
+	if err != nil {
+		return nil, err
+	}
+
+	search := store.OwnerInfo.CredentialID
+	if search == "" {
+		search = store.OwnerInfo.AccessKeyID
+	}
+	if search == "" {
+		err := fmt.Errorf("objectstore %s has no owner credential", id)
+		return nil, ZeroMatchesError.wrap(err)
+	}
+	credential, err := c.FindObjectStoreCredential(search)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := store.BucketURL
+	if endpoint != "" && !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+
+	return &ObjectStoreS3Config{
+		Endpoint:        endpoint,
+		Region:          "FAKE1",
+		Bucket:          store.Name,
+		AccessKeyID:     credential.AccessKeyID,
+		SecretAccessKey: credential.SecretAccessKeyID,
+		UsePathStyle:    true,
+	}, nil
 }
 
-// ListAccountsInOrganisation implemented in a fake way for automated tests
-func (c *FakeClient) ListAccountsInOrganisation() ([]Account, error) {
-	return c.OrganisationAccounts, nil
+// FindDatabaseBackup implemented in a fake way for automated tests
+func (c *FakeClient) FindDatabaseBackup(dbid, search string) (*DatabaseBackup, error) {
+	backups, err := c.ListDatabaseBackup(dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, backup := range backups.Items {
+		if backup.ID == search || strings.EqualFold(backup.Name, search) {
+			return &backup, nil
+		}
+	}
+	for _, backup := range backups.Items {
+		if strings.Contains(strings.ToUpper(backup.Name), strings.ToUpper(search)) || strings.Contains(backup.ID, search) {
+			return &backup, nil
+		}
+	}
+
+	err = fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// ListRoles implemented in a fake way for automated tests
-func (c *FakeClient) ListRoles() ([]Role, error) {
-	return c.OrganisationRoles, nil
+// UpdateDatabaseBackupSchedule implemented in a fake way for automated tests
+func (c *FakeClient) UpdateDatabaseBackupSchedule(did, schedule string) (*DatabaseBackup, error) {
+	return c.UpdateDatabaseBackup(did, &DatabaseBackupUpdateRequest{Schedule: schedule})
 }
 
-// CreateRole implemented in a fake way for automated tests
-func (c *FakeClient) CreateRole(name, permissions string) (*Role, error) {
-	role := Role{
-		ID:          c.generateID(),
-		Name:        name,
-		Permissions: permissions,
+// ListDatabaseUsers implemented in a fake way for automated tests
+func (c *FakeClient) ListDatabaseUsers(id string) ([]DatabaseUserInfo, error) {
+	db, err := c.GetDatabase(id)
+	if err != nil {
+		return nil, err
 	}
-	c.OrganisationRoles = append(c.OrganisationRoles, role)
-	return &role, nil
+
+	if len(db.DatabaseUserInfo) == 0 && db.Username != "" {
+		return []DatabaseUserInfo{{Username: db.Username, Password: db.Password, Port: db.Port}}, nil
+	}
+
+	return db.DatabaseUserInfo, nil
 }
 
-// DeleteRole implemented in a fake way for automated tests
-func (c *FakeClient) DeleteRole(id string) (*SimpleResponse, error) {
-	for i, role := range c.OrganisationRoles {
-		if role.ID == id {
-			c.OrganisationRoles[len(c.OrganisationRoles)-1], c.OrganisationRoles[i] = c.OrganisationRoles[i], c.OrganisationRoles[len(c.OrganisationRoles)-1]
-			c.OrganisationRoles = c.OrganisationRoles[:len(c.OrganisationRoles)-1]
-			return &SimpleResponse{Result: "success"}, nil
+// RotateDatabaseUserPassword implemented in a fake way for automated tests
+func (c *FakeClient) RotateDatabaseUserPassword(id, username string) (*DatabaseUserInfo, error) {
+	for i, db := range c.Databases {
+		if db.ID != id {
+			continue
+		}
+
+		password := "password-" + c.generateID()
+		if db.Username == username {
+			c.Databases[i].Password = password
+		}
+		for j, user := range db.DatabaseUserInfo {
+			if user.Username == username {
+				c.Databases[i].DatabaseUserInfo[j].Password = password
+				return &c.Databases[i].DatabaseUserInfo[j], nil
+			}
 		}
+		if db.Username == username {
+			return &DatabaseUserInfo{Username: username, Password: password, Port: db.Port}, nil
+		}
+
+		err := fmt.Errorf("unable to find user %s in database %s, zero matches", username, id)
+		return nil, ZeroMatchesError.wrap(err)
 	}
 
-	return &SimpleResponse{Result: "failed"}, fmt.Errorf("unable to find that role")
+	err := fmt.Errorf("unable to find database %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// ListTeams implemented in a fake way for automated tests
-func (c *FakeClient) ListTeams() ([]Team, error) {
-	return c.OrganisationTeams, nil
-}
+// ListDatabaseAllowedSources implemented in a fake way for automated tests
+func (c *FakeClient) ListDatabaseAllowedSources(id string) ([]string, error) {
+	db, err := c.GetDatabase(id)
+	if err != nil {
+		return nil, err
+	}
 
-// CreateTeam implemented in a fake way for automated tests
-func (c *FakeClient) CreateTeam(name string) (*Team, error) {
-	team := Team{
-		ID:        c.generateID(),
-		Name:      name,
-		CreatedAt: time.Time{},
-		UpdatedAt: time.Time{},
+	rules, err := c.ListFirewallRules(db.FirewallID)
+	if err != nil {
+		return nil, err
 	}
-	c.OrganisationTeams = append(c.OrganisationTeams, team)
-	return &team, nil
+
+	sources := make([]string, 0)
+	for _, rule := range databaseSourceRules(db, rules) {
+		sources = append(sources, rule.Cidr...)
+	}
+
+	return sources, nil
 }
 
-// RenameTeam implemented in a fake way for automated tests
-func (c *FakeClient) RenameTeam(teamID, name string) (*Team, error) {
-	for _, team := range c.OrganisationTeams {
-		if team.ID == teamID {
-			team.Name = name
-			return &team, nil
-		}
+// AllowDatabaseSource implemented in a fake way for automated tests
+func (c *FakeClient) AllowDatabaseSource(id, cidr string) (*FirewallRule, error) {
+	normalized, err := netutil.NormalizeCIDR(cidr)
+	if err != nil {
+		return nil, InvalidFirewallRuleError.wrap(err)
+	}
+
+	db, err := c.GetDatabase(id)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("unable to find that role")
+	port := strconv.Itoa(db.Port)
+	rule := FirewallRule{
+		ID:         c.generateID(),
+		FirewallID: db.FirewallID,
+		Protocol:   string(FirewallProtocolTCP),
+		StartPort:  port,
+		EndPort:    port,
+		Cidr:       []string{normalized},
+		Direction:  string(FirewallDirectionIngress),
+		Action:     string(FirewallActionAllow),
+		Label:      databaseSourceLabel,
+	}
+	c.FirewallRules = append(c.FirewallRules, rule)
+	return &rule, nil
 }
 
-// DeleteTeam implemented in a fake way for automated tests
-func (c *FakeClient) DeleteTeam(id string) (*SimpleResponse, error) {
-	for i, team := range c.OrganisationTeams {
-		if team.ID == id {
-			c.OrganisationTeams[len(c.OrganisationTeams)-1], c.OrganisationTeams[i] = c.OrganisationTeams[i], c.OrganisationTeams[len(c.OrganisationTeams)-1]
-			c.OrganisationTeams = c.OrganisationTeams[:len(c.OrganisationTeams)-1]
-			return &SimpleResponse{Result: "success"}, nil
+// RevokeDatabaseSource implemented in a fake way for automated tests
+func (c *FakeClient) RevokeDatabaseSource(id, cidr string) error {
+	normalized, err := netutil.NormalizeCIDR(cidr)
+	if err != nil {
+		return InvalidFirewallRuleError.wrap(err)
+	}
+
+	db, err := c.GetDatabase(id)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	remainingRules := make([]FirewallRule, 0, len(c.FirewallRules))
+	for _, rule := range c.FirewallRules {
+		if rule.FirewallID == db.FirewallID && len(databaseSourceRules(db, []FirewallRule{rule})) == 1 {
+			remaining := make([]string, 0, len(rule.Cidr))
+			for _, source := range rule.Cidr {
+				if source != normalized {
+					remaining = append(remaining, source)
+				}
+			}
+			if len(remaining) != len(rule.Cidr) {
+				found = true
+				if len(remaining) == 0 {
+					continue
+				}
+				rule.Cidr = remaining
+			}
 		}
+		remainingRules = append(remainingRules, rule)
 	}
+	c.FirewallRules = remainingRules
 
-	return &SimpleResponse{Result: "failure"}, fmt.Errorf("unable to find that team")
+	if !found {
+		err := fmt.Errorf("%s isn't allowed to connect to database %s", normalized, id)
+		return ZeroMatchesError.wrap(err)
+	}
+	return nil
 }
 
-// ListTeamMembers implemented in a fake way for automated tests
-func (c *FakeClient) ListTeamMembers(teamID string) ([]TeamMember, error) {
-	return c.OrganisationTeamMembers[teamID], nil
+// QuotaHeadroom implemented in a fake way for automated tests
+func (c *FakeClient) QuotaHeadroom(resource string) (int, error) {
+	return c.Quota.Headroom(resource)
 }
 
-// AddTeamMember implemented in a fake way for automated tests
-func (c *FakeClient) AddTeamMember(teamID, userID, permissions, roles string) ([]TeamMember, error) {
-	c.OrganisationTeamMembers[teamID] = append(c.OrganisationTeamMembers[teamID], TeamMember{
-		ID:          c.generateID(),
-		TeamID:      teamID,
-		UserID:      userID,
-		Permissions: permissions,
-		Roles:       roles,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
-	})
+// CheckVolumeQuota implemented in a fake way for automated tests
+func (c *FakeClient) CheckVolumeQuota(ctx context.Context, sizeGB int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 
-	return c.ListTeamMembers(teamID)
+	if err := c.Quota.Check(QuotaResourceDiskVolumeCount, 1); err != nil {
+		return err
+	}
+
+	return c.Quota.Check(QuotaResourceDiskGigabytes, sizeGB)
 }
 
-// UpdateTeamMember implemented in a fake way for automated tests
-func (c *FakeClient) UpdateTeamMember(teamID, teamMemberID, permissions, roles string) (*TeamMember, error) {
-	for _, teamMember := range c.OrganisationTeamMembers[teamID] {
-		if teamMember.ID == teamMemberID {
-			teamMember.Permissions = permissions
-			teamMember.Roles = roles
-			return &teamMember, nil
-		}
+// RegionSupports implemented in a fake way for automated tests, an empty region checks the
+// default region
+func (c *FakeClient) RegionSupports(region string, feature RegionFeature) (bool, error) {
+	var r *Region
+	var err error
+	if region == "" {
+		r, err = c.GetDefaultRegion()
+	} else {
+		r, err = c.FindRegion(region)
+	}
+	if err != nil {
+		return false, err
 	}
 
-	return nil, fmt.Errorf("unable to find that role")
+	return r.Features.Has(feature)
 }
 
-// RemoveTeamMember implemented in a fake way for automated tests
-func (c *FakeClient) RemoveTeamMember(teamID, teamMemberID string) (*SimpleResponse, error) {
-	for i, teamMember := range c.OrganisationTeamMembers[teamID] {
-		if teamMember.ID == teamMemberID {
-			c.OrganisationTeamMembers[teamID][len(c.OrganisationTeamMembers[teamID])-1], c.OrganisationTeamMembers[teamID][i] = c.OrganisationTeamMembers[teamID][i], c.OrganisationTeamMembers[teamID][len(c.OrganisationTeamMembers[teamID])-1]
-			c.OrganisationTeamMembers[teamID] = c.OrganisationTeamMembers[teamID][:len(c.OrganisationTeamMembers[teamID])-1]
-			return &SimpleResponse{Result: "success"}, nil
+// FindSSHKeyByPublicKey implemented in a fake way for automated tests
+func (c *FakeClient) FindSSHKeyByPublicKey(publicKey string) (*SSHKey, error) {
+	fingerprint, err := SSHKeyFingerprint(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range c.SSHKeys {
+		if key.Fingerprint == fingerprint {
+			return &key, nil
+		}
+		if keyFingerprint, err := SSHKeyFingerprint(key.PublicKey); err == nil && keyFingerprint == fingerprint {
+			return &key, nil
 		}
 	}
 
-	return &SimpleResponse{Result: "failure"}, fmt.Errorf("unable to find that team member")
+	err = fmt.Errorf("unable to find an SSH key with fingerprint %s, zero matches", fingerprint)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// ListLoadBalancers implemented in a fake way for automated tests
-func (c *FakeClient) ListLoadBalancers() ([]LoadBalancer, error) {
-	return c.LoadBalancers, nil
+// FinalizeDiskImageUpload implemented in a fake way for automated tests, the image is available
+// straight away
+func (c *FakeClient) FinalizeDiskImageUpload(id string) (*SimpleResponse, error) {
+	for i, diskImage := range c.DiskImage {
+		if diskImage.ID == id {
+			c.DiskImage[i].State = DiskImageStateAvailable
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find disk image %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// GetLoadBalancer implemented in a fake way for automated tests
-func (c *FakeClient) GetLoadBalancer(id string) (*LoadBalancer, error) {
-	for _, lb := range c.LoadBalancers {
-		if lb.ID == id {
-			return &lb, nil
+// GetDiskImageByName implemented in a fake way for automated tests
+func (c *FakeClient) GetDiskImageByName(name string) (*DiskImage, error) {
+	for _, diskImage := range c.DiskImage {
+		if diskImage.Name == name {
+			return &diskImage, nil
 		}
 	}
 
-	err := fmt.Errorf("unable to get load balancer %s", id)
-	return nil, DatabaseLoadBalancerNotFoundError.wrap(err)
+	return nil, errors.New("diskimage not found")
 }
 
-// FindLoadBalancer implemented in a fake way for automated tests
-func (c *FakeClient) FindLoadBalancer(search string) (*LoadBalancer, error) {
-	exactMatch := false
-	partialMatchesCount := 0
-	result := LoadBalancer{}
-
-	for _, lb := range c.LoadBalancers {
-		if lb.ID == search || lb.Name == search {
-			exactMatch = true
-			result = lb
-		} else if strings.Contains(lb.Name, search) || strings.Contains(lb.ID, search) {
-			if !exactMatch {
-				result = lb
-				partialMatchesCount++
-			}
+// GetMostRecentDistro implemented in a fake way for automated tests
+func (c *FakeClient) GetMostRecentDistro(name string) (*DiskImage, error) {
+	var highestVersionDistro *DiskImage
+	for i, diskImage := range c.DiskImage {
+		if !strings.Contains(diskImage.Name, name) {
+			continue
+		}
+		if highestVersionDistro == nil || semver.Compare(highestVersionDistro.Version, diskImage.Version) < 0 {
+			highestVersionDistro = &c.DiskImage[i]
 		}
 	}
 
-	if exactMatch || partialMatchesCount == 1 {
-		return &result, nil
-	} else if partialMatchesCount > 1 {
-		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
-		return nil, MultipleMatchesError.wrap(err)
-	} else {
-		err := fmt.Errorf("unable to find %s, zero matches", search)
-		return nil, ZeroMatchesError.wrap(err)
+	if highestVersionDistro == nil {
+		return nil, fmt.Errorf("%s image not found", name)
 	}
+	return highestVersionDistro, nil
 }
 
-// CreateLoadBalancer implemented in a fake way for automated tests
-func (c *FakeClient) CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, error) {
-	loadbalancer := LoadBalancer{
-		ID:                           c.generateID(),
-		Name:                         r.Name,
-		Algorithm:                    r.Algorithm,
-		ExternalTrafficPolicy:        r.ExternalTrafficPolicy,
-		SessionAffinityConfigTimeout: r.SessionAffinityConfigTimeout,
-		SessionAffinity:              r.SessionAffinity,
-		EnableProxyProtocol:          r.EnableProxyProtocol,
-		FirewallID:                   r.FirewallID,
-		ClusterID:                    r.ClusterID,
+// ListVolumesForCluster implemented in a fake way for automated tests
+func (c *FakeClient) ListVolumesForCluster(clusterID string) ([]Volume, error) {
+	var volumes []Volume
+	for _, volume := range c.Volumes {
+		if volume.ClusterID == clusterID {
+			volumes = append(volumes, volume)
+		}
 	}
 
-	if r.Algorithm == "" {
-		loadbalancer.Algorithm = "round_robin"
+	return volumes, nil
+}
+
+// ListDanglingVolumes implemented in a fake way for automated tests
+func (c *FakeClient) ListDanglingVolumes() ([]Volume, error) {
+	dangling := make([]Volume, 0)
+	for _, volume := range c.Volumes {
+		if volume.ClusterID == "" {
+			continue
+		}
+		if _, err := c.GetKubernetesCluster(volume.ClusterID); err != nil {
+			dangling = append(dangling, volume)
+		}
 	}
-	if r.FirewallID == "" {
-		loadbalancer.FirewallID = c.generateID()
+
+	return dangling, nil
+}
+
+// ListVolumeTypes implemented in a fake way for automated tests
+func (c *FakeClient) ListVolumeTypes() ([]VolumeType, error) {
+	return []VolumeType{
+		{
+			Name:        "ms-xfs-2-replicas",
+			Description: "Fake volume type with two replicas",
+			Enabled:     true,
+			Labels:      []string{"default"},
+		},
+	}, nil
+}
+
+// RestoreVolumeSnapshotInPlace implemented in a fake way for automated tests
+func (c *FakeClient) RestoreVolumeSnapshotInPlace(volumeID, snapshotID string, overwrite bool) (*SimpleResponse, error) {
+	if !overwrite {
+		err := fmt.Errorf("restoring snapshot %s replaces the contents of volume %s, set overwrite to confirm", snapshotID, volumeID)
+		return nil, VolumeRestoreNotConfirmedError.wrap(err)
 	}
-	if r.ExternalTrafficPolicy == "" {
-		loadbalancer.ExternalTrafficPolicy = "Cluster"
+
+	if _, err := c.GetVolumeSnapshotByVolumeID(volumeID, snapshotID); err != nil {
+		return nil, err
 	}
 
-	backends := make([]LoadBalancerBackend, 0)
-	for _, b := range r.Backends {
-		backend := LoadBalancerBackend{
-			IP:         b.IP,
-			Protocol:   b.Protocol,
-			SourcePort: b.SourcePort,
-			TargetPort: b.TargetPort,
-		}
-		backends = append(backends, backend)
+	if _, err := c.GetVolume(volumeID); err != nil {
+		return nil, err
 	}
-	loadbalancer.Backends = backends
-	loadbalancer.PublicIP = c.generatePublicIP()
-	loadbalancer.State = "available"
 
-	c.LoadBalancers = append(c.LoadBalancers, loadbalancer)
-	return &loadbalancer, nil
+	return &SimpleResponse{ID: volumeID, Result: "success"}, nil
 }
 
-// UpdateLoadBalancer implemented in a fake way for automated tests
-func (c *FakeClient) UpdateLoadBalancer(id string, r *LoadBalancerUpdateConfig) (*LoadBalancer, error) {
-	for _, lb := range c.LoadBalancers {
-		if lb.ID == id {
-			lb.Name = r.Name
-			lb.Algorithm = r.Algorithm
-			lb.EnableProxyProtocol = r.EnableProxyProtocol
-			lb.ExternalTrafficPolicy = r.ExternalTrafficPolicy
-			lb.SessionAffinity = r.SessionAffinity
-			lb.SessionAffinityConfigTimeout = r.SessionAffinityConfigTimeout
-
-			backends := make([]LoadBalancerBackend, len(r.Backends))
-			for i, b := range r.Backends {
-				backends[i].IP = b.IP
-				backends[i].Protocol = b.Protocol
-				backends[i].SourcePort = b.SourcePort
-				backends[i].TargetPort = b.TargetPort
-			}
+// AssignIPToInstance implemented in a fake way for automated tests
+func (c *FakeClient) AssignIPToInstance(id, instanceID string) (*SimpleResponse, error) {
+	return c.AssignIP(id, instanceID, IPAssignedToInstance, "")
+}
 
-			if r.ExternalTrafficPolicy == "" {
-				lb.ExternalTrafficPolicy = "Cluster"
-			}
+// AssignIPToLoadBalancer implemented in a fake way for automated tests
+func (c *FakeClient) AssignIPToLoadBalancer(id, loadBalancerID string) (*SimpleResponse, error) {
+	return c.AssignIP(id, loadBalancerID, IPAssignedToLoadBalancer, "")
+}
 
-			return &lb, nil
+// FindInstanceReservedIP implemented in a fake way for automated tests
+func (c *FakeClient) FindInstanceReservedIP(instanceID string) (*IP, error) {
+	for _, ip := range c.IP {
+		if ip.AssignedTo.Type == IPAssignedToInstance && ip.AssignedTo.ID == instanceID {
+			return &ip, nil
 		}
 	}
 
-	err := fmt.Errorf("unable to find load balancer %s", id)
-	return nil, DatabaseLoadBalancerNotFoundError.wrap(err)
+	err := fmt.Errorf("unable to find a reserved IP assigned to instance %s, zero matches", instanceID)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// DeleteLoadBalancer implemented in a fake way for automated tests
-func (c *FakeClient) DeleteLoadBalancer(id string) (*SimpleResponse, error) {
+// SetLoadBalancerCertificate implemented in a fake way for automated tests
+func (c *FakeClient) SetLoadBalancerCertificate(id string, port int32, certificateID string) (*LoadBalancer, error) {
 	for i, lb := range c.LoadBalancers {
-		if lb.ID == id {
-			c.LoadBalancers[len(c.LoadBalancers)-1], c.LoadBalancers[i] = c.LoadBalancers[i], c.LoadBalancers[len(c.LoadBalancers)-1]
-			c.LoadBalancers = c.LoadBalancers[:len(c.LoadBalancers)-1]
-			return &SimpleResponse{Result: "success"}, nil
+		if lb.ID != id {
+			continue
+		}
+
+		found := false
+		for j, backend := range lb.Backends {
+			if backend.SourcePort == port {
+				c.LoadBalancers[i].Backends[j].Protocol = LoadBalancerProtocolHTTPS
+				c.LoadBalancers[i].Backends[j].TLSCertificateID = certificateID
+				found = true
+			}
 		}
+		if !found {
+			err := fmt.Errorf("load balancer %s has no backends on port %d", id, port)
+			return nil, InvalidLoadBalancerConfigError.wrap(err)
+		}
+
+		return &c.LoadBalancers[i], nil
 	}
 
-	return &SimpleResponse{Result: "failed"}, nil
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// ListKubernetesClusterPools implemented in a fake way for automated tests
-func (c *FakeClient) ListKubernetesClusterPools(cid string) ([]KubernetesPool, error) {
-	pools := []KubernetesPool{}
-	found := false
+// ListApplications implemented in a fake way for automated tests
+func (c *FakeClient) ListApplications() (*PaginatedApplications, error) {
+	return &PaginatedApplications{
+		Page:    1,
+		PerPage: 20,
+		Pages:   1,
+		Items:   append([]Application{}, c.Applications...),
+	}, nil
+}
 
-	for _, cs := range c.Clusters {
-		if cs.ID == cid {
-			found = true
-			pools = cs.Pools
-			break
+// GetApplication implemented in a fake way for automated tests
+func (c *FakeClient) GetApplication(id string) (*Application, error) {
+	for _, app := range c.Applications {
+		if app.ID == id {
+			return &app, nil
 		}
 	}
 
-	if found {
-		return pools, nil
-	}
-
-	err := fmt.Errorf("unable to get kubernetes cluster %s", cid)
-	return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// GetKubernetesClusterPool implemented in a fake way for automated tests
-func (c *FakeClient) GetKubernetesClusterPool(cid, pid string) (*KubernetesPool, error) {
-	pool := &KubernetesPool{}
-	clusterFound := false
-	poolFound := false
+// FindApplication implemented in a fake way for automated tests
+func (c *FakeClient) FindApplication(search string) (*Application, error) {
+	exactMatch := false
+	partialMatchesCount := 0
+	result := Application{}
 
-	for _, cs := range c.Clusters {
-		if cs.ID == cid {
-			clusterFound = true
-			for _, p := range cs.Pools {
-				if p.ID == pid {
-					poolFound = true
-					pool = &p
-					break
-				}
+	for _, value := range c.Applications {
+		if value.Name == search || value.ID == search {
+			exactMatch = true
+			result = value
+		} else if strings.Contains(value.Name, search) || strings.Contains(value.ID, search) {
+			if !exactMatch {
+				result = value
+				partialMatchesCount++
 			}
 		}
 	}
 
-	if !clusterFound {
-		err := fmt.Errorf("unable to get kubernetes cluster %s", cid)
-		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	if exactMatch || partialMatchesCount == 1 {
+		return &result, nil
+	} else if partialMatchesCount > 1 {
+		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
+		return nil, MultipleMatchesError.wrap(err)
 	}
 
-	if !poolFound {
-		err := fmt.Errorf("unable to get kubernetes pool %s", pid)
-		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// NewApplicationConfig implemented in a fake way for automated tests
+func (c *FakeClient) NewApplicationConfig() (*ApplicationConfig, error) {
+	network, err := c.GetDefaultNetwork()
+	if err != nil {
+		return nil, err
 	}
 
-	return pool, nil
+	return &ApplicationConfig{
+		Name:      utils.RandomName(),
+		NetworkID: network.ID,
+		Size:      "small",
+		SSHKeyIDs: []string{},
+	}, nil
 }
 
-// FindKubernetesClusterPool implemented in a fake way for automated tests
-func (c *FakeClient) FindKubernetesClusterPool(cid, search string) (*KubernetesPool, error) {
-	pool := &KubernetesPool{}
-	clusterFound := false
-	poolFound := false
+// CreateApplication implemented in a fake way for automated tests
+func (c *FakeClient) CreateApplication(config *ApplicationConfig) (*Application, error) {
+	app := Application{
+		ID:          c.generateID(),
+		Name:        config.Name,
+		NetworkID:   config.NetworkID,
+		Description: config.Description,
+		Size:        config.Size,
+		SSHKeyIDs:   config.SSHKeyIDs,
+		Status:      "available",
+	}
+	c.Applications = append(c.Applications, app)
 
-	for _, cs := range c.Clusters {
-		if cs.ID == cid {
-			clusterFound = true
-			for _, p := range cs.Pools {
-				if p.ID == search || strings.Contains(p.ID, search) {
-					poolFound = true
-					pool = &p
-					break
-				}
+	return &app, nil
+}
+
+// UpdateApplication implemented in a fake way for automated tests
+func (c *FakeClient) UpdateApplication(id string, application *UpdateApplicationRequest) (*Application, error) {
+	for i, app := range c.Applications {
+		if app.ID == id {
+			if application.Name != "" {
+				c.Applications[i].Name = application.Name
+			}
+			if application.Image != "" {
+				c.Applications[i].Image = application.Image
 			}
+			if application.Size != "" {
+				c.Applications[i].Size = application.Size
+			}
+			c.Applications[i].Description = application.Description
+			c.Applications[i].ProcessInfo = application.ProcessInfo
+			c.Applications[i].SSHKeyIDs = application.SSHKeyIDs
+			c.Applications[i].Config = application.Config
+			c.Applications[i].Domains = application.Domains
+			return &c.Applications[i], nil
 		}
 	}
 
-	if !clusterFound {
-		err := fmt.Errorf("unable to get kubernetes cluster %s", cid)
-		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteApplication implemented in a fake way for automated tests
+func (c *FakeClient) DeleteApplication(id string) (*SimpleResponse, error) {
+	for i, app := range c.Applications {
+		if app.ID == id {
+			c.Applications = append(c.Applications[:i], c.Applications[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
 	}
 
-	if !poolFound {
-		err := fmt.Errorf("unable to get kubernetes pool %s", search)
-		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// GetApplicationLogAuth implemented in a fake way for automated tests
+func (c *FakeClient) GetApplicationLogAuth(id string) (string, error) {
+	if _, err := c.GetApplication(id); err != nil {
+		return "", err
 	}
 
-	return pool, nil
+	return "token-" + id, nil
 }
 
-// DeleteKubernetesClusterPoolInstance implemented in a fake way for automated tests
-func (c *FakeClient) DeleteKubernetesClusterPoolInstance(cid, pid, id string) (*SimpleResponse, error) {
-	clusterFound := false
-	poolFound := false
-	instanceFound := false
+// ListKfClusters implemented in a fake way for automated tests
+func (c *FakeClient) ListKfClusters() (*PaginatedKfClusters, error) {
+	return &PaginatedKfClusters{
+		Page:    1,
+		PerPage: 20,
+		Pages:   1,
+		Items:   append([]KfCluster{}, c.KfClusters...),
+	}, nil
+}
 
-	for ci, cs := range c.Clusters {
-		if cs.ID == cid {
-			clusterFound = true
-			for pi, p := range cs.Pools {
-				if p.ID == pid {
-					poolFound = true
-					for i, in := range p.Instances {
-						if in.ID == id {
-							instanceFound = true
-							p.Instances = append(p.Instances[:i], p.Instances[i+1:]...)
+// GetKfCluster implemented in a fake way for automated tests
+func (c *FakeClient) GetKfCluster(id string) (*KfCluster, error) {
+	for _, kfc := range c.KfClusters {
+		if kfc.ID == id {
+			return &kfc, nil
+		}
+	}
 
-							instanceNames := []string{}
-							for _, in := range p.Instances {
-								instanceNames = append(instanceNames, in.Hostname)
-							}
-							p.InstanceNames = instanceNames
-							c.Clusters[ci].Pools[pi] = p
-							break
-						}
-					}
-				}
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindKfCluster implemented in a fake way for automated tests
+func (c *FakeClient) FindKfCluster(search string) (*KfCluster, error) {
+	exactMatch := false
+	partialMatchesCount := 0
+	result := KfCluster{}
+
+	for _, value := range c.KfClusters {
+		if value.Name == search || value.ID == search {
+			exactMatch = true
+			result = value
+		} else if strings.Contains(value.Name, search) || strings.Contains(value.ID, search) {
+			if !exactMatch {
+				result = value
+				partialMatchesCount++
 			}
 		}
 	}
 
-	if !clusterFound {
-		err := fmt.Errorf("unable to get kubernetes cluster %s", cid)
-		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+	if exactMatch || partialMatchesCount == 1 {
+		return &result, nil
+	} else if partialMatchesCount > 1 {
+		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
+		return nil, MultipleMatchesError.wrap(err)
 	}
 
-	if !poolFound {
-		err := fmt.Errorf("unable to get kubernetes pool %s", pid)
-		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
-	}
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
 
-	if !instanceFound {
-		err := fmt.Errorf("unable to get kubernetes pool instance %s", id)
-		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+// CreateKfCluster implemented in a fake way for automated tests
+func (c *FakeClient) CreateKfCluster(req CreateKfClusterReq) (*KfCluster, error) {
+	kfc := KfCluster{
+		ID:            c.generateID(),
+		Name:          req.Name,
+		NetworkID:     req.NetworkID,
+		FirewallID:    req.FirewallID,
+		Size:          req.Size,
+		KubeflowReady: "false",
+		CreatedAt:     time.Now(),
 	}
+	c.KfClusters = append(c.KfClusters, kfc)
 
-	return &SimpleResponse{
-		Result: "success",
-	}, nil
+	return &kfc, nil
 }
 
-// UpdateKubernetesClusterPool implemented in a fake way for automated tests
-func (c *FakeClient) UpdateKubernetesClusterPool(cid, pid string, config *KubernetesClusterPoolUpdateConfig) (*KubernetesPool, error) {
-	clusterFound := false
-	poolFound := false
-
-	pool := KubernetesPool{}
-	for _, cs := range c.Clusters {
-		if cs.ID == cid {
-			clusterFound = true
-			for _, p := range cs.Pools {
-				if p.ID == pid {
-					poolFound = true
-					p.Count = *config.Count
-					pool = p
-				}
+// UpdateKfCluster implemented in a fake way for automated tests
+func (c *FakeClient) UpdateKfCluster(id string, kfc *UpdateKfClusterReq) (*KfCluster, error) {
+	for i, cluster := range c.KfClusters {
+		if cluster.ID == id {
+			if kfc.Name != "" {
+				c.KfClusters[i].Name = kfc.Name
 			}
+			return &c.KfClusters[i], nil
 		}
 	}
 
-	if !clusterFound {
-		err := fmt.Errorf("unable to get kubernetes cluster %s", cid)
-		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
-	}
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
 
-	if !poolFound {
-		err := fmt.Errorf("unable to get kubernetes pool %s", pid)
-		return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+// DeleteKfCluster implemented in a fake way for automated tests
+func (c *FakeClient) DeleteKfCluster(id string) (*SimpleResponse, error) {
+	for i, kfc := range c.KfClusters {
+		if kfc.ID == id {
+			c.KfClusters = append(c.KfClusters[:i], c.KfClusters[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
 	}
 
-	return &pool, nil
+	return &SimpleResponse{Result: "failed"}, nil
 }
 
-// ListIPs returns a list of fake IPs
-func (c *FakeClient) ListIPs() (*PaginatedIPs, error) {
-	return &PaginatedIPs{
-		Page:    1,
-		PerPage: 20,
-		Pages:   100,
-		Items: []IP{
-			{
-				ID:   c.generateID(),
-				Name: "test-ip",
-				IP:   c.generatePublicIP(),
-			},
-		},
-	}, nil
-}
+// ListAccounts implemented in a fake way for automated tests
+func (c *FakeClient) ListAccounts() (*PaginatedAccounts, error) {
+	accounts := &PaginatedAccounts{Page: 1, PerPage: 20, Pages: 1, Items: []Account{}}
+	if c.Account.ID != "" {
+		accounts.Items = append(accounts.Items, c.Account)
+	}
 
-// GetIP returns a fake IP
-func (c *FakeClient) GetIP(id string) (*IP, error) {
-	return &IP{
-		ID:   c.generateID(),
-		Name: "test-ip",
-		IP:   c.generatePublicIP(),
-	}, nil
+	return accounts, nil
 }
 
-// FindIP finds a fake IP
-func (c *FakeClient) FindIP(search string) (*IP, error) {
-	return &IP{
-		ID:   c.generateID(),
-		Name: "test-ip",
-		IP:   c.generatePublicIP(),
-	}, nil
-}
+// GetAccountID implemented in a fake way for automated tests
+func (c *FakeClient) GetAccountID() string {
+	if c.Account.ID == "" {
+		return "No account found"
+	}
 
-// NewIP creates a fake IP
-func (c *FakeClient) NewIP(v *CreateIPRequest) (*IP, error) {
-	return &IP{
-		ID:   c.generateID(),
-		Name: "test-ip",
-		IP:   c.generatePublicIP(),
-	}, nil
+	return c.Account.ID
 }
 
-// UpdateIP updates a fake IP
-func (c *FakeClient) UpdateIP(id string, v *UpdateIPRequest) (*IP, error) {
-	return &IP{
-		ID:   c.generateID(),
-		Name: v.Name,
-		IP:   c.generatePublicIP(),
-	}, nil
+// GetUserEverything implemented in a fake way for automated tests
+func (c *FakeClient) GetUserEverything(userID string) (*UserEverything, error) {
+	everything := &UserEverything{
+		User:  User{ID: userID},
+		Teams: append([]Team{}, c.OrganisationTeams...),
+		Roles: append([]Role{}, c.OrganisationRoles...),
+	}
+	if c.Account.ID != "" {
+		everything.Accounts = []Account{c.Account}
+	}
+	if c.Organisation.ID != "" {
+		everything.Organisations = []Organisation{c.Organisation}
+	}
+
+	return everything, nil
 }
 
-// DeleteIP deletes a fake IP
-func (c *FakeClient) DeleteIP(id string) (*SimpleResponse, error) {
-	return &SimpleResponse{
-		Result: "success",
-	}, nil
+// CreateRoleWithPermissions implemented in a fake way for automated tests
+func (c *FakeClient) CreateRoleWithPermissions(name string, permissions []string) (*Role, error) {
+	return c.CreateRole(name, strings.Join(permissions, ","))
 }
 
-// AssignIP assigns a fake IP
-func (c *FakeClient) AssignIP(id, resourceID, resourceType, region string) (*SimpleResponse, error) {
-	return &SimpleResponse{
-		Result: "success",
-	}, nil
+// UpdateTeamMemberRoles implemented in a fake way for automated tests
+func (c *FakeClient) UpdateTeamMemberRoles(teamID, teamMemberID string, roles []string) (*TeamMember, error) {
+	for _, member := range c.OrganisationTeamMembers[teamID] {
+		if member.ID == teamMemberID {
+			return c.UpdateTeamMember(teamID, teamMemberID, member.Permissions, strings.Join(roles, ","))
+		}
+	}
+
+	err := fmt.Errorf("unable to find team member %s in team %s", teamMemberID, teamID)
+	return nil, ZeroMatchesError.wrap(err)
 }
 
-// UnassignIP unassigns a fake IP
-func (c *FakeClient) UnassignIP(id, region string) (*SimpleResponse, error) {
-	return &SimpleResponse{
-		Result: "success",
-	}, nil
+// ListActions implemented in a fake way for automated tests, the fake doesn't record any actions
+func (c *FakeClient) ListActions(listRequest *ActionListRequest) (*PaginateActionList, error) {
+	page := &PaginateActionList{Page: 1, PerPage: 20, Pages: 1, Items: []Action{}}
+	if listRequest != nil && listRequest.Page > 0 {
+		page.Page = listRequest.Page
+		page.Pages = listRequest.Page
+	}
+	if listRequest != nil && listRequest.PerPage > 0 {
+		page.PerPage = listRequest.PerPage
+	}
+
+	return page, nil
 }
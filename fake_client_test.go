@@ -48,9 +48,28 @@ func TestIPs(t *testing.T) {
 	g.Expect(err).To(BeNil())
 	g.Expect(ip.Name).To(Equal(expected.Name))
 
+	_, err = client.AssignIP(ip.ID, "instance-1", IPAssignedToInstance, "LON1")
+	g.Expect(err).To(BeNil())
+
+	ip, err = client.GetIP(ip.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(ip.Assigned()).To(BeTrue())
+	g.Expect(ip.AssignedTo.ID).To(Equal("instance-1"))
+
+	_, err = client.UnassignIP(ip.ID, "LON1")
+	g.Expect(err).To(BeNil())
+
+	ip, err = client.GetIP(ip.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(ip.Assigned()).To(BeFalse())
+
 	resp, err := client.DeleteIP(ip.ID)
 	g.Expect(err).To(BeNil())
 	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
+
+	ips, err = client.ListIPs()
+	g.Expect(err).To(BeNil())
+	g.Expect(len(ips.Items)).To(Equal(0))
 }
 
 func TestInstances(t *testing.T) {
@@ -85,6 +104,286 @@ func TestInstances(t *testing.T) {
 	}
 }
 
+// TestNetworkPeerings is a test for the NetworkPeering methods.
+func TestNetworkPeerings(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	first, err := client.CreateNetwork(NetworkConfig{Label: "first"})
+	g.Expect(err).To(BeNil())
+	second, err := client.CreateNetwork(NetworkConfig{Label: "second"})
+	g.Expect(err).To(BeNil())
+
+	peering, err := client.CreateNetworkPeering(&NetworkPeeringConfig{NetworkID: first.ID, PeerNetworkID: second.ID})
+	g.Expect(err).To(BeNil())
+	g.Expect(peering.Status).To(Equal(NetworkPeeringStatusActive))
+
+	external, err := client.CreateNetworkPeering(&NetworkPeeringConfig{NetworkID: first.ID, PeerNetworkID: "other-net", PeerAccountID: "other-account"})
+	g.Expect(err).To(BeNil())
+	g.Expect(external.Status).To(Equal(NetworkPeeringStatusPending))
+
+	_, err = client.CreateNetworkPeering(&NetworkPeeringConfig{NetworkID: first.ID, PeerNetworkID: "missing"})
+	g.Expect(err).ToNot(BeNil())
+
+	peerings, err := client.ListNetworkPeerings()
+	g.Expect(err).To(BeNil())
+	g.Expect(peerings).To(HaveLen(2))
+
+	_, err = client.DeleteNetworkPeering(peering.ID)
+	g.Expect(err).To(BeNil())
+	peerings, err = client.ListNetworkPeerings()
+	g.Expect(err).To(BeNil())
+	g.Expect(peerings).To(HaveLen(1))
+}
+
+// TestObjectStores is a test for the ObjectStore methods.
+func TestObjectStores(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	_, err = client.NewObjectStore(&CreateObjectStoreRequest{Name: "empty"})
+	g.Expect(errors.Is(err, InvalidObjectStoreSizeError)).To(BeTrue())
+
+	store, err := client.NewObjectStore(&CreateObjectStoreRequest{Name: "backups", MaxSizeGB: 500})
+	g.Expect(err).To(BeNil())
+	g.Expect(store.Status).To(Equal(ObjectStoreStatusActive))
+
+	_, err = client.UpdateObjectStore(store.ID, &UpdateObjectStoreRequest{MaxSizeGB: 1000})
+	g.Expect(err).To(BeNil())
+
+	found, err := client.FindObjectStore("back")
+	g.Expect(err).To(BeNil())
+	g.Expect(found.MaxSize).To(Equal(1000))
+
+	stores, err := client.ListObjectStores()
+	g.Expect(err).To(BeNil())
+	g.Expect(stores.Items).To(HaveLen(1))
+
+	resp, err := client.DeleteObjectStore(store.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
+
+	_, err = client.GetObjectStore(store.ID)
+	g.Expect(errors.Is(err, ZeroMatchesError)).To(BeTrue())
+}
+
+// TestObjectStoreCredentials is a test for the ObjectStoreCredential methods.
+func TestObjectStoreCredentials(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	credential, err := client.NewObjectStoreCredential(&CreateObjectStoreCredentialRequest{Name: "owner"})
+	g.Expect(err).To(BeNil())
+	g.Expect(credential.AccessKeyID).ToNot(BeEmpty())
+	g.Expect(credential.SecretAccessKeyID).ToNot(BeEmpty())
+
+	secret := "rotated"
+	_, err = client.UpdateObjectStoreCredential(credential.ID, &UpdateObjectStoreCredentialRequest{SecretAccessKeyID: &secret})
+	g.Expect(err).To(BeNil())
+
+	found, err := client.FindObjectStoreCredential(credential.AccessKeyID)
+	g.Expect(err).To(BeNil())
+	g.Expect(found.SecretAccessKeyID).To(Equal("rotated"))
+
+	credentials, err := client.ListObjectStoreCredentials(0, 0)
+	g.Expect(err).To(BeNil())
+	g.Expect(credentials.Items).To(HaveLen(1))
+
+	resp, err := client.DeleteObjectStoreCredential(credential.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
+}
+
+// TestDatabases is a test for the Database methods.
+func TestDatabases(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	_, err = client.NewDatabase(&CreateDatabaseRequest{Name: "app", Size: "g3.db.small", Software: "Oracle"})
+	g.Expect(errors.Is(err, InvalidDatabaseConfigError)).To(BeTrue())
+
+	db, err := client.NewDatabase(&CreateDatabaseRequest{Name: "app", Size: "g3.db.small", Software: DatabaseSoftwarePostgreSQL})
+	g.Expect(err).To(BeNil())
+	g.Expect(db.Nodes).To(Equal(1))
+	g.Expect(db.Status).To(Equal(DatabaseStatusReady))
+
+	nodes := 3
+	_, err = client.UpdateDatabase(db.ID, &UpdateDatabaseRequest{Nodes: &nodes})
+	g.Expect(err).To(BeNil())
+
+	found, err := client.FindDatabase("APP")
+	g.Expect(err).To(BeNil())
+	g.Expect(found.Nodes).To(Equal(3))
+
+	nodes = 0
+	_, err = client.UpdateDatabase(db.ID, &UpdateDatabaseRequest{Nodes: &nodes})
+	g.Expect(errors.Is(err, InvalidDatabaseConfigError)).To(BeTrue())
+
+	backup, err := client.CreateDatabaseBackup(db.ID, &DatabaseBackupCreateRequest{Name: "before-upgrade"})
+	g.Expect(err).To(BeNil())
+	g.Expect(backup.Status).To(Equal(DatabaseBackupStatusReady))
+
+	_, err = client.CreateDatabaseBackup(db.ID, &DatabaseBackupCreateRequest{Name: "nightly", Schedule: "every night"})
+	g.Expect(errors.Is(err, InvalidDatabaseBackupError)).To(BeTrue())
+
+	_, err = client.RestoreDatabase(db.ID, &RestoreDatabaseRequest{Name: "restored", Backup: backup.Name})
+	g.Expect(err).To(BeNil())
+
+	backups, err := client.ListDatabaseBackup(db.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(backups.Items).To(HaveLen(1))
+
+	resp, err := client.DeleteDatabase(db.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
+
+	databases, err := client.ListDatabases()
+	g.Expect(err).To(BeNil())
+	g.Expect(databases.Items).To(BeEmpty())
+}
+
+// TestSSHKeys is a test for the SSHKey methods.
+func TestSSHKeys(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	_, err = client.NewSSHKey("laptop", testSSHPublicKey)
+	g.Expect(err).To(BeNil())
+	_, err = client.NewSSHKey("laptop-old", "not a key")
+	g.Expect(err).To(BeNil())
+
+	key, err := client.FindSSHKey("laptop")
+	g.Expect(err).To(BeNil())
+	g.Expect(key.Fingerprint).To(Equal("SHA256:KJbpps7iLn/KZJT6NoHgbTwFG3ukX/65TTb4WQhC75s"))
+
+	_, err = client.FindSSHKey("lap")
+	g.Expect(errors.Is(err, MultipleMatchesError)).To(BeTrue())
+}
+
+// TestDiskImages is a test for the DiskImage methods.
+func TestDiskImages(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	created, err := client.CreateDiskImage(&CreateDiskImageParams{
+		Name:         "golden",
+		Distribution: "ubuntu",
+		Version:      "24.04",
+		ImageSHA256:  "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		ImageMD5:     "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		ImageSize:    11,
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(created.UploadURL).NotTo(BeEmpty())
+
+	image, err := client.GetDiskImage(created.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(image.State).To(Equal(DiskImageStateUploading))
+
+	_, err = client.CreateDiskImage(&CreateDiskImageParams{Name: "golden"})
+	g.Expect(errors.Is(err, InvalidDiskImageError)).To(BeTrue())
+}
+
+// TestRegions is a test for the Region methods.
+func TestRegions(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	region, err := client.GetDefaultRegion()
+	g.Expect(err).To(BeNil())
+	g.Expect(region.Code).To(Equal("FAKE1"))
+	g.Expect(region.Supports(RegionFeatureKubernetes)).To(BeTrue())
+	g.Expect(region.Supports(RegionFeatureGPU)).To(BeFalse())
+
+	_, err = client.FindRegion("LON1")
+	g.Expect(errors.Is(err, ZeroMatchesError)).To(BeTrue())
+}
+
+// TestAPIKeys is a test for the APIKey methods.
+func TestAPIKeys(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	key, err := client.CreateAPIKey(&CreateAPIKeyConfig{Name: "ci", Permissions: "kubernetes.*"})
+	g.Expect(err).To(BeNil())
+	g.Expect(key.Key).NotTo(BeEmpty())
+	g.Expect(key.Scoped()).To(BeTrue())
+
+	keys, err := client.ListAPIKeys()
+	g.Expect(err).To(BeNil())
+	g.Expect(keys).To(HaveLen(1))
+	g.Expect(keys[0].Key).To(BeEmpty())
+
+	resp, err := client.RevokeAPIKey(key.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
+}
+
+// TestRoles is a test for the Role methods.
+func TestRoles(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	role, err := client.CreateRole("deployers", "kubernetes.*,instance.create")
+	g.Expect(err).To(BeNil())
+	g.Expect(role.Allows("kubernetes.update")).To(BeTrue())
+
+	_, err = client.CreateRole("broken", "kubernetes")
+	g.Expect(errors.Is(err, InvalidPermissionError)).To(BeTrue())
+
+	resp, err := client.DeleteRole(role.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
+}
+
+// TestTeams is a test for the Team methods.
+func TestTeams(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	team, err := client.CreateTeam("developers")
+	g.Expect(err).To(BeNil())
+
+	members, err := client.AddTeamMember(team.ID, "user-1", "instances.*", "")
+	g.Expect(err).To(BeNil())
+	g.Expect(members).To(HaveLen(1))
+
+	_, err = client.UpdateTeamMember(team.ID, members[0].ID, "instances.*", "billing")
+	g.Expect(err).To(BeNil())
+
+	members, err = client.ListTeamMembers(team.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(members[0].RoleIDs()).To(Equal([]string{"billing"}))
+
+	renamed, err := client.RenameTeam(team.ID, "platform")
+	g.Expect(err).To(BeNil())
+	g.Expect(renamed.Name).To(Equal("platform"))
+
+	found, err := client.FindTeam("platform")
+	g.Expect(err).To(BeNil())
+	g.Expect(found.ID).To(Equal(team.ID))
+}
+
 // TestLoadBalancers is a test for the LoadBalancers method.
 func TestLoadBalancers(t *testing.T) {
 	g := NewWithT(t)
@@ -148,11 +447,59 @@ func TestLoadBalancers(t *testing.T) {
 	g.Expect(loadbalancer.Backends).To(Equal(expected.Backends))
 	g.Expect(loadbalancer.ExternalTrafficPolicy).To(Equal(expected.ExternalTrafficPolicy))
 
+	_, err = client.UpdateLoadBalancer(loadbalancer.ID, &LoadBalancerUpdateConfig{
+		Name:                         "bar",
+		Algorithm:                    LoadBalancerAlgorithmLeastConnections,
+		SessionAffinity:              LoadBalancerSessionAffinityClientIP,
+		SessionAffinityConfigTimeout: 600,
+		Backends:                     backendConfig,
+	})
+	g.Expect(err).To(BeNil())
+
+	loadbalancer, err = client.GetLoadBalancer(loadbalancer.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(loadbalancer.Name).To(Equal("bar"))
+	g.Expect(loadbalancer.Algorithm).To(Equal(LoadBalancerAlgorithmLeastConnections))
+	g.Expect(loadbalancer.SessionAffinity).To(Equal(LoadBalancerSessionAffinityClientIP))
+	g.Expect(loadbalancer.Backends).To(Equal(expected.Backends))
+
+	health, err := client.GetLoadBalancerHealth(loadbalancer.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(health.Healthy()).To(BeTrue())
+	g.Expect(health.Backends).To(HaveLen(1))
+
+	_, err = client.UpdateLoadBalancer(loadbalancer.ID, &LoadBalancerUpdateConfig{Algorithm: "random"})
+	g.Expect(errors.Is(err, InvalidLoadBalancerConfigError)).To(BeTrue())
+
 	resp, err := client.DeleteLoadBalancer(loadbalancer.ID)
 	g.Expect(err).To(BeNil())
 	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
 }
 
+// TestFakeFindFirewall is a test for the FindFirewall method.
+func TestFakeFindFirewall(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	client.Firewalls = []Firewall{
+		{ID: "1", Name: "web-instance"},
+		{ID: "2", Name: "web"},
+		{ID: "3", Name: "web-node"},
+	}
+
+	firewall, err := client.FindFirewall("web")
+	g.Expect(err).To(BeNil())
+	g.Expect(firewall.ID).To(Equal("2"))
+
+	_, err = client.FindFirewall("web-")
+	g.Expect(errors.Is(err, MultipleMatchesError)).To(BeTrue())
+
+	_, err = client.FindFirewall("missing")
+	g.Expect(errors.Is(err, ZeroMatchesError)).To(BeTrue())
+}
+
 // TestKubernetesClustersInstances is a test for the KubernetesClustersInstances method.
 func TestKubernetesClustersInstances(t *testing.T) {
 	g := NewWithT(t)
@@ -257,6 +604,26 @@ func TestKubernetesClustersPools(t *testing.T) {
 	pool, err = client.UpdateKubernetesClusterPool("9c89d8b9-463d-45f2-8928-455eb3f3726", "33de5de2-14fd-44ba-a621-f6efbeeb9639", &pc)
 	g.Expect(err).To(BeNil())
 	g.Expect(pool.Count).To(Equal(4))
+
+	pool, err = client.GetKubernetesClusterPool("9c89d8b9-463d-45f2-8928-455eb3f3726", "33de5de2-14fd-44ba-a621-f6efbeeb9639")
+	g.Expect(err).To(BeNil())
+	g.Expect(pool.Count).To(Equal(4))
+
+	result, err = client.CreateKubernetesClusterPool("9c89d8b9-463d-45f2-8928-455eb3f3726", &KubernetesClusterPoolConfig{ID: "gpu-pool", Count: 1, Size: "an.g1.l40s.kube.x1"})
+	g.Expect(err).To(BeNil())
+	g.Expect(string(result.Result)).To(Equal("success"))
+
+	pools, err = client.ListKubernetesClusterPools("9c89d8b9-463d-45f2-8928-455eb3f3726")
+	g.Expect(err).To(BeNil())
+	g.Expect(len(pools)).To(Equal(2))
+
+	result, err = client.DeleteKubernetesClusterPool("9c89d8b9-463d-45f2-8928-455eb3f3726", "gpu-pool")
+	g.Expect(err).To(BeNil())
+	g.Expect(string(result.Result)).To(Equal("success"))
+
+	pools, err = client.ListKubernetesClusterPools("9c89d8b9-463d-45f2-8928-455eb3f3726")
+	g.Expect(err).To(BeNil())
+	g.Expect(len(pools)).To(Equal(1))
 }
 
 func TestPing(t *testing.T) {
@@ -284,3 +651,144 @@ func TestPing(t *testing.T) {
 		t.Errorf("Expected nil, got '%v'", err)
 	}
 }
+
+func TestFakeInstanceTags(t *testing.T) {
+	g := NewWithT(t)
+
+	client, _ := NewFakeClient()
+	instance, err := client.CreateInstance(&InstanceConfig{Hostname: "web", Tags: []string{"prod"}})
+	g.Expect(err).To(BeNil())
+
+	_, err = client.AddInstanceTags(instance.ID, []string{"web", "prod"})
+	g.Expect(err).To(BeNil())
+	instance, _ = client.GetInstance(instance.ID)
+	g.Expect(instance.Tags).To(Equal([]string{"prod", "web"}))
+
+	_, err = client.RemoveInstanceTags(instance.ID, []string{"prod"})
+	g.Expect(err).To(BeNil())
+	instance, _ = client.GetInstance(instance.ID)
+	g.Expect(instance.Tags).To(Equal([]string{"web"}))
+
+	filtered, err := client.ListInstancesWithFilter(&InstanceFilter{Tags: []string{"web"}})
+	g.Expect(err).To(BeNil())
+	g.Expect(filtered.Items).To(HaveLen(1))
+}
+
+func TestFakeInstanceRecovery(t *testing.T) {
+	g := NewWithT(t)
+
+	client, _ := NewFakeClient()
+	instance, _ := client.CreateInstance(&InstanceConfig{Hostname: "web"})
+
+	status, err := client.GetInstanceRecoveryStatus(instance.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(status.Enabled()).To(BeFalse())
+
+	_, err = client.EnableInstanceRecovery(instance.ID)
+	g.Expect(err).To(BeNil())
+	status, _ = client.GetInstanceRecoveryStatus(instance.ID)
+	g.Expect(status.Enabled()).To(BeTrue())
+
+	_, err = client.DisableInstanceRecovery(instance.ID)
+	g.Expect(err).To(BeNil())
+	status, _ = client.GetInstanceRecoveryStatus(instance.ID)
+	g.Expect(status.Enabled()).To(BeFalse())
+
+	_, err = client.EnableInstanceRecovery("missing")
+	g.Expect(errors.Is(err, ZeroMatchesError)).To(BeTrue())
+}
+
+func TestFakeDatabaseSources(t *testing.T) {
+	g := NewWithT(t)
+
+	client, _ := NewFakeClient()
+	client.Databases = []Database{{ID: "db-1", Name: "app", FirewallID: "fw-1", Port: 5432}}
+
+	rule, err := client.AllowDatabaseSource("db-1", "10.0.0.1")
+	g.Expect(err).To(BeNil())
+	g.Expect(rule.Cidr).To(Equal([]string{"10.0.0.1/32"}))
+
+	sources, err := client.ListDatabaseAllowedSources("db-1")
+	g.Expect(err).To(BeNil())
+	g.Expect(sources).To(Equal([]string{"10.0.0.1/32"}))
+
+	g.Expect(client.RevokeDatabaseSource("db-1", "10.0.0.1/32")).To(BeNil())
+	sources, _ = client.ListDatabaseAllowedSources("db-1")
+	g.Expect(sources).To(BeEmpty())
+
+	err = client.RevokeDatabaseSource("db-1", "10.0.0.1/32")
+	g.Expect(errors.Is(err, ZeroMatchesError)).To(BeTrue())
+}
+
+func TestFakeApplications(t *testing.T) {
+	g := NewWithT(t)
+
+	client, _ := NewFakeClient()
+	app, err := client.CreateApplication(&ApplicationConfig{Name: "api", NetworkID: "net-1", Size: "small"})
+	g.Expect(err).To(BeNil())
+
+	found, err := client.FindApplication("ap")
+	g.Expect(err).To(BeNil())
+	g.Expect(found.ID).To(Equal(app.ID))
+
+	updated, err := client.UpdateApplication(app.ID, &UpdateApplicationRequest{Image: "nginx"})
+	g.Expect(err).To(BeNil())
+	g.Expect(updated.Image).To(Equal("nginx"))
+	g.Expect(updated.Name).To(Equal("api"))
+
+	_, err = client.DeleteApplication(app.ID)
+	g.Expect(err).To(BeNil())
+	apps, _ := client.ListApplications()
+	g.Expect(apps.Items).To(BeEmpty())
+}
+
+func TestFakeKfClusters(t *testing.T) {
+	g := NewWithT(t)
+
+	client, _ := NewFakeClient()
+	kfc, err := client.CreateKfCluster(CreateKfClusterReq{Name: "ml", NetworkID: "net-1"})
+	g.Expect(err).To(BeNil())
+
+	renamed, err := client.UpdateKfCluster(kfc.ID, &UpdateKfClusterReq{Name: "ml-prod"})
+	g.Expect(err).To(BeNil())
+	g.Expect(renamed.Name).To(Equal("ml-prod"))
+
+	found, err := client.FindKfCluster("prod")
+	g.Expect(err).To(BeNil())
+	g.Expect(found.ID).To(Equal(kfc.ID))
+
+	_, err = client.DeleteKfCluster(kfc.ID)
+	g.Expect(err).To(BeNil())
+	_, err = client.GetKfCluster(kfc.ID)
+	g.Expect(errors.Is(err, ZeroMatchesError)).To(BeTrue())
+}
+
+func TestFakeSnapshotSchedules(t *testing.T) {
+	g := NewWithT(t)
+
+	client, _ := NewFakeClient()
+	_, err := client.CreateSnapshotSchedule(&CreateSnapshotScheduleRequest{Name: "nightly", CronExpression: "every night"})
+	g.Expect(errors.Is(err, InvalidSnapshotScheduleError)).To(BeTrue())
+
+	schedule, err := client.CreateSnapshotSchedule(&CreateSnapshotScheduleRequest{
+		Name:           "nightly",
+		CronExpression: "0 2 * * *",
+		Retention:      SnapshotRetention{MaxSnapshots: 7},
+		Instances:      []SnapshotScheduleInstance{{ID: "instance-1"}},
+	})
+	g.Expect(err).To(BeNil())
+
+	paused := true
+	updated, err := client.UpdateSnapshotSchedule(schedule.ID, &UpdateSnapshotScheduleRequest{Paused: &paused})
+	g.Expect(err).To(BeNil())
+	g.Expect(updated.Paused).To(BeTrue())
+	g.Expect(updated.CronExpression).To(Equal("0 2 * * *"))
+
+	schedules, _ := client.ListSnapshotSchedules()
+	g.Expect(schedules).To(HaveLen(1))
+
+	resp, err := client.DeleteSnapshotSchedule(schedule.ID)
+	EnsureSuccessfulSimpleResponse(t, resp, err)
+	_, err = client.GetSnapshotSchedule(schedule.ID)
+	g.Expect(errors.Is(err, ZeroMatchesError)).To(BeTrue())
+}
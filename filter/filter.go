@@ -0,0 +1,210 @@
+// Package filter contains composable predicates and sort helpers for the resources
+// returned by the civogo list methods, so the same filtering works across instances,
+// volumes, firewalls and the other listable types
+package filter
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/civo/civogo"
+)
+
+// Predicate reports whether a resource should be kept
+type Predicate[T any] func(T) bool
+
+// Apply returns the items matching every predicate, keeping their order
+func Apply[T any](items []T, predicates ...Predicate[T]) []T {
+	match := And(predicates...)
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if match(item) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// And matches items that match every predicate, no predicates match everything
+func And[T any](predicates ...Predicate[T]) Predicate[T] {
+	return func(item T) bool {
+		for _, p := range predicates {
+			if !p(item) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or matches items that match any of the predicates
+func Or[T any](predicates ...Predicate[T]) Predicate[T] {
+	return func(item T) bool {
+		for _, p := range predicates {
+			if p(item) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not matches items the predicate doesn't
+func Not[T any](p Predicate[T]) Predicate[T] {
+	return func(item T) bool {
+		return !p(item)
+	}
+}
+
+// ByName matches resources with exactly this name, or hostname for instances
+func ByName[T any](name string) Predicate[T] {
+	return func(item T) bool {
+		n, ok := nameOf(item)
+		return ok && n == name
+	}
+}
+
+// ByNamePrefix matches resources whose name starts with prefix
+func ByNamePrefix[T any](prefix string) Predicate[T] {
+	return func(item T) bool {
+		n, ok := nameOf(item)
+		return ok && strings.HasPrefix(n, prefix)
+	}
+}
+
+// ByStatus matches resources in any of the statuses, ignoring case. Load balancers are
+// matched on their state
+func ByStatus[T any](statuses ...string) Predicate[T] {
+	return func(item T) bool {
+		s, ok := statusOf(item)
+		if !ok {
+			return false
+		}
+		for _, status := range statuses {
+			if strings.EqualFold(s, status) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ByTag matches resources that have the tag
+func ByTag[T any](tag string) Predicate[T] {
+	return func(item T) bool {
+		for _, t := range tagsOf(item) {
+			if t == tag {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// CreatedBefore matches resources created before t, resources without a creation time never match
+func CreatedBefore[T any](t time.Time) Predicate[T] {
+	return func(item T) bool {
+		created, ok := createdAtOf(item)
+		return ok && !created.IsZero() && created.Before(t)
+	}
+}
+
+// CreatedAfter matches resources created after t, resources without a creation time never match
+func CreatedAfter[T any](t time.Time) Predicate[T] {
+	return func(item T) bool {
+		created, ok := createdAtOf(item)
+		return ok && created.After(t)
+	}
+}
+
+// SortByName sorts the items by name in place, items without a name sort first
+func SortByName[T any](items []T) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, _ := nameOf(items[i])
+		b, _ := nameOf(items[j])
+		return a < b
+	})
+}
+
+// SortByCreatedAt sorts the items oldest first in place, items without a creation time sort first
+func SortByCreatedAt[T any](items []T) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, _ := createdAtOf(items[i])
+		b, _ := createdAtOf(items[j])
+		return a.Before(b)
+	})
+}
+
+func nameOf(item any) (string, bool) {
+	switch v := item.(type) {
+	case civogo.Instance:
+		return v.Hostname, true
+	case civogo.Volume:
+		return v.Name, true
+	case civogo.Firewall:
+		return v.Name, true
+	case civogo.Network:
+		if v.Label != "" {
+			return v.Label, true
+		}
+		return v.Name, true
+	case civogo.KubernetesCluster:
+		return v.Name, true
+	case civogo.LoadBalancer:
+		return v.Name, true
+	case civogo.Database:
+		return v.Name, true
+	case civogo.ObjectStore:
+		return v.Name, true
+	case civogo.SSHKey:
+		return v.Name, true
+	case civogo.DNSDomain:
+		return v.Name, true
+	}
+	return "", false
+}
+
+func statusOf(item any) (string, bool) {
+	switch v := item.(type) {
+	case civogo.Instance:
+		return v.Status, true
+	case civogo.Volume:
+		return v.Status, true
+	case civogo.Network:
+		return v.Status, true
+	case civogo.KubernetesCluster:
+		return v.Status, true
+	case civogo.LoadBalancer:
+		return v.State, true
+	case civogo.Database:
+		return v.Status, true
+	case civogo.ObjectStore:
+		return v.Status, true
+	}
+	return "", false
+}
+
+func tagsOf(item any) []string {
+	switch v := item.(type) {
+	case civogo.Instance:
+		return v.Tags
+	case civogo.KubernetesCluster:
+		return v.Tags
+	}
+	return nil
+}
+
+func createdAtOf(item any) (time.Time, bool) {
+	switch v := item.(type) {
+	case civogo.Instance:
+		return v.CreatedAt, true
+	case civogo.Volume:
+		return v.CreatedAt, true
+	case civogo.KubernetesCluster:
+		return v.CreatedAt, true
+	case civogo.SSHKey:
+		return v.CreatedAt, true
+	}
+	return time.Time{}, false
+}
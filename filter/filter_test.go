@@ -0,0 +1,73 @@
+package filter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/civo/civogo"
+)
+
+var now = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+var instances = []civogo.Instance{
+	{ID: "i-1", Hostname: "ci-web", Status: "ACTIVE", Tags: []string{"ci"}, CreatedAt: now.Add(-48 * time.Hour)},
+	{ID: "i-2", Hostname: "prod-web", Status: "ACTIVE", Tags: []string{"prod"}, CreatedAt: now.Add(-72 * time.Hour)},
+	{ID: "i-3", Hostname: "ci-db", Status: "SHUTOFF", Tags: []string{"ci"}, CreatedAt: now.Add(-time.Hour)},
+}
+
+func ids(items []civogo.Instance) []string {
+	result := []string{}
+	for _, item := range items {
+		result = append(result, item.ID)
+	}
+	return result
+}
+
+func TestApply(t *testing.T) {
+	cases := map[string]struct {
+		predicates []Predicate[civogo.Instance]
+		expected   []string
+	}{
+		"name":           {[]Predicate[civogo.Instance]{ByName[civogo.Instance]("prod-web")}, []string{"i-2"}},
+		"prefix":         {[]Predicate[civogo.Instance]{ByNamePrefix[civogo.Instance]("ci-")}, []string{"i-1", "i-3"}},
+		"status":         {[]Predicate[civogo.Instance]{ByStatus[civogo.Instance]("active")}, []string{"i-1", "i-2"}},
+		"tag and status": {[]Predicate[civogo.Instance]{ByTag[civogo.Instance]("ci"), ByStatus[civogo.Instance]("SHUTOFF")}, []string{"i-3"}},
+		"created before": {[]Predicate[civogo.Instance]{CreatedBefore[civogo.Instance](now.Add(-24 * time.Hour))}, []string{"i-1", "i-2"}},
+		"not":            {[]Predicate[civogo.Instance]{Not(ByTag[civogo.Instance]("ci"))}, []string{"i-2"}},
+		"or":             {[]Predicate[civogo.Instance]{Or(ByName[civogo.Instance]("ci-db"), ByName[civogo.Instance]("prod-web"))}, []string{"i-2", "i-3"}},
+		"none":           {nil, []string{"i-1", "i-2", "i-3"}},
+	}
+
+	for name, c := range cases {
+		if got := ids(Apply(instances, c.predicates...)); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", name, c.expected, got)
+		}
+	}
+}
+
+func TestApplyAcrossTypes(t *testing.T) {
+	volumes := []civogo.Volume{{ID: "vol-1", Name: "ci-data", Status: "available"}, {ID: "vol-2", Name: "prod-data", Status: "attached"}}
+	if got := Apply(volumes, ByNamePrefix[civogo.Volume]("ci-"), ByStatus[civogo.Volume]("available")); len(got) != 1 || got[0].ID != "vol-1" {
+		t.Errorf("Unexpected volumes %+v", got)
+	}
+
+	firewalls := []civogo.Firewall{{ID: "fw-1", Name: "ci-fw"}}
+	if got := Apply(firewalls, ByStatus[civogo.Firewall]("active")); len(got) != 0 {
+		t.Errorf("Expected firewalls without a status not to match, got %+v", got)
+	}
+}
+
+func TestSort(t *testing.T) {
+	sorted := append([]civogo.Instance{}, instances...)
+
+	SortByName(sorted)
+	if got := ids(sorted); !reflect.DeepEqual(got, []string{"i-3", "i-1", "i-2"}) {
+		t.Errorf("Unexpected order by name %v", got)
+	}
+
+	SortByCreatedAt(sorted)
+	if got := ids(sorted); !reflect.DeepEqual(got, []string{"i-2", "i-1", "i-3"}) {
+		t.Errorf("Unexpected order by creation time %v", got)
+	}
+}
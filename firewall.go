@@ -4,7 +4,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
+
+	"github.com/civo/civogo/netutil"
+	"github.com/google/go-querystring/query"
 )
 
 // Firewall represents list of rule in Civo's infrastructure
@@ -19,6 +23,59 @@ type Firewall struct {
 	Rules             []FirewallRule `json:"rules,omitempty"`
 }
 
+// UnmarshalJSON decodes a Firewall, accepting the counts as either numbers or the
+// numeric strings returned by older versions of the API
+func (f *Firewall) UnmarshalJSON(data []byte) error {
+	type alias Firewall
+	aux := struct {
+		*alias
+		RulesCount        flexibleInt `json:"rules_count"`
+		InstanceCount     flexibleInt `json:"instance_count"`
+		ClusterCount      flexibleInt `json:"cluster_count"`
+		LoadBalancerCount flexibleInt `json:"loadbalancer_count"`
+	}{alias: (*alias)(f)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	f.RulesCount = int(aux.RulesCount)
+	f.InstanceCount = int(aux.InstanceCount)
+	f.ClusterCount = int(aux.ClusterCount)
+	f.LoadBalancerCount = int(aux.LoadBalancerCount)
+
+	return nil
+}
+
+// flexibleInt is an int that can be decoded from a JSON number, a numeric string or null
+type flexibleInt int
+
+func (i *flexibleInt) UnmarshalJSON(data []byte) error {
+	value := string(data)
+	if value == "null" {
+		*i = 0
+		return nil
+	}
+
+	if strings.HasPrefix(value, `"`) {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return fmt.Errorf("invalid count %s: %w", data, err)
+		}
+		if value == "" {
+			*i = 0
+			return nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("invalid count %s: %w", data, err)
+	}
+
+	*i = flexibleInt(n)
+	return nil
+}
+
 // FirewallResult is the response from the Civo Firewall APIs
 type FirewallResult struct {
 	ID     string `json:"id"`
@@ -41,6 +98,38 @@ type FirewallRule struct {
 	Ports      string   `json:"ports,omitempty"`
 }
 
+// FirewallProtocol represents the allowed protocols for a firewall rule: tcp, udp or icmp
+type FirewallProtocol string
+
+// FirewallDirection represents the allowed directions for a firewall rule: ingress or egress
+type FirewallDirection string
+
+// FirewallAction represents the allowed actions for a firewall rule: allow or deny
+type FirewallAction string
+
+const (
+	// FirewallProtocolTCP represents the TCP protocol
+	FirewallProtocolTCP FirewallProtocol = "tcp"
+
+	// FirewallProtocolUDP represents the UDP protocol
+	FirewallProtocolUDP FirewallProtocol = "udp"
+
+	// FirewallProtocolICMP represents the ICMP protocol
+	FirewallProtocolICMP FirewallProtocol = "icmp"
+
+	// FirewallDirectionIngress represents incoming traffic
+	FirewallDirectionIngress FirewallDirection = "ingress"
+
+	// FirewallDirectionEgress represents outgoing traffic
+	FirewallDirectionEgress FirewallDirection = "egress"
+
+	// FirewallActionAllow allows the matching traffic
+	FirewallActionAllow FirewallAction = "allow"
+
+	// FirewallActionDeny denies the matching traffic
+	FirewallActionDeny FirewallAction = "deny"
+)
+
 // FirewallRuleConfig is how you specify the details when creating a new rule
 type FirewallRuleConfig struct {
 	FirewallID string   `json:"firewall_id"`
@@ -56,6 +145,126 @@ type FirewallRuleConfig struct {
 	Ports string `json:"ports,omitempty"`
 }
 
+// Validate checks the rule for invalid values and combinations before it's sent to the API,
+// empty protocol, direction and action are left for the API to default
+func (r *FirewallRuleConfig) Validate() error {
+	switch FirewallProtocol(strings.ToLower(r.Protocol)) {
+	case "", FirewallProtocolTCP, FirewallProtocolUDP, FirewallProtocolICMP:
+	default:
+		err := fmt.Errorf("invalid protocol %q, must be one of tcp, udp or icmp", r.Protocol)
+		return InvalidFirewallRuleError.wrap(err)
+	}
+
+	switch FirewallDirection(strings.ToLower(r.Direction)) {
+	case "", FirewallDirectionIngress, FirewallDirectionEgress:
+	default:
+		err := fmt.Errorf("invalid direction %q, must be one of ingress or egress", r.Direction)
+		return InvalidFirewallRuleError.wrap(err)
+	}
+
+	switch FirewallAction(strings.ToLower(r.Action)) {
+	case "", FirewallActionAllow, FirewallActionDeny:
+	default:
+		err := fmt.Errorf("invalid action %q, must be one of allow or deny", r.Action)
+		return InvalidFirewallRuleError.wrap(err)
+	}
+
+	if _, err := netutil.NormalizeCIDRs(r.Cidr); err != nil {
+		return InvalidFirewallRuleError.wrap(err)
+	}
+
+	hasPorts := r.Ports != "" || r.StartPort != "" || r.EndPort != ""
+	if FirewallProtocol(strings.ToLower(r.Protocol)) == FirewallProtocolICMP {
+		if hasPorts {
+			err := fmt.Errorf("ports can't be set for icmp rules")
+			return InvalidFirewallRuleError.wrap(err)
+		}
+		return nil
+	}
+
+	if r.Ports != "" {
+		for _, part := range strings.Split(r.Ports, ",") {
+			bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+			end := bounds[0]
+			if len(bounds) == 2 {
+				end = bounds[1]
+			}
+			if err := validatePortRange(bounds[0], end); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if r.StartPort != "" {
+		end := r.EndPort
+		if end == "" {
+			end = r.StartPort
+		}
+		return validatePortRange(r.StartPort, end)
+	}
+
+	if r.EndPort != "" {
+		err := fmt.Errorf("end port %s set without a start port", r.EndPort)
+		return InvalidFirewallRuleError.wrap(err)
+	}
+
+	return nil
+}
+
+func validatePortRange(start, end string) error {
+	startPort, err := strconv.Atoi(strings.TrimSpace(start))
+	if err != nil || startPort < 1 || startPort > 65535 {
+		err := fmt.Errorf("invalid port %q, must be between 1 and 65535", start)
+		return InvalidFirewallRuleError.wrap(err)
+	}
+
+	endPort, err := strconv.Atoi(strings.TrimSpace(end))
+	if err != nil || endPort < 1 || endPort > 65535 {
+		err := fmt.Errorf("invalid port %q, must be between 1 and 65535", end)
+		return InvalidFirewallRuleError.wrap(err)
+	}
+
+	if startPort > endPort {
+		err := fmt.Errorf("invalid port range %d-%d, the start port is after the end port", startPort, endPort)
+		return InvalidFirewallRuleError.wrap(err)
+	}
+
+	return nil
+}
+
+// FirewallRuleFilter narrows down the rules returned by ListFirewallRulesWithFilter,
+// empty fields aren't used for filtering
+type FirewallRuleFilter struct {
+	Direction string `url:"direction,omitempty"`
+	Protocol  string `url:"protocol,omitempty"`
+	// PortRange matches the rule's ports, e.g. "443" or "8000-9000"
+	PortRange string `url:"ports,omitempty"`
+	Label     string `url:"label,omitempty"`
+	Page      int    `url:"page,omitempty"`
+	PerPage   int    `url:"per_page,omitempty"`
+}
+
+// PaginatedFirewallRules returns a paginated list of FirewallRule object
+type PaginatedFirewallRules struct {
+	Page    int            `json:"page"`
+	PerPage int            `json:"per_page"`
+	Pages   int            `json:"pages"`
+	Items   []FirewallRule `json:"items"`
+}
+
+// FirewallAttachments lists the resources currently using a firewall
+type FirewallAttachments struct {
+	Instances          []Instance
+	KubernetesClusters []KubernetesCluster
+	LoadBalancers      []LoadBalancer
+}
+
+// InUse returns true if any resource is using the firewall
+func (a *FirewallAttachments) InUse() bool {
+	return len(a.Instances) > 0 || len(a.KubernetesClusters) > 0 || len(a.LoadBalancers) > 0
+}
+
 // FirewallConfig is how you specify the details when creating a new firewall
 type FirewallConfig struct {
 	Name      string `json:"name"`
@@ -81,6 +290,21 @@ func (c *Client) ListFirewalls() ([]Firewall, error) {
 	return firewall, nil
 }
 
+// GetFirewall returns a single firewall by its full ID, including its rules
+func (c *Client) GetFirewall(id string) (*Firewall, error) {
+	resp, err := c.SendGetRequest("/v2/firewalls/" + id)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	firewall := &Firewall{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(firewall); err != nil {
+		return nil, err
+	}
+
+	return firewall, nil
+}
+
 // FindFirewall finds a firewall by either part of the ID or part of the name
 func (c *Client) FindFirewall(search string) (*Firewall, error) {
 	firewalls, err := c.ListFirewalls()
@@ -115,9 +339,55 @@ func (c *Client) FindFirewall(search string) (*Firewall, error) {
 	}
 }
 
-// NewFirewall creates a new firewall record
+// ListFirewallAttachments returns the instances, Kubernetes clusters and load balancers using a firewall
+func (c *Client) ListFirewallAttachments(id string) (*FirewallAttachments, error) {
+	attachments := &FirewallAttachments{
+		Instances:          []Instance{},
+		KubernetesClusters: []KubernetesCluster{},
+		LoadBalancers:      []LoadBalancer{},
+	}
+
+	instances, err := c.ListAllInstances()
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if instance.FirewallID == id {
+			attachments.Instances = append(attachments.Instances, instance)
+		}
+	}
+
+	clusters, err := listAllPages[KubernetesCluster](c, "/v2/kubernetes/clusters")
+	if err != nil {
+		return nil, err
+	}
+	for _, cluster := range clusters {
+		if cluster.FirewallID == id {
+			attachments.KubernetesClusters = append(attachments.KubernetesClusters, cluster)
+		}
+	}
+
+	loadbalancers, err := c.ListLoadBalancers()
+	if err != nil {
+		return nil, err
+	}
+	for _, loadbalancer := range loadbalancers {
+		if loadbalancer.FirewallID == id {
+			attachments.LoadBalancers = append(attachments.LoadBalancers, loadbalancer)
+		}
+	}
+
+	return attachments, nil
+}
+
+// NewFirewall creates a new firewall record, in the client's region unless the config sets one
 func (c *Client) NewFirewall(firewall *FirewallConfig) (*FirewallResult, error) {
-	body, err := c.SendPostRequest("/v2/firewalls", firewall)
+	config := *firewall
+	if config.Region == "" {
+		config.Region = c.Region
+	}
+
+	body, err := c.SendPostRequest("/v2/firewalls", config)
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -130,10 +400,59 @@ func (c *Client) NewFirewall(firewall *FirewallConfig) (*FirewallResult, error)
 	return result, nil
 }
 
+// EnsureFirewall returns the firewall whose name exactly matches the config,
+// creating it if it doesn't exist yet. If the config sets a NetworkID, only
+// a firewall in that network is considered a match.
+func (c *Client) EnsureFirewall(firewall *FirewallConfig) (*Firewall, error) {
+	if firewall.Name == "" {
+		err := fmt.Errorf("the firewall name is empty")
+		return nil, ParameterNameInvalidError.wrap(err)
+	}
+
+	firewalls, err := c.ListFirewalls()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, value := range firewalls {
+		if value.Name != firewall.Name {
+			continue
+		}
+		if firewall.NetworkID != "" && value.NetworkID != firewall.NetworkID {
+			continue
+		}
+		return &value, nil
+	}
+
+	result, err := c.NewFirewall(firewall)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetFirewall(result.ID)
+}
+
 // RenameFirewall rename firewall
 func (c *Client) RenameFirewall(id string, f *FirewallConfig) (*SimpleResponse, error) {
-	f.Region = c.Region
-	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/firewalls/%s", id), f)
+	return c.UpdateFirewall(id, f)
+}
+
+// firewallUpdateRequest is the payload of UpdateFirewall, an empty network ID is left out so
+// the firewall stays in its current network
+type firewallUpdateRequest struct {
+	Name      string `json:"name"`
+	Region    string `json:"region"`
+	NetworkID string `json:"network_id,omitempty"`
+}
+
+// UpdateFirewall updates a firewall's name and the network it's associated with, the network
+// is left unchanged if the config doesn't set one
+func (c *Client) UpdateFirewall(id string, f *FirewallConfig) (*SimpleResponse, error) {
+	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/firewalls/%s", id), firewallUpdateRequest{
+		Name:      f.Name,
+		Region:    c.Region,
+		NetworkID: f.NetworkID,
+	})
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -142,7 +461,13 @@ func (c *Client) RenameFirewall(id string, f *FirewallConfig) (*SimpleResponse,
 }
 
 // DeleteFirewall deletes an firewall
+// With ResolveNames set the firewall's name can be used instead of its ID
 func (c *Client) DeleteFirewall(id string) (*SimpleResponse, error) {
+	id, err := c.resolveExactID(ResourceTypeFirewall, id)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.SendDeleteRequest("/v2/firewalls/" + id)
 	if err != nil {
 		return nil, decodeError(err)
@@ -158,9 +483,12 @@ func (c *Client) NewFirewallRule(r *FirewallRuleConfig) (*FirewallRule, error) {
 		return nil, IDisEmptyError.wrap(err)
 	}
 
-	r.Region = c.Region
+	config, err := c.firewallRulePayload(r.FirewallID, r)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/firewalls/%s/rules", r.FirewallID), r)
+	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/firewalls/%s/rules", r.FirewallID), config)
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -173,6 +501,27 @@ func (c *Client) NewFirewallRule(r *FirewallRuleConfig) (*FirewallRule, error) {
 	return rule, nil
 }
 
+// firewallRulePayload validates a rule and returns a copy of it to send to the API, with the
+// firewall and region set and the CIDRs normalized, so bare IP addresses become single host ranges
+func (c *Client) firewallRulePayload(firewallID string, r *FirewallRuleConfig) (*FirewallRuleConfig, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	config := *r
+	config.FirewallID = firewallID
+	config.Region = c.Region
+	if len(r.Cidr) > 0 {
+		cidrs, err := netutil.NormalizeCIDRs(r.Cidr)
+		if err != nil {
+			return nil, InvalidFirewallRuleError.wrap(err)
+		}
+		config.Cidr = cidrs
+	}
+
+	return &config, nil
+}
+
 // ListFirewallRules get all rules for a firewall
 func (c *Client) ListFirewallRules(id string) ([]FirewallRule, error) {
 	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/firewalls/%s/rules", id))
@@ -188,31 +537,227 @@ func (c *Client) ListFirewallRules(id string) ([]FirewallRule, error) {
 	return firewallRule, nil
 }
 
-// FindFirewallRule finds a firewall Rule by ID or part of the same
+// ListFirewallRulesWithFilter returns a page of the rules for a firewall matching the filter.
+// If the API returns the full rule set instead of a page, the filtering and pagination are
+// applied client-side so callers get the same result either way.
+func (c *Client) ListFirewallRulesWithFilter(id string, filter *FirewallRuleFilter) (*PaginatedFirewallRules, error) {
+	if filter == nil {
+		filter = &FirewallRuleFilter{}
+	}
+
+	vals, err := query.Values(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/firewalls/%s/rules?%s", id, vals.Encode()))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	if trimmed := bytes.TrimSpace(resp); len(trimmed) > 0 && trimmed[0] == '{' {
+		page := &PaginatedFirewallRules{}
+		if err := json.NewDecoder(bytes.NewReader(resp)).Decode(page); err != nil {
+			return nil, err
+		}
+		return page, nil
+	}
+
+	rules := make([]FirewallRule, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&rules); err != nil {
+		return nil, err
+	}
+
+	return paginateFirewallRules(filterFirewallRules(rules, filter), filter.Page, filter.PerPage), nil
+}
+
+func filterFirewallRules(rules []FirewallRule, filter *FirewallRuleFilter) []FirewallRule {
+	filtered := make([]FirewallRule, 0)
+	for _, rule := range rules {
+		if filter.Direction != "" && !strings.EqualFold(rule.Direction, filter.Direction) {
+			continue
+		}
+		if filter.Protocol != "" && !strings.EqualFold(rule.Protocol, filter.Protocol) {
+			continue
+		}
+		if filter.PortRange != "" && firewallRulePorts(rule.StartPort, rule.EndPort, rule.Ports) != filter.PortRange {
+			continue
+		}
+		if filter.Label != "" && rule.Label != filter.Label {
+			continue
+		}
+		filtered = append(filtered, rule)
+	}
+	return filtered
+}
+
+func paginateFirewallRules(rules []FirewallRule, page, perPage int) *PaginatedFirewallRules {
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		return &PaginatedFirewallRules{Page: 1, PerPage: len(rules), Pages: 1, Items: rules}
+	}
+
+	pages := (len(rules) + perPage - 1) / perPage
+	if pages == 0 {
+		pages = 1
+	}
+
+	start := (page - 1) * perPage
+	if start > len(rules) {
+		start = len(rules)
+	}
+	end := start + perPage
+	if end > len(rules) {
+		end = len(rules)
+	}
+
+	return &PaginatedFirewallRules{Page: page, PerPage: perPage, Pages: pages, Items: rules[start:end]}
+}
+
+// FindFirewallRule finds a firewall rule by its ID, label, port range or CIDR. Exact matches on any
+// of those are preferred over partial matches of the ID or label, and more than one exact match
+// is reported as multiple matches.
 func (c *Client) FindFirewallRule(firewallID string, search string) (*FirewallRule, error) {
 	firewallsRules, err := c.ListFirewallRules(firewallID)
 	if err != nil {
 		return nil, decodeError(err)
 	}
 
-	found := -1
+	exactMatchesCount := 0
+	partialMatchesCount := 0
+	result := FirewallRule{}
 
-	for i, firewallRule := range firewallsRules {
-		if strings.Contains(firewallRule.ID, search) {
-			if found != -1 {
-				err := fmt.Errorf("unable to find %s because there were multiple matches", search)
-				return nil, MultipleMatchesError.wrap(err)
+	for _, value := range firewallsRules {
+		if value.ID == search || value.Label == search || firewallRulePorts(value.StartPort, value.EndPort, value.Ports) == search || findString(value.Cidr, search) {
+			exactMatchesCount++
+			result = value
+		} else if strings.Contains(value.ID, search) || strings.Contains(value.Label, search) {
+			if exactMatchesCount == 0 {
+				result = value
+				partialMatchesCount++
 			}
-			found = i
 		}
 	}
 
-	if found == -1 {
+	if exactMatchesCount == 1 || (exactMatchesCount == 0 && partialMatchesCount == 1) {
+		return &result, nil
+	} else if exactMatchesCount > 1 || partialMatchesCount > 1 {
+		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
+		return nil, MultipleMatchesError.wrap(err)
+	} else {
 		err := fmt.Errorf("unable to find %s, zero matches", search)
 		return nil, ZeroMatchesError.wrap(err)
 	}
+}
+
+// FindOverlappingFirewallRules returns the existing rules of a firewall that overlap the given
+// rule, that is rules for the same protocol and direction whose ports and CIDRs intersect with it.
+// It's optional, but can be called before NewFirewallRule to avoid submitting duplicate rules.
+func (c *Client) FindOverlappingFirewallRules(firewallID string, r *FirewallRuleConfig) ([]FirewallRule, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	rules, err := c.ListFirewallRules(firewallID)
+	if err != nil {
+		return nil, err
+	}
 
-	return &firewallsRules[found], nil
+	return overlappingFirewallRules(rules, r)
+}
+
+func overlappingFirewallRules(rules []FirewallRule, r *FirewallRuleConfig) ([]FirewallRule, error) {
+	ranges := firewallPortRanges(firewallRulePorts(r.StartPort, r.EndPort, r.Ports))
+	overlapping := []FirewallRule{}
+
+	for _, rule := range rules {
+		if !strings.EqualFold(rule.Protocol, r.Protocol) || !strings.EqualFold(rule.Direction, r.Direction) {
+			continue
+		}
+
+		if !portRangesOverlap(ranges, firewallPortRanges(firewallRulePorts(rule.StartPort, rule.EndPort, rule.Ports))) {
+			continue
+		}
+
+		overlap, err := netutil.AnyCIDRsOverlap(rule.Cidr, r.Cidr)
+		if err != nil {
+			return nil, InvalidFirewallRuleError.wrap(err)
+		}
+		if overlap {
+			overlapping = append(overlapping, rule)
+		}
+	}
+
+	return overlapping, nil
+}
+
+// firewallPortRanges parses a port specification such as "22,8000-9000", an empty
+// specification covers all ports
+func firewallPortRanges(ports string) [][2]int {
+	if ports == "" {
+		return [][2]int{{1, 65535}}
+	}
+
+	ranges := [][2]int{}
+	for _, part := range strings.Split(ports, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			continue
+		}
+		end := start
+		if len(bounds) == 2 {
+			if end, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+				continue
+			}
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+
+	return ranges
+}
+
+func portRangesOverlap(a, b [][2]int) bool {
+	for _, rangeA := range a {
+		for _, rangeB := range b {
+			if rangeA[0] <= rangeB[1] && rangeB[0] <= rangeA[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// UpdateFirewallRule updates an existing rule within a firewall in place
+func (c *Client) UpdateFirewallRule(firewallID, ruleID string, r *FirewallRuleConfig) (*FirewallRule, error) {
+	if len(firewallID) == 0 {
+		err := fmt.Errorf("the firewall ID is empty")
+		return nil, IDisEmptyError.wrap(err)
+	}
+
+	if len(ruleID) == 0 {
+		err := fmt.Errorf("the firewall rule ID is empty")
+		return nil, IDisEmptyError.wrap(err)
+	}
+
+	config, err := c.firewallRulePayload(firewallID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/firewalls/%s/rules/%s", firewallID, ruleID), config)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	rule := &FirewallRule{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
 }
 
 // DeleteFirewallRule deletes an firewall
@@ -225,15 +770,15 @@ func (c *Client) DeleteFirewallRule(id string, ruleID string) (*SimpleResponse,
 	return c.DecodeSimpleResponse(resp)
 }
 
+// defaultFirewallRules are the rules a firewall created with CreateRules gets
+var defaultFirewallRules = []FirewallRule{
+	{Protocol: "tcp", Ports: "22", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+	{Protocol: "tcp", Ports: "80", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+	{Protocol: "tcp", Ports: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+}
+
 // IsUsingDefaultRules checks if the firewall is using the default rules
 func (c *Client) IsUsingDefaultRules(firewallID string) (bool, error) {
-	// Define default firewall rules
-	var defaultRules = []FirewallRule{
-		{Protocol: "tcp", Ports: "22", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
-		{Protocol: "tcp", Ports: "80", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
-		{Protocol: "tcp", Ports: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
-	}
-
 	// Retrieve actual firewall rules
 	rules, err := c.ListFirewallRules(firewallID)
 	if err != nil {
@@ -241,7 +786,7 @@ func (c *Client) IsUsingDefaultRules(firewallID string) (bool, error) {
 	}
 
 	// Compare the actual rules with the default rules
-	return areDefaultRules(rules, defaultRules), nil
+	return areDefaultRules(rules, defaultFirewallRules), nil
 }
 
 // Helper function to check if the firewall rules match the default rules
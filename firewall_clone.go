@@ -0,0 +1,99 @@
+package civogo
+
+import (
+	"fmt"
+)
+
+// FirewallCloneConfig describes where CloneFirewall should create the copy of a firewall
+type FirewallCloneConfig struct {
+	// TargetRegion defaults to the client's region
+	TargetRegion string
+	// TargetNetworkID defaults to the source firewall's network when cloning within the
+	// same region, and to the target region's default network otherwise
+	TargetNetworkID string
+	// NewName defaults to the source firewall's name with a "-clone" suffix
+	NewName string
+}
+
+// FirewallRuleCloneFailure is a rule that couldn't be replayed onto the cloned firewall
+type FirewallRuleCloneFailure struct {
+	Rule  FirewallRule
+	Error error
+}
+
+// FirewallCloneReport describes the outcome of CloneFirewall
+type FirewallCloneReport struct {
+	Firewall *FirewallResult
+	Region   string
+	Created  []FirewallRule
+	Failed   []FirewallRuleCloneFailure
+}
+
+// Succeeded returns true if every rule was replayed onto the cloned firewall
+func (r *FirewallCloneReport) Succeeded() bool {
+	return r.Firewall != nil && len(r.Failed) == 0
+}
+
+// CloneFirewall creates a copy of a firewall, optionally in another region or network, and
+// replays all of its rules onto it. A failure to replay a rule doesn't stop the clone, it's
+// recorded in the returned report instead; an error is only returned if the source firewall
+// couldn't be read or the new firewall couldn't be created.
+func (c *Client) CloneFirewall(id string, cfg FirewallCloneConfig) (*FirewallCloneReport, error) {
+	source, err := c.FindFirewall(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := c.ListFirewallRules(source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	target := *c
+	if cfg.TargetRegion != "" {
+		target.Region = cfg.TargetRegion
+	}
+
+	networkID := cfg.TargetNetworkID
+	if networkID == "" && target.Region == c.Region {
+		networkID = source.NetworkID
+	}
+
+	name := cfg.NewName
+	if name == "" {
+		name = fmt.Sprintf("%s-clone", source.Name)
+	}
+
+	createRules := false
+	firewall, err := target.NewFirewall(&FirewallConfig{
+		Name:        name,
+		Region:      target.Region,
+		NetworkID:   networkID,
+		CreateRules: &createRules,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &FirewallCloneReport{Firewall: firewall, Region: target.Region}
+	for _, rule := range rules {
+		created, err := target.NewFirewallRule(&FirewallRuleConfig{
+			FirewallID: firewall.ID,
+			Protocol:   rule.Protocol,
+			StartPort:  rule.StartPort,
+			EndPort:    rule.EndPort,
+			Cidr:       rule.Cidr,
+			Direction:  rule.Direction,
+			Action:     rule.Action,
+			Label:      rule.Label,
+			Ports:      rule.Ports,
+		})
+		if err != nil {
+			report.Failed = append(report.Failed, FirewallRuleCloneFailure{Rule: rule, Error: err})
+			continue
+		}
+		report.Created = append(report.Created, *created)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,44 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCloneFirewall(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/firewalls": {`[{"id": "78901", "name": "web", "network_id": "net-1"}]`},
+		"GET /v2/firewalls/78901/rules": {`[
+			{"id": "1", "firewall_id": "78901", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "ssh"},
+			{"id": "2", "firewall_id": "78901", "protocol": "tcp", "start_port": "80", "end_port": "80", "cidr": ["0.0.0.0/0"], "direction": "inbound", "action": "allow"}
+		]`},
+		"POST /v2/firewalls":             {`{"id": "99999", "name": "web-lon1", "result": "success"}`},
+		"POST /v2/firewalls/99999/rules": {`{"id": "3", "firewall_id": "99999", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "ssh"}`},
+	})
+	defer server.Close()
+
+	got, err := client.CloneFirewall("78901", FirewallCloneConfig{TargetRegion: "LON1", NewName: "web-lon1"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Firewall.ID != "99999" {
+		t.Errorf("Expected %s, got %s", "99999", got.Firewall.ID)
+	}
+	if got.Region != "LON1" {
+		t.Errorf("Expected %s, got %s", "LON1", got.Region)
+	}
+	if client.Region != "TEST" {
+		t.Errorf("Expected the client's region to be unchanged, got %s", client.Region)
+	}
+	if len(got.Created) != 1 || got.Created[0].ID != "3" {
+		t.Errorf("Expected rule %s to be created, got %+v", "3", got.Created)
+	}
+	if len(got.Failed) != 1 || got.Failed[0].Rule.ID != "2" || !errors.Is(got.Failed[0].Error, InvalidFirewallRuleError) {
+		t.Errorf("Expected rule %s to fail, got %+v", "2", got.Failed)
+	}
+	if got.Succeeded() {
+		t.Errorf("Expected the clone to report a failure")
+	}
+}
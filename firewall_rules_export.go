@@ -0,0 +1,141 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// FirewallRuleSetVersion is the current version of the firewall rule set schema
+const FirewallRuleSetVersion = 1
+
+// FirewallRuleFormat is the serialisation format used by ExportFirewallRules
+type FirewallRuleFormat string
+
+const (
+	// FirewallRuleFormatJSON exports the rule set as JSON
+	FirewallRuleFormatJSON FirewallRuleFormat = "json"
+
+	// FirewallRuleFormatYAML exports the rule set as YAML
+	FirewallRuleFormatYAML FirewallRuleFormat = "yaml"
+)
+
+// FirewallRuleSet is the portable representation of a firewall's rules. It contains no IDs,
+// so a rule set exported from one firewall can be imported into any other account or region.
+//
+//	version: 1
+//	rules:
+//	- label: web
+//	  protocol: tcp
+//	  ports: 80,443
+//	  cidr:
+//	  - 0.0.0.0/0
+//	  direction: ingress
+//	  action: allow
+type FirewallRuleSet struct {
+	Version int                `json:"version" yaml:"version"`
+	Rules   []FirewallRuleSpec `json:"rules" yaml:"rules"`
+}
+
+// FirewallRuleSpec is a single rule in a FirewallRuleSet, Ports is either a single port,
+// a range such as "8000-9000" or a comma-separated list of both
+type FirewallRuleSpec struct {
+	Label     string            `json:"label,omitempty" yaml:"label,omitempty"`
+	Protocol  FirewallProtocol  `json:"protocol" yaml:"protocol"`
+	Ports     string            `json:"ports,omitempty" yaml:"ports,omitempty"`
+	Cidr      []string          `json:"cidr" yaml:"cidr"`
+	Direction FirewallDirection `json:"direction" yaml:"direction"`
+	Action    FirewallAction    `json:"action" yaml:"action"`
+}
+
+// ExportFirewallRules returns the rules of a firewall as a FirewallRuleSet in the given format
+func (c *Client) ExportFirewallRules(id string, format FirewallRuleFormat) ([]byte, error) {
+	rules, err := c.ListFirewallRules(id)
+	if err != nil {
+		return nil, err
+	}
+
+	set := FirewallRuleSet{Version: FirewallRuleSetVersion, Rules: []FirewallRuleSpec{}}
+	for _, rule := range rules {
+		set.Rules = append(set.Rules, FirewallRuleSpec{
+			Label:     rule.Label,
+			Protocol:  FirewallProtocol(rule.Protocol),
+			Ports:     firewallRulePorts(rule.StartPort, rule.EndPort, rule.Ports),
+			Cidr:      rule.Cidr,
+			Direction: FirewallDirection(rule.Direction),
+			Action:    FirewallAction(rule.Action),
+		})
+	}
+
+	switch format {
+	case FirewallRuleFormatJSON:
+		return json.MarshalIndent(set, "", "  ")
+	case FirewallRuleFormatYAML:
+		return yaml.Marshal(set)
+	default:
+		err := fmt.Errorf("unknown format %q, must be one of json or yaml", format)
+		return nil, InvalidFirewallRuleError.wrap(err)
+	}
+}
+
+// ParseFirewallRuleSet parses a FirewallRuleSet from JSON or YAML and validates each rule
+func ParseFirewallRuleSet(data []byte) (*FirewallRuleSet, error) {
+	set := &FirewallRuleSet{}
+
+	var err error
+	if strings.HasPrefix(string(bytes.TrimSpace(data)), "{") {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(set)
+	} else {
+		err = yaml.UnmarshalStrict(data, set)
+	}
+	if err != nil {
+		return nil, InvalidFirewallRuleError.wrap(err)
+	}
+
+	if set.Version != FirewallRuleSetVersion {
+		err := fmt.Errorf("unsupported rule set version %d, expected %d", set.Version, FirewallRuleSetVersion)
+		return nil, InvalidFirewallRuleError.wrap(err)
+	}
+
+	for i, spec := range set.Rules {
+		config := spec.config()
+		if err := config.Validate(); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+	}
+
+	return set, nil
+}
+
+// ImportFirewallRules applies a FirewallRuleSet in JSON or YAML to a firewall. Rules that
+// already exist are left untouched, so importing the same rule set twice is safe; existing
+// rules that aren't in the set are kept.
+func (c *Client) ImportFirewallRules(id string, data []byte) (*FirewallRuleChangeset, error) {
+	set, err := ParseFirewallRuleSet(data)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := make([]FirewallRuleConfig, 0, len(set.Rules))
+	for _, spec := range set.Rules {
+		desired = append(desired, spec.config())
+	}
+
+	return c.SyncFirewallRules(id, desired, SyncOptions{})
+}
+
+func (s FirewallRuleSpec) config() FirewallRuleConfig {
+	return FirewallRuleConfig{
+		Label:     s.Label,
+		Protocol:  string(s.Protocol),
+		Ports:     s.Ports,
+		Cidr:      s.Cidr,
+		Direction: string(s.Direction),
+		Action:    string(s.Action),
+	}
+}
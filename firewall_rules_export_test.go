@@ -0,0 +1,97 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestExportFirewallRules(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/firewalls/78901/rules": {`[
+			{"id": "1", "firewall_id": "78901", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "ssh"},
+			{"id": "2", "firewall_id": "78901", "protocol": "tcp", "start_port": "8000", "end_port": "9000", "cidr": ["10.0.0.0/8"], "direction": "ingress", "action": "allow"}
+		]`},
+	})
+	defer server.Close()
+
+	got, err := client.ExportFirewallRules("78901", FirewallRuleFormatYAML)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := `version: 1
+rules:
+- label: ssh
+  protocol: tcp
+  ports: "22"
+  cidr:
+  - 0.0.0.0/0
+  direction: ingress
+  action: allow
+- protocol: tcp
+  ports: 8000-9000
+  cidr:
+  - 10.0.0.0/8
+  direction: ingress
+  action: allow
+`
+	if string(got) != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+
+	set, err := ParseFirewallRuleSet(got)
+	if err != nil {
+		t.Errorf("Expected the export to parse, got %s", err)
+		return
+	}
+	if len(set.Rules) != 2 || set.Rules[1].Ports != "8000-9000" {
+		t.Errorf("Expected the export to round trip, got %+v", set.Rules)
+	}
+}
+
+func TestImportFirewallRules(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/firewalls/78901/rules": {`[
+			{"id": "1", "firewall_id": "78901", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "ssh"}
+		]`},
+		"POST /v2/firewalls/78901/rules": {`{"id": "2", "firewall_id": "78901", "protocol": "tcp", "start_port": "443", "end_port": "443", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "ports": "443"}`},
+	})
+	defer server.Close()
+
+	data := []byte(`{
+		"version": 1,
+		"rules": [
+			{"label": "ssh", "protocol": "tcp", "ports": "22", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow"},
+			{"protocol": "tcp", "ports": "443", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow"}
+		]
+	}`)
+
+	got, err := client.ImportFirewallRules("78901", data)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got.Unchanged) != 1 || got.Unchanged[0].ID != "1" {
+		t.Errorf("Expected rule %s to be unchanged, got %+v", "1", got.Unchanged)
+	}
+	if len(got.Created) != 1 || got.Created[0].ID != "2" {
+		t.Errorf("Expected rule %s to be created, got %+v", "2", got.Created)
+	}
+}
+
+func TestParseFirewallRuleSetRejectsInvalidRules(t *testing.T) {
+	invalid := []string{
+		`{"version": 2, "rules": []}`,
+		`{"version": 1, "rules": [{"protocol": "icmp", "ports": "22"}]}`,
+		"version: 1\nrules:\n- protocol: tcp\n  ports: \"22\"\n  direction: inbound\n",
+		"version: 1\nrulez: []\n",
+	}
+
+	for _, data := range invalid {
+		if _, err := ParseFirewallRuleSet([]byte(data)); !errors.Is(err, InvalidFirewallRuleError) {
+			t.Errorf("Expected InvalidFirewallRuleError for %s, got %v", data, err)
+		}
+	}
+}
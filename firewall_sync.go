@@ -0,0 +1,180 @@
+package civogo
+
+import (
+	"sort"
+	"strings"
+)
+
+// SyncOptions controls how SyncFirewallRules reconciles a firewall's rules
+type SyncOptions struct {
+	// DeleteExtra removes rules that exist on the firewall but aren't in the desired set
+	DeleteExtra bool
+	// DryRun computes the changeset without making any changes
+	DryRun bool
+}
+
+// FirewallRuleChangeset describes the changes made (or that would be made) by SyncFirewallRules
+type FirewallRuleChangeset struct {
+	Created   []FirewallRule
+	Updated   []FirewallRule
+	Deleted   []FirewallRule
+	Unchanged []FirewallRule
+}
+
+// HasChanges returns true if the changeset creates, updates or deletes any rule
+func (cs *FirewallRuleChangeset) HasChanges() bool {
+	return len(cs.Created) > 0 || len(cs.Updated) > 0 || len(cs.Deleted) > 0
+}
+
+// SyncFirewallRules makes the rules of a firewall match the desired set. Desired rules with a
+// label are matched to the existing rule with the same label and updated in place if they
+// differ, rules without a label are matched on their protocol, ports, CIDRs, direction and action.
+// Rules are updated and created before any extra rule is deleted, and the changeset is returned
+// even if one of the calls fails, so callers can see what was applied before the error.
+func (c *Client) SyncFirewallRules(firewallID string, desired []FirewallRuleConfig, opts SyncOptions) (*FirewallRuleChangeset, error) {
+	actual, err := c.ListFirewallRules(firewallID)
+	if err != nil {
+		return nil, err
+	}
+
+	changeset := &FirewallRuleChangeset{}
+	matched := make([]bool, len(actual))
+
+	type update struct {
+		ruleID string
+		config FirewallRuleConfig
+	}
+	var updates []update
+	var creates []FirewallRuleConfig
+
+	for _, rule := range desired {
+		index := matchFirewallRule(actual, matched, rule)
+		if index == -1 {
+			creates = append(creates, rule)
+			continue
+		}
+
+		matched[index] = true
+		if sameFirewallRule(actual[index], rule) {
+			changeset.Unchanged = append(changeset.Unchanged, actual[index])
+		} else {
+			updates = append(updates, update{ruleID: actual[index].ID, config: rule})
+		}
+	}
+
+	for _, u := range updates {
+		if opts.DryRun {
+			changeset.Updated = append(changeset.Updated, firewallRuleFromConfig(firewallID, u.ruleID, u.config))
+			continue
+		}
+
+		config := u.config
+		rule, err := c.UpdateFirewallRule(firewallID, u.ruleID, &config)
+		if err != nil {
+			return changeset, err
+		}
+		changeset.Updated = append(changeset.Updated, *rule)
+	}
+
+	for _, config := range creates {
+		config.FirewallID = firewallID
+		if opts.DryRun {
+			changeset.Created = append(changeset.Created, firewallRuleFromConfig(firewallID, "", config))
+			continue
+		}
+
+		rule, err := c.NewFirewallRule(&config)
+		if err != nil {
+			return changeset, err
+		}
+		changeset.Created = append(changeset.Created, *rule)
+	}
+
+	if opts.DeleteExtra {
+		for i, rule := range actual {
+			if matched[i] {
+				continue
+			}
+
+			if !opts.DryRun {
+				if _, err := c.DeleteFirewallRule(firewallID, rule.ID); err != nil {
+					return changeset, err
+				}
+			}
+			changeset.Deleted = append(changeset.Deleted, rule)
+		}
+	}
+
+	return changeset, nil
+}
+
+// matchFirewallRule returns the index of the existing rule a desired rule corresponds to, or -1
+func matchFirewallRule(actual []FirewallRule, matched []bool, desired FirewallRuleConfig) int {
+	for i, rule := range actual {
+		if matched[i] {
+			continue
+		}
+		if desired.Label != "" && rule.Label == desired.Label {
+			return i
+		}
+	}
+
+	for i, rule := range actual {
+		if matched[i] {
+			continue
+		}
+		if desired.Label == "" && sameFirewallRule(rule, desired) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func sameFirewallRule(rule FirewallRule, config FirewallRuleConfig) bool {
+	return strings.EqualFold(rule.Protocol, config.Protocol) &&
+		strings.EqualFold(rule.Direction, config.Direction) &&
+		strings.EqualFold(rule.Action, config.Action) &&
+		rule.Label == config.Label &&
+		firewallRulePorts(rule.StartPort, rule.EndPort, rule.Ports) == firewallRulePorts(config.StartPort, config.EndPort, config.Ports) &&
+		sameCidrs(rule.Cidr, config.Cidr)
+}
+
+// firewallRulePorts returns the canonical port range of a rule, e.g. "80" or "8000-9000"
+func firewallRulePorts(startPort, endPort, ports string) string {
+	if ports != "" {
+		return ports
+	}
+	if endPort == "" || endPort == startPort {
+		return startPort
+	}
+	return startPort + "-" + endPort
+}
+
+func sameCidrs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortedA := append([]string{}, a...)
+	sortedB := append([]string{}, b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+
+	return equalStrings(sortedA, sortedB)
+}
+
+func firewallRuleFromConfig(firewallID, ruleID string, config FirewallRuleConfig) FirewallRule {
+	return FirewallRule{
+		ID:         ruleID,
+		FirewallID: firewallID,
+		Protocol:   config.Protocol,
+		StartPort:  config.StartPort,
+		EndPort:    config.EndPort,
+		Cidr:       config.Cidr,
+		Direction:  config.Direction,
+		Action:     config.Action,
+		Label:      config.Label,
+		Ports:      config.Ports,
+	}
+}
@@ -0,0 +1,73 @@
+package civogo
+
+import (
+	"testing"
+)
+
+func TestSyncFirewallRules(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/firewalls/78901/rules": {`[
+			{"id": "1", "firewall_id": "78901", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "ssh", "ports": "22"},
+			{"id": "2", "firewall_id": "78901", "protocol": "tcp", "start_port": "80", "end_port": "80", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "ports": "80"},
+			{"id": "3", "firewall_id": "78901", "protocol": "tcp", "start_port": "8080", "end_port": "8080", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "ports": "8080"}
+		]`},
+		"PUT /v2/firewalls/78901/rules/1":    {`{"id": "1", "firewall_id": "78901", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["10.0.0.0/8"], "direction": "ingress", "action": "allow", "label": "ssh", "ports": "22"}`},
+		"POST /v2/firewalls/78901/rules":     {`{"id": "4", "firewall_id": "78901", "protocol": "tcp", "start_port": "443", "end_port": "443", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "ports": "443"}`},
+		"DELETE /v2/firewalls/78901/rules/3": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	desired := []FirewallRuleConfig{
+		{Protocol: "tcp", Ports: "22", Cidr: []string{"10.0.0.0/8"}, Direction: "ingress", Action: "allow", Label: "ssh"},
+		{Protocol: "tcp", StartPort: "80", EndPort: "80", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+		{Protocol: "tcp", Ports: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+	}
+
+	got, err := client.SyncFirewallRules("78901", desired, SyncOptions{DeleteExtra: true})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got.Updated) != 1 || got.Updated[0].ID != "1" {
+		t.Errorf("Expected rule %s to be updated, got %+v", "1", got.Updated)
+	}
+	if len(got.Unchanged) != 1 || got.Unchanged[0].ID != "2" {
+		t.Errorf("Expected rule %s to be unchanged, got %+v", "2", got.Unchanged)
+	}
+	if len(got.Created) != 1 || got.Created[0].ID != "4" {
+		t.Errorf("Expected rule %s to be created, got %+v", "4", got.Created)
+	}
+	if len(got.Deleted) != 1 || got.Deleted[0].ID != "3" {
+		t.Errorf("Expected rule %s to be deleted, got %+v", "3", got.Deleted)
+	}
+}
+
+func TestSyncFirewallRulesDryRun(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/firewalls/78901/rules": {`[
+			{"id": "3", "firewall_id": "78901", "protocol": "tcp", "start_port": "8080", "end_port": "8080", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "ports": "8080"}
+		]`},
+	})
+	defer server.Close()
+
+	desired := []FirewallRuleConfig{
+		{Protocol: "tcp", Ports: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+	}
+
+	got, err := client.SyncFirewallRules("78901", desired, SyncOptions{DeleteExtra: true, DryRun: true})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if !got.HasChanges() {
+		t.Errorf("Expected the changeset to have changes")
+	}
+	if len(got.Created) != 1 || got.Created[0].Ports != "443" {
+		t.Errorf("Expected a rule for port %s to be created, got %+v", "443", got.Created)
+	}
+	if len(got.Deleted) != 1 || got.Deleted[0].ID != "3" {
+		t.Errorf("Expected rule %s to be deleted, got %+v", "3", got.Deleted)
+	}
+}
@@ -1,6 +1,8 @@
 package civogo
 
 import (
+	"encoding/json"
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -59,6 +61,32 @@ func TestFindFirewall(t *testing.T) {
 	}
 }
 
+func TestFindFirewallPrefersExactMatch(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/firewalls": `[{"id": "12345", "name": "web-instance"}, {"id": "67789", "name": "web"}, {"id": "99999", "name": "web-node"}]`,
+	})
+	defer server.Close()
+
+	got, err := client.FindFirewall("web")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "67789" {
+		t.Errorf("Expected %s, got %s", "67789", got.ID)
+	}
+
+	_, err = client.FindFirewall("web-")
+	if !errors.Is(err, MultipleMatchesError) {
+		t.Errorf("Expected %s, got %s", MultipleMatchesError, err)
+	}
+
+	_, err = client.FindFirewall("missing")
+	if !errors.Is(err, ZeroMatchesError) {
+		t.Errorf("Expected %s, got %s", ZeroMatchesError, err)
+	}
+}
+
 func TestNewFirewall(t *testing.T) {
 	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
 		{
@@ -106,6 +134,42 @@ func TestNewFirewall(t *testing.T) {
 	}
 }
 
+func TestNewFirewallWithoutDefaultRules(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"fw-mail","region":"TEST","network_id":"1234-5698-9874-98","create_rules":false}`,
+					URL:          "/v2/firewalls",
+					ResponseBody: `{"id": "76cc107f-fbef-4e2b-b97f-f5d34f4075d3","name": "fw-mail","result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	createRules := false
+	config := &FirewallConfig{
+		Name:        "fw-mail",
+		NetworkID:   "1234-5698-9874-98",
+		CreateRules: &createRules,
+	}
+	got, err := client.NewFirewall(config)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if config.Region != "" {
+		t.Errorf("Expected the caller's config to be left unchanged, got region %q", config.Region)
+	}
+
+	if got.ID != "76cc107f-fbef-4e2b-b97f-f5d34f4075d3" {
+		t.Errorf("Expected %s, got %s", "76cc107f-fbef-4e2b-b97f-f5d34f4075d3", got.ID)
+	}
+}
+
 func TestNewFirewallWithRules(t *testing.T) {
 	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
 		{
@@ -159,6 +223,48 @@ func TestNewFirewallWithRules(t *testing.T) {
 	}
 }
 
+func TestEnsureFirewallExisting(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/firewalls": `[{"id": "12345", "name": "web", "network_id": "net-1"}, {"id": "67890", "name": "web", "network_id": "net-2"}]`,
+	})
+	defer server.Close()
+
+	got, err := client.EnsureFirewall(&FirewallConfig{Name: "web", NetworkID: "net-2"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "67890" {
+		t.Errorf("Expected %s, got %s", "67890", got.ID)
+	}
+}
+
+func TestEnsureFirewallCreate(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/firewalls":  {`[{"id": "67890", "name": "web-old", "network_id": "net-1"}]`},
+		"POST /v2/firewalls": {`{"id": "12345", "name": "web", "result": "success"}`},
+		"GET /v2/firewalls/12345": {`{"id": "12345", "name": "web", "network_id": "net-1", "rules_count": 2, "rules": [
+			{"id": "r-1", "protocol": "tcp", "start_port": "22", "end_port": "22", "direction": "ingress", "cidr": ["0.0.0.0/0"]},
+			{"id": "r-2", "protocol": "tcp", "start_port": "1", "end_port": "65535", "direction": "egress", "cidr": ["0.0.0.0/0"]}
+		]}`},
+	})
+	defer server.Close()
+
+	config := &FirewallConfig{Name: "web", NetworkID: "net-1"}
+	got, err := client.EnsureFirewall(config)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.ID != "12345" || got.NetworkID != "net-1" || len(got.Rules) != 2 {
+		t.Errorf("Expected the created firewall with its default rules, got %+v", got)
+	}
+	if config.Region != "" {
+		t.Errorf("Expected the config to be left unchanged, got region %q", config.Region)
+	}
+}
+
 func TestRenameFirewall(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/firewalls/12346": `{"result": "success"}`,
@@ -179,6 +285,57 @@ func TestRenameFirewall(t *testing.T) {
 	}
 }
 
+func TestUpdateFirewall(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"new_name","region":"TEST","network_id":"net-2"}`,
+					URL:          "/v2/firewalls/12346",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.UpdateFirewall("12346", &FirewallConfig{Name: "new_name", NetworkID: "net-2"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &SimpleResponse{Result: "success"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestUpdateFirewallNameOnly(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"new_name","region":"TEST"}`,
+					URL:          "/v2/firewalls/12346",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	config := &FirewallConfig{Name: "new_name"}
+	got, err := client.UpdateFirewall("12346", config)
+	EnsureSuccessfulSimpleResponse(t, got, err)
+
+	if config.Region != "" {
+		t.Errorf("Expected the config to be left unchanged, got region %q", config.Region)
+	}
+}
+
 func TestDeleteFirewall(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/firewalls/12346": `{"result": "success"}`,
@@ -215,7 +372,7 @@ func TestNewFirewallRule(t *testing.T) {
 	})
 	defer server.Close()
 
-	cfg := &FirewallRuleConfig{FirewallID: "78901", Protocol: "tcp", StartPort: "443", EndPort: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "inbound", Label: "", Action: "allow"}
+	cfg := &FirewallRuleConfig{FirewallID: "78901", Protocol: "tcp", StartPort: "443", EndPort: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Label: "", Action: "allow"}
 	got, err := client.NewFirewallRule(cfg)
 	if err != nil {
 		t.Errorf("Request returned an error: %s", err)
@@ -267,6 +424,57 @@ func TestNewFirewallRule(t *testing.T) {
 	}
 }
 
+func TestUpdateFirewallRule(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"firewall_id":"78901","region":"TEST","protocol":"tcp","start_port":"","end_port":"","cidr":["10.0.0.0/8"],"direction":"ingress","action":"allow","label":"https","ports":"443"}`,
+					URL:          "/v2/firewalls/78901/rules/123456",
+					ResponseBody: `{"id": "123456", "firewall_id": "78901", "protocol": "tcp", "start_port": "443", "end_port": "443", "cidr": ["10.0.0.0/8"], "direction": "ingress", "action": "allow", "label": "https", "ports": "443"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	cfg := &FirewallRuleConfig{Protocol: "tcp", Ports: "443", Cidr: []string{"10.0.0.0/8"}, Direction: "ingress", Action: "allow", Label: "https"}
+	got, err := client.UpdateFirewallRule("78901", "123456", cfg)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if cfg.FirewallID != "" || cfg.Region != "" {
+		t.Errorf("Expected the config to be left unchanged, got %+v", cfg)
+	}
+
+	expected := &FirewallRule{
+		ID:         "123456",
+		FirewallID: "78901",
+		Protocol:   "tcp",
+		StartPort:  "443",
+		EndPort:    "443",
+		Cidr:       []string{"10.0.0.0/8"},
+		Direction:  "ingress",
+		Action:     "allow",
+		Label:      "https",
+		Ports:      "443",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestUpdateFirewallRuleEmptyID(t *testing.T) {
+	client, _ := NewClient("TEST-API-KEY", "TEST")
+
+	cfg := &FirewallRuleConfig{Protocol: "tcp", Ports: "443", Cidr: []string{"10.0.0.0/8"}, Direction: "ingress", Action: "allow"}
+	if _, err := client.UpdateFirewallRule("78901", "", cfg); !errors.Is(err, IDisEmptyError) {
+		t.Errorf("Expected IDisEmptyError, got %v", err)
+	}
+}
+
 func TestFindFirewallRule(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/firewalls/22/rules": `[{
@@ -320,6 +528,45 @@ func TestFindFirewallRule(t *testing.T) {
 	}
 }
 
+func TestFindFirewallRuleByLabelPortOrCidr(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/firewalls/22/rules": `[
+			{"id": "21", "firewall_id": "22", "protocol": "tcp", "start_port": "443", "end_port": "443", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "https"},
+			{"id": "23", "firewall_id": "22", "protocol": "tcp", "start_port": "8000", "end_port": "9000", "cidr": ["10.0.0.0/8"], "direction": "ingress", "action": "allow", "label": "https-internal"}
+		]`,
+	})
+	defer server.Close()
+
+	got, err := client.FindFirewallRule("22", "https")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "21" {
+		t.Errorf("Expected %s, got %s", "21", got.ID)
+	}
+
+	got, _ = client.FindFirewallRule("22", "8000-9000")
+	if got == nil || got.ID != "23" {
+		t.Errorf("Expected %s, got %+v", "23", got)
+	}
+
+	got, _ = client.FindFirewallRule("22", "10.0.0.0/8")
+	if got == nil || got.ID != "23" {
+		t.Errorf("Expected %s, got %+v", "23", got)
+	}
+
+	got, _ = client.FindFirewallRule("22", "internal")
+	if got == nil || got.ID != "23" {
+		t.Errorf("Expected %s, got %+v", "23", got)
+	}
+
+	_, err = client.FindFirewallRule("22", "http")
+	if !errors.Is(err, MultipleMatchesError) {
+		t.Errorf("Expected %s, got %s", MultipleMatchesError, err)
+	}
+}
+
 func TestListFirewallRules(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/firewalls/22/rules": `[{
@@ -379,3 +626,252 @@ func TestDeleteFirewallRule(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestListFirewallRulesWithFilter(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/firewalls/78901/rules": `[
+			{"id": "1", "firewall_id": "78901", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "ssh"},
+			{"id": "2", "firewall_id": "78901", "protocol": "tcp", "start_port": "80", "end_port": "80", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "http"},
+			{"id": "3", "firewall_id": "78901", "protocol": "tcp", "start_port": "443", "end_port": "443", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "https"},
+			{"id": "4", "firewall_id": "78901", "protocol": "udp", "start_port": "53", "end_port": "53", "cidr": ["0.0.0.0/0"], "direction": "egress", "action": "allow", "label": "dns"}
+		]`,
+	})
+	defer server.Close()
+
+	got, err := client.ListFirewallRulesWithFilter("78901", &FirewallRuleFilter{Direction: "ingress", Protocol: "tcp", Page: 2, PerPage: 2})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Pages != 2 {
+		t.Errorf("Expected %d, got %d", 2, got.Pages)
+	}
+	if len(got.Items) != 1 || got.Items[0].ID != "3" {
+		t.Errorf("Expected only rule %s, got %+v", "3", got.Items)
+	}
+
+	got, err = client.ListFirewallRulesWithFilter("78901", &FirewallRuleFilter{PortRange: "53"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if len(got.Items) != 1 || got.Items[0].ID != "4" {
+		t.Errorf("Expected only rule %s, got %+v", "4", got.Items)
+	}
+}
+
+func TestListFirewallRulesWithFilterPaginated(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/firewalls/78901/rules": `{"page": 1, "per_page": 1, "pages": 4, "items": [{"id": "1", "firewall_id": "78901", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "ssh"}]}`,
+	})
+	defer server.Close()
+
+	got, err := client.ListFirewallRulesWithFilter("78901", &FirewallRuleFilter{Page: 1, PerPage: 1})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Pages != 4 || len(got.Items) != 1 {
+		t.Errorf("Expected the API page to be returned as is, got %+v", got)
+	}
+}
+
+func TestListFirewallAttachments(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances": {`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "i-1", "hostname": "web-1", "firewall_id": "fw-1"}, {"id": "i-2", "hostname": "web-2", "firewall_id": "fw-2"}]}`},
+		"GET /v2/kubernetes/clusters": {
+			`{"page": 1, "per_page": 100, "pages": 2, "items": [{"id": "k-1", "name": "cluster-1", "firewall_id": "fw-2"}]}`,
+			`{"page": 2, "per_page": 100, "pages": 2, "items": [{"id": "k-2", "name": "cluster-2", "firewall_id": "fw-1"}]}`,
+		},
+		"GET /v2/loadbalancers": {`[{"id": "lb-1", "name": "lb-1", "firewall_id": "fw-2"}]`},
+	})
+	defer server.Close()
+
+	got, err := client.ListFirewallAttachments("fw-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if !got.InUse() {
+		t.Errorf("Expected the firewall to be in use")
+	}
+	if len(got.Instances) != 1 || got.Instances[0].ID != "i-1" {
+		t.Errorf("Expected instance %s, got %+v", "i-1", got.Instances)
+	}
+	if len(got.KubernetesClusters) != 1 || got.KubernetesClusters[0].ID != "k-2" {
+		t.Errorf("Expected cluster %s, got %+v", "k-2", got.KubernetesClusters)
+	}
+	if len(got.LoadBalancers) != 0 {
+		t.Errorf("Expected no load balancers, got %+v", got.LoadBalancers)
+	}
+}
+
+func TestFirewallRuleConfigValidate(t *testing.T) {
+	valid := []FirewallRuleConfig{
+		{Protocol: "tcp", StartPort: "443", Direction: "ingress", Action: "allow"},
+		{Protocol: "UDP", StartPort: "8000", EndPort: "9000", Direction: "egress", Action: "deny"},
+		{Protocol: "tcp", Ports: "22,80,8000-9000"},
+		{Protocol: "icmp", Direction: "ingress"},
+		{},
+	}
+	for _, cfg := range valid {
+		if err := cfg.Validate(); err != nil {
+			t.Errorf("Expected %+v to be valid, got %s", cfg, err)
+		}
+	}
+
+	invalid := []FirewallRuleConfig{
+		{Protocol: "sctp", StartPort: "443"},
+		{Protocol: "tcp", StartPort: "443", Direction: "inbound"},
+		{Protocol: "tcp", StartPort: "443", Action: "reject"},
+		{Protocol: "icmp", StartPort: "443"},
+		{Protocol: "tcp", StartPort: "0"},
+		{Protocol: "tcp", StartPort: "70000"},
+		{Protocol: "tcp", StartPort: "9000", EndPort: "8000"},
+		{Protocol: "tcp", EndPort: "8000"},
+		{Protocol: "tcp", Ports: "80,http"},
+	}
+	for _, cfg := range invalid {
+		if err := cfg.Validate(); !errors.Is(err, InvalidFirewallRuleError) {
+			t.Errorf("Expected %+v to return InvalidFirewallRuleError, got %v", cfg, err)
+		}
+	}
+}
+
+func TestNewFirewallRuleValidatesBeforeRequest(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{})
+	defer server.Close()
+
+	cfg := &FirewallRuleConfig{FirewallID: "78901", Protocol: "icmp", StartPort: "443"}
+	_, err := client.NewFirewallRule(cfg)
+	if !errors.Is(err, InvalidFirewallRuleError) {
+		t.Errorf("Expected InvalidFirewallRuleError, got %v", err)
+	}
+	if client.LastJSONResponse != "" {
+		t.Errorf("Expected no request to be sent, got %s", client.LastJSONResponse)
+	}
+}
+
+func TestGetFirewall(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/firewalls/12345": `{
+			"id": "12345",
+			"name": "web",
+			"rules_count": 1,
+			"instance_count": 2,
+			"cluster_count": 0,
+			"loadbalancer_count": 0,
+			"network_id": "net-1",
+			"rules": [
+				{"id": "1", "firewall_id": "12345", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "ssh"}
+			]
+		}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetFirewall("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.InstanceCount != 2 {
+		t.Errorf("Expected %d, got %d", 2, got.InstanceCount)
+	}
+	if len(got.Rules) != 1 || got.Rules[0].Label != "ssh" {
+		t.Errorf("Expected the rules to be returned inline, got %+v", got.Rules)
+	}
+}
+
+func TestFirewallUnmarshalStringCounts(t *testing.T) {
+	got := Firewall{}
+	err := json.Unmarshal([]byte(`{"id": "12345", "name": "web", "rules_count": "3", "instance_count": "2", "cluster_count": null, "loadbalancer_count": 1}`), &got)
+	if err != nil {
+		t.Errorf("Unmarshal returned an error: %s", err)
+		return
+	}
+
+	if got.ID != "12345" || got.Name != "web" {
+		t.Errorf("Expected %s, got %s", "12345 web", got.ID+" "+got.Name)
+	}
+	if got.RulesCount != 3 || got.InstanceCount != 2 || got.ClusterCount != 0 || got.LoadBalancerCount != 1 {
+		t.Errorf("Expected counts 3, 2, 0, 1, got %+v", got)
+	}
+
+	for _, malformed := range []string{`"many"`, `"\"3\""`, `"3.0"`, `3.5`, `true`, `[3]`} {
+		if err := json.Unmarshal([]byte(`{"rules_count": `+malformed+`}`), &got); err == nil {
+			t.Errorf("Expected an error for the count %s", malformed)
+		}
+	}
+}
+
+func TestGetFirewallDecodeError(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/firewalls/12345": `{"id": "12345", "rules_count": "many"}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetFirewall("12345")
+	if err == nil || got != nil {
+		t.Errorf("Expected only an error, got %+v and %v", got, err)
+	}
+}
+
+func TestNewFirewallRuleNormalizesCidrs(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"firewall_id":"78901","region":"TEST","protocol":"tcp","start_port":"22","end_port":"22","cidr":["10.0.0.1/32","192.168.0.0/16"],"direction":"ingress","action":"allow"}`,
+					URL:          "/v2/firewalls/78901/rules",
+					ResponseBody: `{"id": "123456", "firewall_id": "78901", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["10.0.0.1/32", "192.168.0.0/16"], "direction": "ingress", "action": "allow"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	cfg := &FirewallRuleConfig{FirewallID: "78901", Protocol: "tcp", StartPort: "22", EndPort: "22", Cidr: []string{"10.0.0.1", "192.168.1.0/16", "10.0.0.1/32"}, Direction: "ingress", Action: "allow"}
+	got, err := client.NewFirewallRule(cfg)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.ID != "123456" {
+		t.Errorf("Expected %s, got %s", "123456", got.ID)
+	}
+	if !reflect.DeepEqual(cfg.Cidr, []string{"10.0.0.1", "192.168.1.0/16", "10.0.0.1/32"}) || cfg.Region != "" {
+		t.Errorf("Expected the config to be left unchanged, got %+v", cfg)
+	}
+
+	cfg = &FirewallRuleConfig{FirewallID: "78901", Protocol: "tcp", StartPort: "22", Cidr: []string{"10.0.0.300"}}
+	if _, err := client.NewFirewallRule(cfg); !errors.Is(err, InvalidFirewallRuleError) {
+		t.Errorf("Expected InvalidFirewallRuleError, got %v", err)
+	}
+}
+
+func TestFindOverlappingFirewallRules(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/firewalls/78901/rules": {`[
+			{"id": "1", "firewall_id": "78901", "protocol": "tcp", "start_port": "8000", "end_port": "9000", "cidr": ["10.0.0.0/8"], "direction": "ingress", "action": "allow"},
+			{"id": "2", "firewall_id": "78901", "protocol": "tcp", "start_port": "22", "end_port": "22", "cidr": ["10.0.0.0/8"], "direction": "ingress", "action": "allow"},
+			{"id": "3", "firewall_id": "78901", "protocol": "udp", "start_port": "8080", "end_port": "8080", "cidr": ["10.0.0.0/8"], "direction": "ingress", "action": "allow"},
+			{"id": "4", "firewall_id": "78901", "protocol": "tcp", "start_port": "8080", "end_port": "8080", "cidr": ["192.168.0.0/16"], "direction": "ingress", "action": "allow"}
+		]`},
+	})
+	defer server.Close()
+
+	cfg := &FirewallRuleConfig{Protocol: "tcp", Ports: "80,8080", Cidr: []string{"10.1.2.3"}, Direction: "ingress", Action: "allow"}
+	got, err := client.FindOverlappingFirewallRules("78901", cfg)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got) != 1 || got[0].ID != "1" {
+		t.Errorf("Expected rule %s to overlap, got %+v", "1", got)
+	}
+}
@@ -1,6 +1,9 @@
 package civogo
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 )
 
@@ -15,3 +18,36 @@ func EnsureSuccessfulSimpleResponse(t *testing.T, got *SimpleResponse, err error
 		t.Errorf("Expected %s, got %s", "success", got.Result)
 	}
 }
+
+// NewRoutedClientForTesting initializes a Client connecting to a local test server which
+// matches requests on both method and exact path (e.g. "GET /v2/volumes/12345"). Each route
+// serves its responses in order, repeating the last one once they are exhausted, so that
+// polling helpers can be tested against changing state.
+func NewRoutedClientForTesting(routes map[string][]string) (*Client, *httptest.Server, error) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		key := req.Method + " " + req.URL.Path
+		responses, ok := routes[key]
+		if !ok || len(responses) == 0 {
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"code": "not_found", "reason": "failed to find a matching request"}`))
+			return
+		}
+
+		i := calls[key]
+		if i >= len(responses) {
+			i = len(responses) - 1
+		}
+		calls[key]++
+		rw.Write([]byte(responses[i]))
+	}))
+
+	client, err := NewClientForTestingWithServer(server)
+
+	return client, server, err
+}
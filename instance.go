@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/civo/civogo/utils"
+	"github.com/google/go-querystring/query"
 )
 
 // Instance represents a virtual server within Civo's infrastructure
@@ -63,14 +64,18 @@ type Instance struct {
 // InstanceConsole represents a link to a webconsole for an instances
 type InstanceConsole struct {
 	URL string `json:"url"`
+	// ExpiresAt is when the console URL stops working, as reported by the API, it's empty if
+	// the API doesn't report it
+	ExpiresAt string `json:"expires_at,omitempty"`
 }
 
 // InstanceVnc represents VNC information for an instances
 type InstanceVnc struct {
-	URI    string `json:"uri"`
-	Result string `json:"result"`
-	Name   string `json:"name"`
-	Label  string `json:"label"`
+	URI        string `json:"uri"`
+	Result     string `json:"result"`
+	Name       string `json:"name"`
+	Label      string `json:"label"`
+	Expiration string `json:"expiration,omitempty"`
 }
 
 // PaginatedInstanceList returns a paginated list of Instance object
@@ -114,6 +119,31 @@ type InstanceConfig struct {
 	VolumeType       string           `json:"volume_type,omitempty"`
 	AttachedVolumes  []AttachedVolume `json:"attached_volumes"`
 	PlacementRule    PlacementRule    `json:"placement_rule"`
+	// DiskImageID is the ID of a disk image to boot from, including user-uploaded images,
+	// it's checked to exist in the target region and takes precedence over TemplateID
+	DiskImageID string `json:"-"`
+}
+
+// InstanceFromVolumeConfig describes the parameters for a new instance
+// booted from an existing bootable volume
+type InstanceFromVolumeConfig struct {
+	Hostname         string
+	ReverseDNS       string
+	Size             string
+	PublicIPRequired string
+	ReservedIPv4     string
+	NetworkID        string
+	InitialUser      string
+	SSHKeyID         string
+	Script           string
+	Tags             []string
+	FirewallID       string
+}
+
+// InstancePassword is the login credential of an instance
+type InstancePassword struct {
+	Username string `json:"user"`
+	Password string `json:"password"`
 }
 
 // AffinityRule represents a affinity rule
@@ -146,6 +176,77 @@ func (c *Client) ListInstances(page int, perPage int) (*PaginatedInstanceList, e
 	return &PaginatedInstances, err
 }
 
+// InstanceFilter narrows the instances returned by ListInstancesWithFilter, empty fields match everything
+type InstanceFilter struct {
+	Status    string `url:"status,omitempty"`
+	NetworkID string `url:"network_id,omitempty"`
+	// Tags matches instances that have all of the given tags
+	Tags       []string `url:"tags,comma,omitempty"`
+	SizePrefix string   `url:"size_prefix,omitempty"`
+	// Region lists the instances of another region than the client's
+	Region  string `url:"-"`
+	Page    int    `url:"page,omitempty"`
+	PerPage int    `url:"per_page,omitempty"`
+}
+
+// ListInstancesWithFilter returns a page of Instances matching the filter, the filter is passed to
+// the API as query parameters and also applied to the returned page in case the API ignores any of them
+func (c *Client) ListInstancesWithFilter(filter *InstanceFilter) (*PaginatedInstanceList, error) {
+	if filter == nil {
+		filter = &InstanceFilter{}
+	}
+
+	vals, err := query.Values(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	client := c
+	if filter.Region != "" && filter.Region != c.Region {
+		regional := *c
+		regional.Region = filter.Region
+		client = &regional
+	}
+
+	resp, err := client.SendGetRequest(fmt.Sprintf("/v2/instances?%s", vals.Encode()))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	instances := &PaginatedInstanceList{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(instances); err != nil {
+		return nil, err
+	}
+
+	filtered := make([]Instance, 0, len(instances.Items))
+	for _, instance := range instances.Items {
+		if filter.matches(instance) {
+			filtered = append(filtered, instance)
+		}
+	}
+	instances.Items = filtered
+
+	return instances, nil
+}
+
+func (filter *InstanceFilter) matches(instance Instance) bool {
+	if filter.Status != "" && !strings.EqualFold(instance.Status, filter.Status) {
+		return false
+	}
+	if filter.NetworkID != "" && instance.NetworkID != filter.NetworkID {
+		return false
+	}
+	if filter.SizePrefix != "" && !strings.HasPrefix(instance.Size, filter.SizePrefix) {
+		return false
+	}
+	for _, tag := range filter.Tags {
+		if !findString(instance.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
 // ListAllInstances returns all (well, upto 99,999,999 instances) Instances owned by the calling API account
 func (c *Client) ListAllInstances() ([]Instance, error) {
 	instances, err := c.ListInstances(1, 99999999)
@@ -190,6 +291,23 @@ func (c *Client) FindInstance(search string) (*Instance, error) {
 	}
 }
 
+// FindInstancesByTag returns all instances carrying the tag, the tag must match exactly
+func (c *Client) FindInstancesByTag(tag string) ([]Instance, error) {
+	instances, err := c.ListAllInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	tagged := make([]Instance, 0)
+	for _, instance := range instances {
+		if findString(instance.Tags, tag) {
+			tagged = append(tagged, instance)
+		}
+	}
+
+	return tagged, nil
+}
+
 // GetInstance returns a single Instance by its full ID
 func (c *Client) GetInstance(id string) (*Instance, error) {
 	resp, err := c.SendGetRequest("/v2/instances/" + id)
@@ -228,6 +346,17 @@ func (c *Client) NewInstanceConfig() (*InstanceConfig, error) {
 
 // CreateInstance creates a new instance in the account
 func (c *Client) CreateInstance(config *InstanceConfig) (*Instance, error) {
+	if config.DiskImageID != "" {
+		if err := c.checkDiskImageInRegion(config.DiskImageID, config.Region); err != nil {
+			return nil, err
+		}
+
+		// The disk image is sent as the template, on a copy so the caller's config keeps its own
+		withImage := *config
+		withImage.TemplateID = config.DiskImageID
+		config = &withImage
+	}
+
 	config.TagsList = strings.Join(config.Tags, " ")
 	body, err := c.SendPostRequest("/v2/instances", config)
 	if err != nil {
@@ -242,6 +371,72 @@ func (c *Client) CreateInstance(config *InstanceConfig) (*Instance, error) {
 	return &instance, nil
 }
 
+// checkDiskImageInRegion returns DiskImageNotFoundError if the disk image isn't available in the region
+func (c *Client) checkDiskImageInRegion(id, region string) error {
+	client := c
+	if region != "" && region != c.Region {
+		regional := *c
+		regional.Region = region
+		client = &regional
+	}
+
+	images, err := client.ListDiskImages()
+	if err != nil {
+		return err
+	}
+
+	for _, image := range images {
+		if image.ID == id {
+			return nil
+		}
+	}
+
+	err = fmt.Errorf("disk image %s isn't available in region %s", id, client.Region)
+	return DiskImageNotFoundError.wrap(err)
+}
+
+// CreateInstanceFromVolume creates a new instance booting from the given volume,
+// the volume must be bootable and not attached to any other instance
+func (c *Client) CreateInstanceFromVolume(volumeID string, config InstanceFromVolumeConfig) (*Instance, error) {
+	volume, err := c.GetVolume(volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !volume.Bootable {
+		err := fmt.Errorf("the volume %s is not bootable", volume.ID)
+		return nil, VolumeNotBootableError.wrap(err)
+	}
+
+	if volume.InstanceID != "" || volume.Status == "attached" {
+		err := fmt.Errorf("the volume %s is already attached to an instance", volume.ID)
+		return nil, VolumeAlreadyAttachedError.wrap(err)
+	}
+
+	networkID := config.NetworkID
+	if networkID == "" {
+		networkID = volume.NetworkID
+	}
+
+	return c.CreateInstance(&InstanceConfig{
+		Count:            1,
+		Hostname:         config.Hostname,
+		ReverseDNS:       config.ReverseDNS,
+		Size:             config.Size,
+		Region:           c.Region,
+		PublicIPRequired: config.PublicIPRequired,
+		ReservedIPv4:     config.ReservedIPv4,
+		NetworkID:        networkID,
+		SourceType:       "volume",
+		SourceID:         volume.ID,
+		InitialUser:      config.InitialUser,
+		SSHKeyID:         config.SSHKeyID,
+		Script:           config.Script,
+		Tags:             config.Tags,
+		FirewallID:       config.FirewallID,
+	})
+}
+
 // SetInstanceTags sets the tags for the specified instance
 func (c *Client) SetInstanceTags(i *Instance, tags string) (*SimpleResponse, error) {
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/instances/%s/tags", i.ID), map[string]string{
@@ -256,6 +451,69 @@ func (c *Client) SetInstanceTags(i *Instance, tags string) (*SimpleResponse, err
 	return response, err
 }
 
+// AddInstanceTags adds tags to an instance, keeping the tags it already has
+func (c *Client) AddInstanceTags(id string, tags []string) (*SimpleResponse, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := append([]string{}, instance.Tags...)
+	for _, tag := range tags {
+		if !findString(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+
+	return c.SetInstanceTags(instance, strings.Join(merged, " "))
+}
+
+// RemoveInstanceTags removes tags from an instance, keeping the rest of its tags
+func (c *Client) RemoveInstanceTags(id string, tags []string) (*SimpleResponse, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := []string{}
+	for _, tag := range instance.Tags {
+		if !findString(tags, tag) {
+			remaining = append(remaining, tag)
+		}
+	}
+
+	return c.SetInstanceTags(instance, strings.Join(remaining, " "))
+}
+
+// ReplaceInstanceTags replaces all the tags of an instance with the given list
+func (c *Client) ReplaceInstanceTags(id string, tags []string) (*SimpleResponse, error) {
+	return c.SetInstanceTags(&Instance{ID: id}, strings.Join(tags, " "))
+}
+
+// GetInstanceUserData returns the user-data (cloud-init) script of an instance
+func (c *Client) GetInstanceUserData(id string) (string, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return "", err
+	}
+
+	return instance.Script, nil
+}
+
+// SetInstanceUserData replaces the user-data (cloud-init) script of an instance, the new
+// script is used the next time cloud-init runs on the instance
+func (c *Client) SetInstanceUserData(id, script string) (*SimpleResponse, error) {
+	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/instances/%s/script", id), map[string]string{
+		"script": script,
+		"region": c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
 // UpdateInstance updates an Instance's hostname, reverse DNS or notes
 func (c *Client) UpdateInstance(i *Instance) (*SimpleResponse, error) {
 	params := map[string]interface{}{
@@ -282,21 +540,63 @@ func (c *Client) UpdateInstance(i *Instance) (*SimpleResponse, error) {
 
 // GetInstanceVnc enables and gets the VNC information for an instance
 func (c *Client) GetInstanceVnc(id string) (InstanceVnc, error) {
-	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/instances/%s/vnc", id), map[string]string{
+	vnc, err := c.EnableInstanceVNC(id, 0)
+	if err != nil {
+		return InstanceVnc{}, err
+	}
+	return *vnc, nil
+}
+
+// EnableInstanceVNC enables VNC access to an instance for the given duration, a zero
+// duration uses the API's default. The duration is sent in whole hours or minutes, e.g.
+// "2h" or "30m", rounded up to the next minute.
+func (c *Client) EnableInstanceVNC(id string, duration time.Duration) (*InstanceVnc, error) {
+	params := map[string]string{
 		"region": c.Region,
-	})
-	vnc := InstanceVnc{}
+	}
+	if duration > 0 {
+		params["duration"] = formatVNCDuration(duration)
+	}
 
+	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/instances/%s/vnc", id), params)
 	if err != nil {
-		return vnc, decodeError(err)
+		return nil, decodeError(err)
+	}
+
+	vnc := &InstanceVnc{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(vnc); err != nil {
+		return nil, err
+	}
+	return vnc, nil
+}
+
+// formatVNCDuration writes a duration the way the API expects it, e.g. "2h" or "90m"
+func formatVNCDuration(duration time.Duration) string {
+	minutes := int64((duration + time.Minute - 1) / time.Minute)
+	if minutes%60 == 0 {
+		return fmt.Sprintf("%dh", minutes/60)
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
+
+// DisableInstanceVNC revokes VNC access to an instance before it expires
+func (c *Client) DisableInstanceVNC(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/instances/%s/vnc", id))
+	if err != nil {
+		return nil, decodeError(err)
 	}
 
-	err = json.NewDecoder(bytes.NewReader(resp)).Decode(&vnc)
-	return vnc, err
+	return c.DecodeSimpleResponse(resp)
 }
 
 // DeleteInstance deletes an instance and frees its resources
+// With ResolveNames set the instance's name can be used instead of its ID
 func (c *Client) DeleteInstance(id string) (*SimpleResponse, error) {
+	id, err := c.resolveExactID(ResourceTypeInstance, id)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.SendDeleteRequest("/v2/instances/" + id)
 	if err != nil {
 		return nil, decodeError(err)
@@ -363,16 +663,54 @@ func (c *Client) StartInstance(id string) (*SimpleResponse, error) {
 	return response, err
 }
 
+// GetInstanceInitialPassword returns the user and password the instance was created with
+func (c *Client) GetInstanceInitialPassword(id string) (*InstancePassword, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InstancePassword{Username: instance.InitialUser, Password: instance.InitialPassword}, nil
+}
+
+// ResetInstancePassword generates a new password for the instance's initial user and returns it
+func (c *Client) ResetInstancePassword(id string) (*InstancePassword, error) {
+	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/instances/%s/password", id), map[string]string{
+		"region": c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	password := &InstancePassword{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(password); err != nil {
+		return nil, err
+	}
+
+	return password, nil
+}
+
 // GetInstanceConsoleURL gets the web URL for an instance's console
 func (c *Client) GetInstanceConsoleURL(id string) (string, error) {
+	console, err := c.GetInstanceConsole(id)
+	if err != nil {
+		return "", err
+	}
+	return console.URL, nil
+}
+
+// GetInstanceConsole gets the time-limited web console for an instance, including when it expires
+func (c *Client) GetInstanceConsole(id string) (*InstanceConsole, error) {
 	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/instances/%s/console", id))
 	if err != nil {
-		return "", decodeError(err)
+		return nil, decodeError(err)
 	}
 
-	console := InstanceConsole{}
-	err = json.NewDecoder(bytes.NewReader(resp)).Decode(&console)
-	return console.URL, err
+	console := &InstanceConsole{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(console); err != nil {
+		return nil, err
+	}
+	return console, nil
 }
 
 // UpgradeInstance resizes the instance up to the new specification
@@ -390,6 +728,59 @@ func (c *Client) UpgradeInstance(id, newSize string) (*SimpleResponse, error) {
 	return response, err
 }
 
+// ResizeInstance resizes the instance to the new size after checking the size exists and
+// its disk isn't smaller than the instance's current disk, as disks can't be shrunk. If the
+// current size isn't listed the disks can't be compared, so an error is returned.
+func (c *Client) ResizeInstance(id, newSize string) (*SimpleResponse, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes, err := c.ListInstanceSizes()
+	if err != nil {
+		return nil, err
+	}
+
+	var current, target *InstanceSize
+	for i, size := range sizes {
+		if size.Name == instance.Size {
+			current = &sizes[i]
+		}
+		if size.Name == newSize {
+			target = &sizes[i]
+		}
+	}
+
+	if target == nil {
+		err := fmt.Errorf("unable to find size %s", newSize)
+		return nil, InstanceInvalidSizeError.wrap(err)
+	}
+
+	if current == nil {
+		err := fmt.Errorf("unable to find the current size %s of instance %s", instance.Size, id)
+		return nil, InstanceInvalidSizeError.wrap(err)
+	}
+
+	if target.DiskGigabytes < current.DiskGigabytes {
+		err := fmt.Errorf("size %s has a %dGB disk, smaller than the %dGB disk of the current size %s", target.Name, target.DiskGigabytes, current.DiskGigabytes, current.Name)
+		return nil, InstanceInvalidSizeError.wrap(err)
+	}
+
+	return c.UpgradeInstance(id, newSize)
+}
+
+// ResizeInstanceAndWait resizes the instance and waits until it has the new size and is ACTIVE again
+func (c *Client) ResizeInstanceAndWait(id, newSize string, opts WaitOptions) (*Instance, error) {
+	if _, err := c.ResizeInstance(id, newSize); err != nil {
+		return nil, err
+	}
+
+	return c.waitForInstance(id, opts, func(instance *Instance) bool {
+		return instance.Size == newSize && instance.Status == InstanceStatusActive
+	})
+}
+
 // MovePublicIPToInstance moves a public IP to the specified instance
 func (c *Client) MovePublicIPToInstance(id, ipAddress string) (*SimpleResponse, error) {
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/instances/%s/ip/%s", id, ipAddress), "")
@@ -0,0 +1,91 @@
+package civogo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// DefaultBulkConcurrency is the number of instances CreateInstances creates at the same time
+// when BulkOptions.Concurrency isn't set
+const DefaultBulkConcurrency = 5
+
+// BulkOptions controls how CreateInstances creates the instances
+type BulkOptions struct {
+	// Concurrency is the maximum number of creations in flight at once
+	Concurrency int
+	// NameTemplate is a fmt template given the 1-based index of each instance, e.g. "web-%d",
+	// it defaults to the config's hostname followed by "-%d"
+	NameTemplate string
+}
+
+// BulkInstanceResult is the outcome of creating one of the instances requested from CreateInstances
+type BulkInstanceResult struct {
+	Index    int
+	Hostname string
+	Instance *Instance
+	Error    error
+}
+
+// CreateInstances creates count instances from the same config with bounded concurrency, naming
+// them from the template in opts. A result is returned for every instance in index order, failed
+// creations carry their error in the result. Once the context is cancelled no more creations are
+// started and the remaining results get the context's error, which is also returned.
+func (c *Client) CreateInstances(ctx context.Context, cfg InstanceConfig, count int, opts BulkOptions) ([]BulkInstanceResult, error) {
+	if count < 1 {
+		err := fmt.Errorf("at least 1 instance has to be created, got %d", count)
+		return nil, InvalidInstanceConfigError.wrap(err)
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = DefaultBulkConcurrency
+	}
+
+	template := opts.NameTemplate
+	if template == "" && cfg.Hostname != "" {
+		template = cfg.Hostname + "-%d"
+	}
+	if template != "" && !strings.Contains(template, "%d") {
+		template = template + "-%d"
+	}
+
+	results := make([]BulkInstanceResult, count)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < count; i++ {
+		results[i].Index = i + 1
+		if template != "" {
+			results[i].Hostname = fmt.Sprintf(template, i+1)
+		}
+
+		select {
+		case <-ctx.Done():
+			results[i].Error = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(result *BulkInstanceResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := ctx.Err(); err != nil {
+				result.Error = err
+				return
+			}
+
+			config := cfg
+			config.Count = 1
+			config.Hostname = result.Hostname
+			result.Instance, result.Error = c.worker().CreateInstance(&config)
+		}(&results[i])
+	}
+
+	wg.Wait()
+
+	return results, ctx.Err()
+}
@@ -0,0 +1,69 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCreateInstances(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"POST /v2/instances": {`{"id": "12345", "status": "BUILDING"}`},
+	})
+	defer server.Close()
+
+	got, err := client.CreateInstances(context.Background(), InstanceConfig{Size: "g3.small"}, 3, BulkOptions{Concurrency: 2, NameTemplate: "web-%d"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got) != 3 {
+		t.Errorf("Expected %d results, got %d", 3, len(got))
+		return
+	}
+	for i, result := range got {
+		expected := []string{"web-1", "web-2", "web-3"}[i]
+		if result.Hostname != expected {
+			t.Errorf("Expected %s, got %s", expected, result.Hostname)
+		}
+		if result.Error != nil || result.Instance == nil || result.Instance.ID != "12345" {
+			t.Errorf("Expected instance %s to be created, got %+v", expected, result)
+		}
+	}
+}
+
+func TestCreateInstancesCancelled(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"POST /v2/instances": {`{"id": "12345", "status": "BUILDING"}`},
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err := client.CreateInstances(ctx, InstanceConfig{Hostname: "web"}, 2, BulkOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %s, got %v", context.Canceled, err)
+	}
+
+	for _, result := range got {
+		if result.Hostname != fmt.Sprintf("web-%d", result.Index) {
+			t.Errorf("Expected the hostname to default from the config, got %s", result.Hostname)
+		}
+		if !errors.Is(result.Error, context.Canceled) {
+			t.Errorf("Expected %s, got %v", context.Canceled, result.Error)
+		}
+	}
+}
+
+func TestCreateInstancesInvalidCount(t *testing.T) {
+	client, _ := NewClient("TEST-API-KEY", "TEST")
+
+	for _, count := range []int{0, -1} {
+		if _, err := client.CreateInstances(context.Background(), InstanceConfig{}, count, BulkOptions{}); !errors.Is(err, InvalidInstanceConfigError) {
+			t.Errorf("Expected %s for %d instances, got %v", InvalidInstanceConfigError, count, err)
+		}
+	}
+}
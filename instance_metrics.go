@@ -0,0 +1,80 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// Metric names accepted in MetricsRequest.Metrics
+const (
+	MetricCPU     = "cpu"
+	MetricMemory  = "memory"
+	MetricDisk    = "disk"
+	MetricNetwork = "network"
+)
+
+// MetricsRequest selects which metrics GetInstanceMetrics returns and over what period,
+// empty fields use the API's defaults
+type MetricsRequest struct {
+	// Metrics lists the metrics to return, all of them if empty
+	Metrics []string  `url:"metrics,comma,omitempty"`
+	Start   time.Time `url:"start,omitempty"`
+	End     time.Time `url:"end,omitempty"`
+	// Step is the interval between two points, it's rounded to the second
+	Step time.Duration `url:"-"`
+}
+
+// MetricPoint is a single value in a time series
+type MetricPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// MetricSeries is a time series for a single metric, e.g. the received bytes of the network
+type MetricSeries struct {
+	Name   string        `json:"name"`
+	Unit   string        `json:"unit,omitempty"`
+	Points []MetricPoint `json:"points"`
+}
+
+// InstanceMetrics contains the usage time series of an instance
+type InstanceMetrics struct {
+	InstanceID string         `json:"instance_id"`
+	CPU        []MetricSeries `json:"cpu,omitempty"`
+	Memory     []MetricSeries `json:"memory,omitempty"`
+	Disk       []MetricSeries `json:"disk,omitempty"`
+	Network    []MetricSeries `json:"network,omitempty"`
+}
+
+// GetInstanceMetrics returns the CPU, memory, disk and network usage of an instance
+func (c *Client) GetInstanceMetrics(id string, r MetricsRequest) (*InstanceMetrics, error) {
+	vals, err := query.Values(r)
+	if err != nil {
+		return nil, err
+	}
+	if r.Step > 0 {
+		vals.Set("step", strconv.Itoa(int(r.Step.Round(time.Second).Seconds())))
+	}
+
+	url := fmt.Sprintf("/v2/instances/%s/metrics", id)
+	if encoded := vals.Encode(); encoded != "" {
+		url = url + "?" + encoded
+	}
+
+	resp, err := c.SendGetRequest(url)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	metrics := &InstanceMetrics{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(metrics); err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
@@ -0,0 +1,44 @@
+package civogo
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetInstanceMetrics(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345/metrics?end=2020-01-01T01%3A00%3A00Z&metrics=cpu%2Cnetwork&region=TEST&start=2020-01-01T00%3A00%3A00Z&step=300": `{
+			"instance_id": "12345",
+			"cpu": [{"name": "usage", "unit": "percent", "points": [{"timestamp": "2020-01-01T00:00:00Z", "value": 12.5}]}],
+			"network": [
+				{"name": "rx", "unit": "bytes", "points": [{"timestamp": "2020-01-01T00:00:00Z", "value": 1024}]},
+				{"name": "tx", "unit": "bytes", "points": [{"timestamp": "2020-01-01T00:00:00Z", "value": 2048}]}
+			]
+		}`,
+	})
+	defer server.Close()
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	got, err := client.GetInstanceMetrics("12345", MetricsRequest{
+		Metrics: []string{MetricCPU, MetricNetwork},
+		Start:   start,
+		End:     start.Add(time.Hour),
+		Step:    5 * time.Minute,
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expectedCPU := []MetricSeries{{Name: "usage", Unit: "percent", Points: []MetricPoint{{Timestamp: start, Value: 12.5}}}}
+	if !reflect.DeepEqual(got.CPU, expectedCPU) {
+		t.Errorf("Expected %+v, got %+v", expectedCPU, got.CPU)
+	}
+	if len(got.Network) != 2 || got.Network[1].Points[0].Value != 2048 {
+		t.Errorf("Expected the rx and tx network series, got %+v", got.Network)
+	}
+	if got.Memory != nil {
+		t.Errorf("Expected no memory series, got %+v", got.Memory)
+	}
+}
@@ -0,0 +1,55 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// InstanceRecoveryStatus is the recovery mode state of an instance
+type InstanceRecoveryStatus struct {
+	// Status is one of "enabled", "disabled" or a transitional state such as "enabling"
+	Status string `json:"status"`
+}
+
+// Enabled returns true once the instance has booted into recovery mode
+func (s *InstanceRecoveryStatus) Enabled() bool {
+	return s.Status == "enabled"
+}
+
+// EnableInstanceRecovery reboots an instance into recovery mode so its disk can be repaired
+func (c *Client) EnableInstanceRecovery(id string) (*SimpleResponse, error) {
+	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/instances/%s/recovery", id), map[string]string{
+		"region": c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
+// DisableInstanceRecovery reboots an instance out of recovery mode back to its own disk
+func (c *Client) DisableInstanceRecovery(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/instances/%s/recovery", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
+// GetInstanceRecoveryStatus returns whether an instance is in recovery mode
+func (c *Client) GetInstanceRecoveryStatus(id string) (*InstanceRecoveryStatus, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/instances/%s/recovery", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	status := &InstanceRecoveryStatus{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
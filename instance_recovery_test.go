@@ -0,0 +1,51 @@
+package civogo
+
+import (
+	"testing"
+)
+
+func TestEnableInstanceRecovery(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST"}`,
+					URL:          "/v2/instances/12345/recovery",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.EnableInstanceRecovery("12345")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestDisableInstanceRecovery(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"DELETE /v2/instances/12345/recovery": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	got, err := client.DisableInstanceRecovery("12345")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestGetInstanceRecoveryStatus(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances/12345/recovery": {`{"status": "enabled"}`},
+	})
+	defer server.Close()
+
+	got, err := client.GetInstanceRecoveryStatus("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if !got.Enabled() {
+		t.Errorf("Expected %s, got %s", "enabled", got.Status)
+	}
+}
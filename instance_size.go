@@ -71,3 +71,59 @@ func (c *Client) FindInstanceSizes(search string) (*InstanceSize, error) {
 		return nil, ZeroMatchesError.wrap(err)
 	}
 }
+
+// Instance size classes accepted by ListInstanceSizesByType
+const (
+	// instanceSizeTypeInstance is the size type the API reports for instance sizes, as opposed
+	// to the Kubernetes, database and kfaas ones
+	instanceSizeTypeInstance = "instance"
+
+	// InstanceSizeTypeGPU matches sizes with at least one GPU
+	InstanceSizeTypeGPU = "gpu"
+	// InstanceSizeTypeCPU matches CPU-optimised sizes, e.g. g4c.small
+	InstanceSizeTypeCPU = "cpu"
+	// InstanceSizeTypeStandard matches every size that isn't GPU or CPU-optimised
+	InstanceSizeTypeStandard = "standard"
+)
+
+// ListInstanceSizesByType returns the available instance sizes of one class: gpu, cpu or standard.
+// Sizes for Kubernetes nodes, databases and kfaas aren't included.
+func (c *Client) ListInstanceSizesByType(sizeType string) ([]InstanceSize, error) {
+	sizeType = strings.ToLower(sizeType)
+	switch sizeType {
+	case InstanceSizeTypeGPU, InstanceSizeTypeCPU, InstanceSizeTypeStandard:
+	default:
+		err := fmt.Errorf("unknown size type %q, must be one of gpu, cpu or standard", sizeType)
+		return nil, InstanceInvalidSizeError.wrap(err)
+	}
+
+	sizes, err := c.ListInstanceSizes()
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]InstanceSize, 0)
+	for _, size := range sizes {
+		if size.Type == instanceSizeTypeInstance && size.sizeType() == sizeType {
+			filtered = append(filtered, size)
+		}
+	}
+
+	return filtered, nil
+}
+
+// sizeType classifies a size, the API has no field for CPU-optimised sizes so they're the ones
+// it describes as such, e.g. "Small - CPU optimized"
+func (s InstanceSize) sizeType() string {
+	if s.GPUCount > 0 {
+		return InstanceSizeTypeGPU
+	}
+
+	for _, text := range []string{s.NiceName, s.Description} {
+		if strings.Contains(strings.ToLower(text), "cpu optimi") {
+			return InstanceSizeTypeCPU
+		}
+	}
+
+	return InstanceSizeTypeStandard
+}
@@ -100,3 +100,42 @@ func TestFindInstanceSizes(t *testing.T) {
 		t.Errorf("Expected %s, got %s", "g3.xsmall", got.Name)
 	}
 }
+
+func TestListInstanceSizesByType(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/sizes": `[
+			{"type": "instance", "name": "g4s.small", "nice_name": "Small", "cpu_cores": 1, "gpu_count": 0},
+			{"type": "instance", "name": "g4c.small", "nice_name": "Small - CPU optimized", "cpu_cores": 4, "gpu_count": 0},
+			{"type": "instance", "name": "an.g1.l40s.x1", "nice_name": "NVIDIA L40S", "cpu_cores": 12, "gpu_count": 1, "gpu_type": "L40S"},
+			{"type": "kubernetes", "name": "g4s.kube.small", "nice_name": "Small", "cpu_cores": 1, "gpu_count": 0},
+			{"type": "database", "name": "g3.db.small", "nice_name": "Small", "cpu_cores": 2, "gpu_count": 0}
+		]`,
+	})
+	defer server.Close()
+
+	expected := map[string]string{
+		InstanceSizeTypeGPU:      "an.g1.l40s.x1",
+		InstanceSizeTypeCPU:      "g4c.small",
+		InstanceSizeTypeStandard: "g4s.small",
+	}
+
+	for sizeType, name := range expected {
+		got, err := client.ListInstanceSizesByType(sizeType)
+		if err != nil {
+			t.Errorf("Request returned an error: %s", err)
+			return
+		}
+		if len(got) != 1 || got[0].Name != name {
+			t.Errorf("Expected %s sizes to be [%s], got %+v", sizeType, name, got)
+		}
+	}
+
+	gpus, _ := client.ListInstanceSizesByType("GPU")
+	if len(gpus) != 1 || gpus[0].GPUType != "L40S" {
+		t.Errorf("Expected the GPU type %s, got %+v", "L40S", gpus)
+	}
+
+	if _, err := client.ListInstanceSizesByType("tpu"); err == nil {
+		t.Errorf("Expected an error for an unknown size type")
+	}
+}
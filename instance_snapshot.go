@@ -0,0 +1,95 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// InstanceSnapshot is a point-in-time copy of an instance's disk
+type InstanceSnapshot struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description,omitempty"`
+	InstanceID    string    `json:"instance_id"`
+	Status        string    `json:"status"`
+	SizeGigabytes int       `json:"size_gb"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+}
+
+// InstanceSnapshotConfig is the configuration for creating a new InstanceSnapshot
+type InstanceSnapshotConfig struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Region      string `json:"region"`
+}
+
+// CreateInstanceSnapshot creates a snapshot of an instance
+func (c *Client) CreateInstanceSnapshot(instanceID string, config *InstanceSnapshotConfig) (*InstanceSnapshot, error) {
+	config.Region = c.Region
+	body, err := c.SendPostRequest(fmt.Sprintf("/v2/instances/%s/snapshots", instanceID), config)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var result = &InstanceSnapshot{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListInstanceSnapshots returns all snapshots of an instance
+func (c *Client) ListInstanceSnapshots(instanceID string) ([]InstanceSnapshot, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/instances/%s/snapshots", instanceID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var snapshots = make([]InstanceSnapshot, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&snapshots); err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// GetInstanceSnapshot returns a single snapshot of an instance by its full ID
+func (c *Client) GetInstanceSnapshot(instanceID, snapshotID string) (*InstanceSnapshot, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/instances/%s/snapshots/%s", instanceID, snapshotID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var snapshot = InstanceSnapshot{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// RestoreInstanceSnapshot restores an instance's disk from one of its snapshots,
+// replacing its current contents
+func (c *Client) RestoreInstanceSnapshot(instanceID, snapshotID string) (*SimpleResponse, error) {
+	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/instances/%s/snapshots/%s/restore", instanceID, snapshotID), map[string]string{
+		"region": c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
+// DeleteInstanceSnapshot deletes a snapshot of an instance
+func (c *Client) DeleteInstanceSnapshot(instanceID, snapshotID string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/instances/%s/snapshots/%s", instanceID, snapshotID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
@@ -0,0 +1,193 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SnapshotSchedule takes snapshots of a set of instances on a cron schedule and removes the
+// old ones according to its retention policy
+type SnapshotSchedule struct {
+	ID             string                     `json:"id"`
+	Name           string                     `json:"name"`
+	Description    string                     `json:"description,omitempty"`
+	CronExpression string                     `json:"cron_expression"`
+	Paused         bool                       `json:"paused"`
+	Retention      SnapshotRetention          `json:"retention"`
+	Instances      []SnapshotScheduleInstance `json:"instances"`
+	Status         string                     `json:"status"`
+	// LastRunAt is when snapshots were last taken, empty if the schedule hasn't run yet
+	LastRunAt time.Time `json:"last_run_at,omitempty"`
+	// NextRunAt is when snapshots will next be taken, empty while the schedule is paused
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// SnapshotRetention limits how many snapshots a schedule keeps of each instance. When both
+// limits are set a snapshot is removed as soon as it breaks either of them, when neither is set
+// snapshots are kept until they're deleted.
+type SnapshotRetention struct {
+	MaxSnapshots int `json:"max_snapshots,omitempty"`
+	MaxAgeDays   int `json:"max_age_days,omitempty"`
+}
+
+// SnapshotScheduleInstance is an instance snapshotted by a schedule
+type SnapshotScheduleInstance struct {
+	ID string `json:"id"`
+	// IncludeVolumes also snapshots the volumes attached to the instance
+	IncludeVolumes bool `json:"include_volumes"`
+}
+
+// CreateSnapshotScheduleRequest is the configuration for creating a new SnapshotSchedule, the
+// cron expression can be one of @hourly, @daily, @weekly or @monthly or have 5 fields
+type CreateSnapshotScheduleRequest struct {
+	Name           string                     `json:"name"`
+	Description    string                     `json:"description,omitempty"`
+	CronExpression string                     `json:"cron_expression"`
+	Retention      SnapshotRetention          `json:"retention"`
+	Instances      []SnapshotScheduleInstance `json:"instances"`
+	Region         string                     `json:"region"`
+}
+
+// UpdateSnapshotScheduleRequest changes a SnapshotSchedule, empty fields are left unchanged
+type UpdateSnapshotScheduleRequest struct {
+	Name           string                     `json:"name,omitempty"`
+	Description    string                     `json:"description,omitempty"`
+	CronExpression string                     `json:"cron_expression,omitempty"`
+	Paused         *bool                      `json:"paused,omitempty"`
+	Retention      *SnapshotRetention         `json:"retention,omitempty"`
+	Instances      []SnapshotScheduleInstance `json:"instances,omitempty"`
+	Region         string                     `json:"region"`
+}
+
+// Validate checks the schedule has a name, a valid cron expression and at least one instance
+func (r *CreateSnapshotScheduleRequest) Validate() error {
+	if r.Name == "" {
+		err := fmt.Errorf("the snapshot schedule name is empty")
+		return InvalidSnapshotScheduleError.wrap(err)
+	}
+	if err := checkCronExpression(r.CronExpression); err != nil {
+		return InvalidSnapshotScheduleError.wrap(err)
+	}
+	if len(r.Instances) == 0 {
+		err := fmt.Errorf("a snapshot schedule needs at least 1 instance")
+		return InvalidSnapshotScheduleError.wrap(err)
+	}
+
+	return checkSnapshotScheduleTargets(r.Retention, r.Instances)
+}
+
+// Validate checks the fields being changed are valid
+func (r *UpdateSnapshotScheduleRequest) Validate() error {
+	if r.CronExpression != "" {
+		if err := checkCronExpression(r.CronExpression); err != nil {
+			return InvalidSnapshotScheduleError.wrap(err)
+		}
+	}
+
+	retention := SnapshotRetention{}
+	if r.Retention != nil {
+		retention = *r.Retention
+	}
+	return checkSnapshotScheduleTargets(retention, r.Instances)
+}
+
+// checkSnapshotScheduleTargets checks the retention limits aren't negative and every instance has an ID
+func checkSnapshotScheduleTargets(retention SnapshotRetention, instances []SnapshotScheduleInstance) error {
+	if retention.MaxSnapshots < 0 || retention.MaxAgeDays < 0 {
+		err := fmt.Errorf("retention limits can't be negative, got %d snapshots and %d days", retention.MaxSnapshots, retention.MaxAgeDays)
+		return InvalidSnapshotScheduleError.wrap(err)
+	}
+
+	for _, instance := range instances {
+		if instance.ID == "" {
+			err := fmt.Errorf("an instance of the snapshot schedule has an empty ID")
+			return InvalidSnapshotScheduleError.wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// CreateSnapshotSchedule creates a schedule taking snapshots of instances
+func (c *Client) CreateSnapshotSchedule(r *CreateSnapshotScheduleRequest) (*SnapshotSchedule, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	r.Region = c.Region
+	body, err := c.SendPostRequest("/v2/snapshot_schedules", r)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var schedule = &SnapshotSchedule{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(schedule); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// ListSnapshotSchedules returns all snapshot schedules in the region
+func (c *Client) ListSnapshotSchedules() ([]SnapshotSchedule, error) {
+	resp, err := c.SendGetRequest("/v2/snapshot_schedules")
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var schedules = make([]SnapshotSchedule, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&schedules); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// GetSnapshotSchedule returns a single snapshot schedule by its full ID
+func (c *Client) GetSnapshotSchedule(id string) (*SnapshotSchedule, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/snapshot_schedules/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var schedule = &SnapshotSchedule{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(schedule); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// UpdateSnapshotSchedule changes a snapshot schedule, e.g. its cron expression, retention or
+// instances, or pauses and resumes it
+func (c *Client) UpdateSnapshotSchedule(id string, r *UpdateSnapshotScheduleRequest) (*SnapshotSchedule, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	r.Region = c.Region
+	body, err := c.SendPutRequest(fmt.Sprintf("/v2/snapshot_schedules/%s", id), r)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var schedule = &SnapshotSchedule{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(schedule); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// DeleteSnapshotSchedule deletes a snapshot schedule, the snapshots it has taken are kept
+func (c *Client) DeleteSnapshotSchedule(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/snapshot_schedules/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
@@ -0,0 +1,139 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateSnapshotSchedule(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"nightly","cron_expression":"0 2 * * *","retention":{"max_snapshots":7},"instances":[{"id":"12345","include_volumes":true}],"region":"TEST"}`,
+					URL:          "/v2/snapshot_schedules",
+					ResponseBody: `{"id": "sched-1", "name": "nightly", "cron_expression": "0 2 * * *", "retention": {"max_snapshots": 7}, "instances": [{"id": "12345", "include_volumes": true}], "status": "active", "next_run_at": "2020-01-02T02:00:00Z"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateSnapshotSchedule(&CreateSnapshotScheduleRequest{
+		Name:           "nightly",
+		CronExpression: "0 2 * * *",
+		Retention:      SnapshotRetention{MaxSnapshots: 7},
+		Instances:      []SnapshotScheduleInstance{{ID: "12345", IncludeVolumes: true}},
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &SnapshotSchedule{
+		ID:             "sched-1",
+		Name:           "nightly",
+		CronExpression: "0 2 * * *",
+		Retention:      SnapshotRetention{MaxSnapshots: 7},
+		Instances:      []SnapshotScheduleInstance{{ID: "12345", IncludeVolumes: true}},
+		Status:         "active",
+		NextRunAt:      time.Date(2020, 1, 2, 2, 0, 0, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestCreateSnapshotScheduleInvalid(t *testing.T) {
+	client, _ := NewClient("TEST-API-KEY", "TEST")
+
+	invalid := []CreateSnapshotScheduleRequest{
+		{CronExpression: "@daily", Instances: []SnapshotScheduleInstance{{ID: "12345"}}},
+		{Name: "nightly", CronExpression: "every night", Instances: []SnapshotScheduleInstance{{ID: "12345"}}},
+		{Name: "nightly", CronExpression: "@daily"},
+		{Name: "nightly", CronExpression: "@daily", Instances: []SnapshotScheduleInstance{{ID: "12345"}}, Retention: SnapshotRetention{MaxAgeDays: -1}},
+	}
+	for _, r := range invalid {
+		if _, err := client.CreateSnapshotSchedule(&r); !errors.Is(err, InvalidSnapshotScheduleError) {
+			t.Errorf("Expected %s for %+v, got %v", InvalidSnapshotScheduleError, r, err)
+		}
+	}
+}
+
+func TestListSnapshotSchedules(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/snapshot_schedules": `[{
+			"id": "sched-1",
+			"name": "nightly",
+			"cron_expression": "@daily",
+			"paused": true,
+			"retention": {"max_age_days": 30},
+			"instances": [{"id": "12345", "include_volumes": false}],
+			"status": "paused",
+			"last_run_at": "2020-01-01T00:00:00Z"
+		}]`,
+	})
+	defer server.Close()
+
+	got, err := client.ListSnapshotSchedules()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []SnapshotSchedule{
+		{
+			ID:             "sched-1",
+			Name:           "nightly",
+			CronExpression: "@daily",
+			Paused:         true,
+			Retention:      SnapshotRetention{MaxAgeDays: 30},
+			Instances:      []SnapshotScheduleInstance{{ID: "12345"}},
+			Status:         "paused",
+			LastRunAt:      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestUpdateSnapshotSchedule(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"cron_expression":"0 */6 * * *","paused":false,"region":"TEST"}`,
+					URL:          "/v2/snapshot_schedules/sched-1",
+					ResponseBody: `{"id": "sched-1", "name": "nightly", "cron_expression": "0 */6 * * *", "paused": false, "status": "active"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	paused := false
+	got, err := client.UpdateSnapshotSchedule("sched-1", &UpdateSnapshotScheduleRequest{CronExpression: "0 */6 * * *", Paused: &paused})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.CronExpression != "0 */6 * * *" || got.Status != "active" {
+		t.Errorf("Expected the schedule to be updated, got %+v", got)
+	}
+}
+
+func TestDeleteSnapshotSchedule(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/snapshot_schedules/sched-1": `{"result": "success"}`,
+	})
+	defer server.Close()
+
+	got, err := client.DeleteSnapshotSchedule("sched-1")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
@@ -0,0 +1,116 @@
+package civogo
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateInstanceSnapshot(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"before-upgrade","region":"TEST"}`,
+					URL:          "/v2/instances/12345/snapshots",
+					ResponseBody: `{"id": "snap-1", "name": "before-upgrade", "instance_id": "12345", "status": "pending", "size_gb": 0}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateInstanceSnapshot("12345", &InstanceSnapshotConfig{Name: "before-upgrade"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &InstanceSnapshot{ID: "snap-1", Name: "before-upgrade", InstanceID: "12345", Status: "pending"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestListInstanceSnapshots(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345/snapshots": `[{
+			"id": "snap-1",
+			"name": "before-upgrade",
+			"description": "taken before the upgrade",
+			"instance_id": "12345",
+			"status": "available",
+			"size_gb": 25,
+			"created_at": "2020-01-01T00:00:00Z"
+		}]`,
+	})
+	defer server.Close()
+
+	got, err := client.ListInstanceSnapshots("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []InstanceSnapshot{
+		{
+			ID:            "snap-1",
+			Name:          "before-upgrade",
+			Description:   "taken before the upgrade",
+			InstanceID:    "12345",
+			Status:        "available",
+			SizeGigabytes: 25,
+			CreatedAt:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestGetInstanceSnapshot(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345/snapshots/snap-1": `{"id": "snap-1", "name": "before-upgrade", "instance_id": "12345", "status": "available", "size_gb": 25}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetInstanceSnapshot("12345", "snap-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.SizeGigabytes != 25 {
+		t.Errorf("Expected %d, got %d", 25, got.SizeGigabytes)
+	}
+}
+
+func TestRestoreInstanceSnapshot(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST"}`,
+					URL:          "/v2/instances/12345/snapshots/snap-1/restore",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.RestoreInstanceSnapshot("12345", "snap-1")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestDeleteInstanceSnapshot(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345/snapshots/snap-1": `{"result": "success"}`,
+	})
+	defer server.Close()
+
+	got, err := client.DeleteInstanceSnapshot("12345", "snap-1")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
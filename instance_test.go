@@ -1,7 +1,9 @@
 package civogo
 
 import (
+	"errors"
 	"testing"
+	"time"
 )
 
 func TestListInstances(t *testing.T) {
@@ -204,6 +206,50 @@ func TestCreateInstance(t *testing.T) {
 	}
 }
 
+func TestCreateInstanceFromVolume(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/volumes/12345": `{"id": "12345", "name": "boot-volume", "network_id": "28244c7d", "status": "available", "size_gb": 25, "bootable": true}`,
+		"/v2/instances":     `{"id": "b177ae0e-60fa-11e5-be02-5cf9389be614", "hostname": "foo.example.com", "network_id": "28244c7d", "source_type": "volume", "source_id": "12345"}`,
+	})
+	defer server.Close()
+
+	got, err := client.CreateInstanceFromVolume("12345", InstanceFromVolumeConfig{Hostname: "foo.example.com", Size: "g3.small"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.SourceType != "volume" {
+		t.Errorf("Expected %s, got %s", "volume", got.SourceType)
+	}
+	if got.SourceID != "12345" {
+		t.Errorf("Expected %s, got %s", "12345", got.SourceID)
+	}
+}
+
+func TestCreateInstanceFromVolumeNotBootable(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/volumes/12345": `{"id": "12345", "name": "data-volume", "status": "available", "size_gb": 25, "bootable": false}`,
+	})
+	defer server.Close()
+
+	_, err := client.CreateInstanceFromVolume("12345", InstanceFromVolumeConfig{Hostname: "foo.example.com"})
+	if !errors.Is(err, VolumeNotBootableError) {
+		t.Errorf("Expected %s, got %s", VolumeNotBootableError, err)
+	}
+}
+
+func TestCreateInstanceFromVolumeAttached(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/volumes/12345": `{"id": "12345", "name": "boot-volume", "instance_id": "67890", "status": "attached", "size_gb": 25, "bootable": true}`,
+	})
+	defer server.Close()
+
+	_, err := client.CreateInstanceFromVolume("12345", InstanceFromVolumeConfig{Hostname: "foo.example.com"})
+	if !errors.Is(err, VolumeAlreadyAttachedError) {
+		t.Errorf("Expected %s, got %s", VolumeAlreadyAttachedError, err)
+	}
+}
+
 func TestSetInstanceTags(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/instances/12345/tags": `{
@@ -433,3 +479,378 @@ func TestSetInstanceFirewall(t *testing.T) {
 	got, err := client.SetInstanceFirewall("12345", "67890")
 	EnsureSuccessfulSimpleResponse(t, got, err)
 }
+
+func TestResizeInstance(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances/12345": {`{"id": "12345", "hostname": "foo.example.com", "size": "g3.small", "status": "ACTIVE"}`},
+		"GET /v2/sizes": {`[
+			{"name": "g3.xsmall", "disk_gb": 25},
+			{"name": "g3.small", "disk_gb": 50},
+			{"name": "g3.medium", "disk_gb": 50},
+			{"name": "g3.large", "disk_gb": 100}
+		]`},
+		"PUT /v2/instances/12345/resize": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	got, err := client.ResizeInstance("12345", "g3.large")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+
+	if _, err := client.ResizeInstance("12345", "g3.xsmall"); !errors.Is(err, InstanceInvalidSizeError) {
+		t.Errorf("Expected InstanceInvalidSizeError for a smaller disk, got %v", err)
+	}
+
+	if _, err := client.ResizeInstance("12345", "g3.huge"); !errors.Is(err, InstanceInvalidSizeError) {
+		t.Errorf("Expected InstanceInvalidSizeError for an unknown size, got %v", err)
+	}
+}
+
+func TestResizeInstanceUnknownCurrentSize(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances/12345":        {`{"id": "12345", "hostname": "foo.example.com", "size": "g2.retired", "status": "ACTIVE"}`},
+		"GET /v2/sizes":                  {`[{"name": "g3.small", "disk_gb": 50}]`},
+		"PUT /v2/instances/12345/resize": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	if _, err := client.ResizeInstance("12345", "g3.small"); !errors.Is(err, InstanceInvalidSizeError) {
+		t.Errorf("Expected InstanceInvalidSizeError for an unknown current size, got %v", err)
+	}
+}
+
+func TestGetInstanceConsole(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345/console": `{"url": "https://console.example.com/12345", "expires_at": "2020-01-01T00:15:00Z"}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetInstanceConsole("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.URL != "https://console.example.com/12345" {
+		t.Errorf("Expected %s, got %s", "https://console.example.com/12345", got.URL)
+	}
+	if got.ExpiresAt != "2020-01-01T00:15:00Z" {
+		t.Errorf("Expected %s, got %s", "2020-01-01T00:15:00Z", got.ExpiresAt)
+	}
+}
+
+func TestGetInstanceConsoleURLWithoutExpiry(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345/console": `{"url": "https://console.example.com/12345", "expires_at": ""}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetInstanceConsoleURL("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got != "https://console.example.com/12345" {
+		t.Errorf("Expected %s, got %s", "https://console.example.com/12345", got)
+	}
+}
+
+func TestEnableInstanceVNC(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"duration":"30m","region":"TEST"}`,
+					URL:          "/v2/instances/12345/vnc",
+					ResponseBody: `{"uri": "https://vnc.example.com/12345", "result": "success", "name": "foo", "label": "foo", "expiration": "30m"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.EnableInstanceVNC("12345", 30*time.Minute)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.URI != "https://vnc.example.com/12345" {
+		t.Errorf("Expected %s, got %s", "https://vnc.example.com/12345", got.URI)
+	}
+	if got.Expiration != "30m" {
+		t.Errorf("Expected %s, got %s", "30m", got.Expiration)
+	}
+}
+
+func TestFormatVNCDuration(t *testing.T) {
+	cases := map[time.Duration]string{
+		30 * time.Minute:             "30m",
+		time.Hour:                    "1h",
+		90 * time.Minute:             "90m",
+		24 * time.Hour:               "24h",
+		10 * time.Second:             "1m",
+		2*time.Hour + 30*time.Second: "121m",
+	}
+	for duration, expected := range cases {
+		if got := formatVNCDuration(duration); got != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, duration, got)
+		}
+	}
+}
+
+func TestDisableInstanceVNC(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"DELETE /v2/instances/12345/vnc": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	got, err := client.DisableInstanceVNC("12345")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestGetInstanceUserData(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345": `{"id": "12345", "hostname": "foo.example.com", "script": "#cloud-config\npackages:\n  - nginx\n"}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetInstanceUserData("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if expected := "#cloud-config\npackages:\n  - nginx\n"; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestSetInstanceUserData(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","script":"#!/bin/sh\necho hello\n"}`,
+					URL:          "/v2/instances/12345/script",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.SetInstanceUserData("12345", "#!/bin/sh\necho hello\n")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+	if got != nil && got.Result != ResultSuccess {
+		t.Errorf("Expected %s, got %s", ResultSuccess, got.Result)
+	}
+}
+
+func TestAddInstanceTags(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "GET",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					URL:          "/v2/instances/12345",
+					ResponseBody: `{"id": "12345", "hostname": "foo.example.com", "tags": ["prod", "lamp"]}`,
+				},
+			},
+		},
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","tags":"prod lamp web"}`,
+					URL:          "/v2/instances/12345/tags",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.AddInstanceTags("12345", []string{"lamp", "web"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Result != ResultSuccess {
+		t.Errorf("Expected %s, got %s", ResultSuccess, got.Result)
+	}
+}
+
+func TestRemoveInstanceTags(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "GET",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					URL:          "/v2/instances/12345",
+					ResponseBody: `{"id": "12345", "hostname": "foo.example.com", "tags": ["prod", "lamp", "web"]}`,
+				},
+			},
+		},
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","tags":"prod web"}`,
+					URL:          "/v2/instances/12345/tags",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.RemoveInstanceTags("12345", []string{"lamp", "missing"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Result != ResultSuccess {
+		t.Errorf("Expected %s, got %s", ResultSuccess, got.Result)
+	}
+}
+
+func TestListInstancesWithFilter(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances?network_id=net-1&region=TEST&size_prefix=g3.&status=ACTIVE&tags=prod%2Cweb": `{
+			"page": 1,
+			"per_page": 20,
+			"pages": 1,
+			"items": [
+				{"id": "1", "hostname": "web-1", "size": "g3.small", "status": "ACTIVE", "network_id": "net-1", "tags": ["prod", "web"]},
+				{"id": "2", "hostname": "db-1", "size": "g3.large", "status": "ACTIVE", "network_id": "net-1", "tags": ["prod", "db"]},
+				{"id": "3", "hostname": "web-2", "size": "g4s.small", "status": "ACTIVE", "network_id": "net-1", "tags": ["prod", "web"]}
+			]
+		}`,
+	})
+	defer server.Close()
+
+	got, err := client.ListInstancesWithFilter(&InstanceFilter{Status: "ACTIVE", NetworkID: "net-1", Tags: []string{"prod", "web"}, SizePrefix: "g3."})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got.Items) != 1 || got.Items[0].ID != "1" {
+		t.Errorf("Expected only instance %s, got %+v", "1", got.Items)
+	}
+}
+
+func TestListInstancesWithFilterRegion(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances?region=LON1": `{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "1", "hostname": "web-1"}]}`,
+	})
+	defer server.Close()
+
+	got, err := client.ListInstancesWithFilter(&InstanceFilter{Region: "LON1"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got.Items) != 1 {
+		t.Errorf("Expected %d instances, got %d", 1, len(got.Items))
+	}
+	if client.Region != "TEST" {
+		t.Errorf("Expected the client's region to be unchanged, got %s", client.Region)
+	}
+}
+
+func TestCreateInstanceFromDiskImage(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/disk_images": {`[{"id": "img-1", "name": "my-custom-image", "state": "available"}]`},
+		"POST /v2/instances":  {`{"id": "12345", "hostname": "foo.example.com", "template_id": "img-1"}`},
+	})
+	defer server.Close()
+
+	config := &InstanceConfig{Hostname: "foo.example.com", Region: "TEST", DiskImageID: "img-1"}
+	got, err := client.CreateInstance(config)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.TemplateID != "img-1" {
+		t.Errorf("Expected %s, got %s", "img-1", got.TemplateID)
+	}
+	if config.TemplateID != "" {
+		t.Errorf("Expected the config's template to be left unchanged, got %s", config.TemplateID)
+	}
+
+	_, err = client.CreateInstance(&InstanceConfig{Hostname: "foo.example.com", Region: "TEST", DiskImageID: "img-2"})
+	if !errors.Is(err, DiskImageNotFoundError) {
+		t.Errorf("Expected DiskImageNotFoundError, got %v", err)
+	}
+}
+
+func TestFindInstancesByTag(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances": `{"page": 1, "per_page": 20, "pages": 1, "items": [
+			{"id": "1", "hostname": "web-1", "tags": ["canary", "web"]},
+			{"id": "2", "hostname": "web-2", "tags": ["canary-old", "web"]},
+			{"id": "3", "hostname": "web-3", "tags": ["web", "canary"]}
+		]}`,
+	})
+	defer server.Close()
+
+	got, err := client.FindInstancesByTag("canary")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got) != 2 || got[0].ID != "1" || got[1].ID != "3" {
+		t.Errorf("Expected instances %s, got %+v", "1 and 3", got)
+	}
+}
+
+func TestGetInstanceInitialPassword(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345": `{"id": "12345", "hostname": "foo.example.com", "initial_user": "civo", "initial_password": "s3cret"}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetInstanceInitialPassword("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &InstancePassword{Username: "civo", Password: "s3cret"}
+	if *got != *expected {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestResetInstancePassword(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST"}`,
+					URL:          "/v2/instances/12345/password",
+					ResponseBody: `{"user": "civo", "password": "n3w-s3cret"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.ResetInstancePassword("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &InstancePassword{Username: "civo", Password: "n3w-s3cret"}
+	if *got != *expected {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
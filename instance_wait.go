@@ -0,0 +1,122 @@
+package civogo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Instance statuses reported by the API
+const (
+	InstanceStatusBuilding = "BUILDING"
+	InstanceStatusActive   = "ACTIVE"
+	InstanceStatusShutoff  = "SHUTOFF"
+	InstanceStatusError    = "ERROR"
+)
+
+// WaitForInstanceStatus polls an instance until it reaches the given status, failing early
+// with InstanceFailedError if the instance goes into the ERROR state
+func (c *Client) WaitForInstanceStatus(id, status string, opts WaitOptions) (*Instance, error) {
+	return c.waitForInstance(id, opts, func(instance *Instance) bool {
+		return instance.Status == status
+	})
+}
+
+// waitForInstance polls an instance until done reports true, failing early with
+// InstanceFailedError if the instance goes into the ERROR state first
+func (c *Client) waitForInstance(id string, opts WaitOptions, done func(*Instance) bool) (*Instance, error) {
+	var instance *Instance
+	err := waitFor(opts, func() (bool, error) {
+		var err error
+		instance, err = c.GetInstance(id)
+		if err != nil {
+			return false, err
+		}
+
+		if done(instance) {
+			return true, nil
+		}
+		if instance.Status == InstanceStatusError {
+			err := fmt.Errorf("instance %s went into the %s state", id, instance.Status)
+			return false, InstanceFailedError.wrap(err)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+// StopInstanceAndWait shuts the power down to the instance and waits until it's SHUTOFF
+func (c *Client) StopInstanceAndWait(id string, opts WaitOptions) (*Instance, error) {
+	if _, err := c.StopInstance(id); err != nil {
+		return nil, err
+	}
+
+	return c.WaitForInstanceStatus(id, InstanceStatusShutoff, opts)
+}
+
+// StartInstanceAndWait starts the instance and waits until it's ACTIVE
+func (c *Client) StartInstanceAndWait(id string, opts WaitOptions) (*Instance, error) {
+	if _, err := c.StartInstance(id); err != nil {
+		return nil, err
+	}
+
+	return c.WaitForInstanceStatus(id, InstanceStatusActive, opts)
+}
+
+// instanceRestartWindow is how long a reboot has to show up as a status other than ACTIVE,
+// after that it's assumed to have finished between two polls
+const instanceRestartWindow = 30 * time.Second
+
+// RebootInstanceAndWait reboots an instance and waits until it's ACTIVE again
+func (c *Client) RebootInstanceAndWait(id string, opts WaitOptions) (*Instance, error) {
+	if _, err := c.RebootInstance(id); err != nil {
+		return nil, err
+	}
+
+	return c.waitForInstanceRestart(id, opts)
+}
+
+// HardRebootInstanceAndWait harshly reboots an instance and waits until it's ACTIVE again
+func (c *Client) HardRebootInstanceAndWait(id string, opts WaitOptions) (*Instance, error) {
+	if _, err := c.HardRebootInstance(id); err != nil {
+		return nil, err
+	}
+
+	return c.waitForInstanceRestart(id, opts)
+}
+
+// waitForInstanceRestart waits for a rebooting instance to be ACTIVE again. Right after the
+// reboot is requested the instance usually still reports ACTIVE, so it's first polled until
+// it reports another status, for up to instanceRestartWindow or half of opts.Timeout. A fast
+// reboot can finish between two polls, so if no other status is seen in that window the wait
+// carries on as if it had been. Both waits happen within opts.Timeout.
+func (c *Client) waitForInstanceRestart(id string, opts WaitOptions) (*Instance, error) {
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = DefaultWaitTimeout
+	}
+	deadline := time.Now().Add(timeout)
+
+	window := opts
+	window.Timeout = instanceRestartWindow
+	if window.Timeout > timeout/2 {
+		window.Timeout = timeout / 2
+	}
+	_, err := c.waitForInstance(id, window, func(instance *Instance) bool {
+		return instance.Status != InstanceStatusActive
+	})
+	if err != nil && !errors.Is(err, TimeoutError) {
+		return nil, err
+	}
+
+	opts.Timeout = time.Until(deadline)
+	if opts.Timeout <= 0 {
+		err := fmt.Errorf("timed out after %s", timeout)
+		return nil, TimeoutError.wrap(err)
+	}
+	return c.WaitForInstanceStatus(id, InstanceStatusActive, opts)
+}
@@ -0,0 +1,139 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStopInstanceAndWait(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"PUT /v2/instances/12345/stop": {`{"result": "success"}`},
+		"GET /v2/instances/12345": {
+			`{"id": "12345", "hostname": "foo.example.com", "status": "STOPPING"}`,
+			`{"id": "12345", "hostname": "foo.example.com", "status": "SHUTOFF"}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.StopInstanceAndWait("12345", WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Status != InstanceStatusShutoff {
+		t.Errorf("Expected %s, got %s", InstanceStatusShutoff, got.Status)
+	}
+}
+
+func TestHardRebootInstanceAndWait(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"POST /v2/instances/12345/hard_reboots": {`{"result": "success"}`},
+		"GET /v2/instances/12345": {
+			`{"id": "12345", "hostname": "foo.example.com", "status": "HARD_REBOOTING"}`,
+			`{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE"}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.HardRebootInstanceAndWait("12345", WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Status != InstanceStatusActive {
+		t.Errorf("Expected %s, got %s", InstanceStatusActive, got.Status)
+	}
+}
+
+func TestRebootInstanceAndWaitWhileStillActive(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"POST /v2/instances/12345/hard_reboots": {`{"result": "success"}`},
+		"GET /v2/instances/12345": {
+			`{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE"}`,
+			`{"id": "12345", "hostname": "foo.example.com", "status": "REBOOTING"}`,
+			`{"id": "12345", "hostname": "foo.example.com", "status": "REBOOTING"}`,
+			`{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "public_ip": "1.2.3.4"}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.RebootInstanceAndWait("12345", WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Status != InstanceStatusActive || got.PublicIP != "1.2.3.4" {
+		t.Errorf("Expected the wait to last until the instance came back from rebooting, got %+v", got)
+	}
+}
+
+func TestRebootInstanceAndWaitNeverLeavesActive(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"POST /v2/instances/12345/hard_reboots": {`{"result": "success"}`},
+		"GET /v2/instances/12345":               {`{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE"}`},
+	})
+	defer server.Close()
+
+	got, err := client.RebootInstanceAndWait("12345", WaitOptions{Timeout: 100 * time.Millisecond, Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Expected a reboot that finished between two polls to succeed, got %s", err)
+		return
+	}
+
+	if got.Status != InstanceStatusActive {
+		t.Errorf("Expected %s, got %s", InstanceStatusActive, got.Status)
+	}
+}
+
+func TestResizeInstanceAndWait(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances/12345": {
+			`{"id": "12345", "hostname": "foo.example.com", "size": "g3.small", "status": "ACTIVE"}`,
+			`{"id": "12345", "hostname": "foo.example.com", "size": "g3.small", "status": "ACTIVE"}`,
+			`{"id": "12345", "hostname": "foo.example.com", "size": "g3.large", "status": "RESIZING"}`,
+			`{"id": "12345", "hostname": "foo.example.com", "size": "g3.large", "status": "ACTIVE"}`,
+		},
+		"GET /v2/sizes":                  {`[{"name": "g3.small", "disk_gb": 50}, {"name": "g3.large", "disk_gb": 100}]`},
+		"PUT /v2/instances/12345/resize": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	got, err := client.ResizeInstanceAndWait("12345", "g3.large", WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Size != "g3.large" || got.Status != InstanceStatusActive {
+		t.Errorf("Expected the wait to last until the instance was resized and ACTIVE, got %+v", got)
+	}
+}
+
+func TestStartInstanceAndWaitFailed(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"PUT /v2/instances/12345/start": {`{"result": "success"}`},
+		"GET /v2/instances/12345":       {`{"id": "12345", "hostname": "foo.example.com", "status": "ERROR"}`},
+	})
+	defer server.Close()
+
+	_, err := client.StartInstanceAndWait("12345", WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if !errors.Is(err, InstanceFailedError) {
+		t.Errorf("Expected InstanceFailedError, got %v", err)
+	}
+}
+
+func TestWaitForInstanceStatusTimeout(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances/12345": {`{"id": "12345", "hostname": "foo.example.com", "status": "BUILDING"}`},
+	})
+	defer server.Close()
+
+	_, err := client.WaitForInstanceStatus("12345", InstanceStatusActive, WaitOptions{Timeout: 5 * time.Millisecond, Interval: time.Millisecond})
+	if !errors.Is(err, TimeoutError) {
+		t.Errorf("Expected TimeoutError, got %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+package civogo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultInventoryConcurrency is the number of resource types Inventory fetches at the same
+// time when InventoryOptions.Concurrency isn't set
+const DefaultInventoryConcurrency = 4
+
+// InventoryOptions controls how Inventory fetches the resources
+type InventoryOptions struct {
+	// Concurrency is the maximum number of list requests in flight at once
+	Concurrency int
+}
+
+// InventoryFailure is a resource type Inventory couldn't fetch, e.g. "instances"
+type InventoryFailure struct {
+	Resource string
+	Error    error
+}
+
+// AccountInventory is a snapshot of the resources in the client's region along with the
+// account wide SSH keys, DNS domains and webhooks. Resource types that couldn't be fetched
+// are left empty and listed in Failures.
+type AccountInventory struct {
+	Region    string
+	CreatedAt time.Time
+
+	Instances          []Instance
+	KubernetesClusters []KubernetesCluster
+	Volumes            []Volume
+	Networks           []Network
+	Firewalls          []Firewall
+	LoadBalancers      []LoadBalancer
+	Databases          []Database
+	ObjectStores       []ObjectStore
+	IPs                []IP
+	SSHKeys            []SSHKey
+	DNSDomains         []DNSDomain
+	Webhooks           []Webhook
+
+	Failures []InventoryFailure
+}
+
+// Complete reports whether every resource type was fetched
+func (inv *AccountInventory) Complete() bool {
+	return len(inv.Failures) == 0
+}
+
+// Err returns an error describing the failed resource types, or nil if the inventory is complete
+func (inv *AccountInventory) Err() error {
+	if inv.Complete() {
+		return nil
+	}
+
+	messages := make([]string, 0, len(inv.Failures))
+	for _, failure := range inv.Failures {
+		messages = append(messages, fmt.Sprintf("%s: %s", failure.Resource, failure.Error))
+	}
+	return fmt.Errorf("unable to fetch %d resource types - %s", len(inv.Failures), strings.Join(messages, "; "))
+}
+
+// inventoryTask fetches one resource type into its field of the inventory
+type inventoryTask struct {
+	resource string
+	fetch    func(c *Client, inv *AccountInventory) error
+}
+
+// inventoryTasks are the resource types Inventory fetches, each one only writes its own field
+var inventoryTasks = []inventoryTask{
+	{"instances", func(c *Client, inv *AccountInventory) (err error) {
+		inv.Instances, err = c.ListAllInstances()
+		return err
+	}},
+	{"kubernetes_clusters", func(c *Client, inv *AccountInventory) (err error) {
+		inv.KubernetesClusters, err = listAllPages[KubernetesCluster](c, "/v2/kubernetes/clusters")
+		return err
+	}},
+	{"volumes", func(c *Client, inv *AccountInventory) (err error) {
+		inv.Volumes, err = c.ListVolumes()
+		return err
+	}},
+	{"networks", func(c *Client, inv *AccountInventory) (err error) {
+		inv.Networks, err = c.ListNetworks()
+		return err
+	}},
+	{"firewalls", func(c *Client, inv *AccountInventory) (err error) {
+		inv.Firewalls, err = c.ListFirewalls()
+		return err
+	}},
+	{"loadbalancers", func(c *Client, inv *AccountInventory) (err error) {
+		inv.LoadBalancers, err = c.ListLoadBalancers()
+		return err
+	}},
+	{"databases", func(c *Client, inv *AccountInventory) (err error) {
+		inv.Databases, err = listAllPages[Database](c, "/v2/databases")
+		return err
+	}},
+	{"objectstores", func(c *Client, inv *AccountInventory) (err error) {
+		inv.ObjectStores, err = listAllPages[ObjectStore](c, "/v2/objectstores")
+		return err
+	}},
+	{"ips", func(c *Client, inv *AccountInventory) (err error) {
+		inv.IPs, err = listAllPages[IP](c, "/v2/ips")
+		return err
+	}},
+	{"ssh_keys", func(c *Client, inv *AccountInventory) (err error) {
+		inv.SSHKeys, err = c.ListSSHKeys()
+		return err
+	}},
+	{"dns_domains", func(c *Client, inv *AccountInventory) (err error) {
+		inv.DNSDomains, err = c.ListDNSDomains()
+		return err
+	}},
+	{"webhooks", func(c *Client, inv *AccountInventory) (err error) {
+		inv.Webhooks, err = c.ListWebhooks()
+		return err
+	}},
+}
+
+// Inventory fetches every resource type in the client's region concurrently into a single
+// snapshot. A failure to fetch one type is recorded in the inventory's Failures and the rest
+// carry on, so callers should check Complete before treating a missing resource as deleted.
+// Once the context is cancelled no more types are fetched and the context's error is returned
+// along with whatever was fetched.
+func (c *Client) Inventory(ctx context.Context, opts InventoryOptions) (*AccountInventory, error) {
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = DefaultInventoryConcurrency
+	}
+
+	inv := &AccountInventory{Region: c.Region, CreatedAt: time.Now()}
+
+	var mu sync.Mutex
+	fail := func(resource string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		inv.Failures = append(inv.Failures, InventoryFailure{Resource: resource, Error: err})
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for _, task := range inventoryTasks {
+		select {
+		case <-ctx.Done():
+			fail(task.resource, ctx.Err())
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(task inventoryTask) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := ctx.Err(); err != nil {
+				fail(task.resource, err)
+				return
+			}
+
+			if err := task.fetch(c.worker(), inv); err != nil {
+				fail(task.resource, err)
+			}
+		}(task)
+	}
+
+	wg.Wait()
+
+	sort.Slice(inv.Failures, func(i, j int) bool {
+		return inv.Failures[i].Resource < inv.Failures[j].Resource
+	})
+
+	return inv, ctx.Err()
+}
@@ -0,0 +1,130 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestInventory(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances":           {`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "i-1", "hostname": "web"}]}`},
+		"GET /v2/kubernetes/clusters": {`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "k-1", "name": "cluster"}]}`},
+		"GET /v2/volumes":             {`[{"id": "v-1", "name": "data"}]`},
+		"GET /v2/networks":            {`[{"id": "n-1", "label": "default", "default": true}]`},
+		"GET /v2/firewalls":           {`[{"id": "f-1", "name": "default"}]`},
+		"GET /v2/loadbalancers":       {`[{"id": "lb-1", "name": "lb"}]`},
+		"GET /v2/databases":           {`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "d-1", "name": "db"}]}`},
+		"GET /v2/objectstores":        {`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "o-1", "name": "bucket"}]}`},
+		"GET /v2/ips":                 {`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "ip-1", "name": "reserved"}]}`},
+		"GET /v2/sshkeys":             {`[{"id": "s-1", "name": "laptop"}]`},
+		"GET /v2/dns":                 {`[{"id": "dns-1", "name": "example.com"}]`},
+		"GET /v2/webhooks":            {`[{"id": "w-1", "url": "https://example.com/hook"}]`},
+	})
+	defer server.Close()
+
+	got, err := client.Inventory(context.Background(), InventoryOptions{Concurrency: 3})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if !got.Complete() || got.Err() != nil {
+		t.Errorf("Expected a complete inventory, got %+v", got.Failures)
+	}
+	if got.Region != client.Region {
+		t.Errorf("Expected %s, got %s", client.Region, got.Region)
+	}
+
+	counts := map[string]int{
+		"instances":           len(got.Instances),
+		"kubernetes_clusters": len(got.KubernetesClusters),
+		"volumes":             len(got.Volumes),
+		"networks":            len(got.Networks),
+		"firewalls":           len(got.Firewalls),
+		"loadbalancers":       len(got.LoadBalancers),
+		"databases":           len(got.Databases),
+		"objectstores":        len(got.ObjectStores),
+		"ips":                 len(got.IPs),
+		"ssh_keys":            len(got.SSHKeys),
+		"dns_domains":         len(got.DNSDomains),
+		"webhooks":            len(got.Webhooks),
+	}
+	for resource, count := range counts {
+		if count != 1 {
+			t.Errorf("Expected 1 of %s, got %d", resource, count)
+		}
+	}
+	if got.Instances[0].Hostname != "web" {
+		t.Errorf("Expected %s, got %s", "web", got.Instances[0].Hostname)
+	}
+}
+
+func TestInventoryPartialFailure(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes":   {`[{"id": "v-1", "name": "data"}]`},
+		"GET /v2/firewalls": {`[{"id": "f-1", "name": "default"}]`},
+	})
+	defer server.Close()
+
+	got, err := client.Inventory(context.Background(), InventoryOptions{})
+	if err != nil {
+		t.Errorf("Expected partial failures not to be returned as an error, got %s", err)
+		return
+	}
+
+	if len(got.Volumes) != 1 || len(got.Firewalls) != 1 {
+		t.Errorf("Expected the volumes and firewalls to be fetched, got %+v and %+v", got.Volumes, got.Firewalls)
+	}
+	if got.Complete() || got.Err() == nil {
+		t.Errorf("Expected the inventory to be incomplete")
+	}
+	if len(got.Failures) != len(inventoryTasks)-2 {
+		t.Errorf("Expected %d failures, got %d", len(inventoryTasks)-2, len(got.Failures))
+		return
+	}
+	if got.Failures[0].Resource != "databases" {
+		t.Errorf("Expected the failures to be sorted, got %s first", got.Failures[0].Resource)
+	}
+	for _, failure := range got.Failures {
+		if failure.Error == nil {
+			t.Errorf("Expected %s to have an error", failure.Resource)
+		}
+	}
+}
+
+func TestInventoryFetchesEveryPage(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/databases": {
+			`{"page": 1, "per_page": 100, "pages": 2, "items": [{"id": "d-1", "name": "db"}]}`,
+			`{"page": 2, "per_page": 100, "pages": 2, "items": [{"id": "d-2", "name": "db-replica"}]}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.Inventory(context.Background(), InventoryOptions{})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got.Databases) != 2 || got.Databases[1].ID != "d-2" {
+		t.Errorf("Expected the databases of both pages, got %+v", got.Databases)
+	}
+}
+
+func TestInventoryCancelled(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err := client.Inventory(ctx, InventoryOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %s, got %v", context.Canceled, err)
+	}
+	if len(got.Failures) != len(inventoryTasks) {
+		t.Errorf("Expected every resource type to fail, got %d failures", len(got.Failures))
+	}
+}
@@ -25,6 +25,17 @@ type AssignedTo struct {
 	Name string `json:"name"`
 }
 
+// Types of resource a reserved IP can be assigned to
+const (
+	IPAssignedToInstance     = "instance"
+	IPAssignedToLoadBalancer = "loadbalancer"
+)
+
+// Assigned returns true if the IP is currently assigned to a resource
+func (ip *IP) Assigned() bool {
+	return ip.AssignedTo.ID != ""
+}
+
 // CreateIPRequest is a struct for creating an IP
 type CreateIPRequest struct {
 	// Name is an optional parameter. If not provided, name will be the IP address
@@ -163,6 +174,9 @@ func (c *Client) AssignIP(id, resourceID, resourceType, region string) (*SimpleR
 	if resourceID == "" || resourceType == "" {
 		return nil, fmt.Errorf("resource ID and type are required")
 	}
+	if resourceType != IPAssignedToInstance && resourceType != IPAssignedToLoadBalancer {
+		return nil, fmt.Errorf("unknown resource type %q, must be one of %s or %s", resourceType, IPAssignedToInstance, IPAssignedToLoadBalancer)
+	}
 
 	actions.AssignToID = resourceID
 	actions.AssignToType = resourceType
@@ -175,6 +189,35 @@ func (c *Client) AssignIP(id, resourceID, resourceType, region string) (*SimpleR
 	return c.DecodeSimpleResponse(resp)
 }
 
+// AssignIPToInstance assigns a reserved IP to an instance in the client's region, if the IP is
+// already assigned to another instance it's moved, which makes it usable for failover
+func (c *Client) AssignIPToInstance(id, instanceID string) (*SimpleResponse, error) {
+	return c.AssignIP(id, instanceID, IPAssignedToInstance, c.Region)
+}
+
+// AssignIPToLoadBalancer assigns a reserved IP to a load balancer in the client's region
+func (c *Client) AssignIPToLoadBalancer(id, loadBalancerID string) (*SimpleResponse, error) {
+	return c.AssignIP(id, loadBalancerID, IPAssignedToLoadBalancer, c.Region)
+}
+
+// FindInstanceReservedIP returns the reserved IP currently assigned to an instance, looking
+// through every page of reserved IPs
+func (c *Client) FindInstanceReservedIP(instanceID string) (*IP, error) {
+	ips, err := listAllPages[IP](c, "/v2/ips")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ip := range ips {
+		if ip.AssignedTo.Type == IPAssignedToInstance && ip.AssignedTo.ID == instanceID {
+			return &ip, nil
+		}
+	}
+
+	err = fmt.Errorf("unable to find a reserved IP assigned to instance %s, zero matches", instanceID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // UnassignIP unassigns a reserved IP from a Civo resource
 // UnassignIP is an idempotent operation. If you unassign on a unassigned IP, it will return a 200 OK.
 func (c *Client) UnassignIP(id, region string) (*SimpleResponse, error) {
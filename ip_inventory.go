@@ -0,0 +1,135 @@
+package civogo
+
+import (
+	"bytes"
+	"net"
+	"sort"
+)
+
+// Types of resource an IPAddress can belong to
+const (
+	IPAddressOwnerInstance       = "instance"
+	IPAddressOwnerLoadBalancer   = "loadbalancer"
+	IPAddressOwnerKubernetesNode = "kubernetes_node"
+	IPAddressOwnerDatabase       = "database"
+	IPAddressOwnerReservedIP     = "reserved_ip"
+)
+
+// IPAddress is an address in use in the account along with the resource it belongs to. Reserved
+// IPs that are assigned to a resource are listed against that resource with ReservedIPID set,
+// unassigned ones are listed with the reserved IP itself as the owner
+type IPAddress struct {
+	Address      string
+	Public       bool
+	Version      int
+	OwnerType    string
+	OwnerID      string
+	OwnerName    string
+	NetworkID    string
+	ReservedIPID string
+}
+
+// ListIPAddresses returns every public and private IP address of the instances, load balancers,
+// Kubernetes nodes, databases and reserved IPs in the client's region, sorted by address
+func (c *Client) ListIPAddresses() ([]IPAddress, error) {
+	instances, err := c.ListAllInstances()
+	if err != nil {
+		return nil, err
+	}
+	loadBalancers, err := c.ListLoadBalancers()
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := listAllPages[KubernetesCluster](c, "/v2/kubernetes/clusters")
+	if err != nil {
+		return nil, err
+	}
+	databases, err := listAllPages[Database](c, "/v2/databases")
+	if err != nil {
+		return nil, err
+	}
+	reserved, err := listAllPages[IP](c, "/v2/ips")
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &ipInventory{reserved: map[string]string{}, seen: map[string]bool{}}
+	for _, ip := range reserved {
+		if ip.Assigned() {
+			inventory.reserved[ip.IP] = ip.ID
+		}
+	}
+
+	for _, i := range instances {
+		inventory.add(i.PublicIP, true, IPAddressOwnerInstance, i.ID, i.Hostname, i.NetworkID)
+		inventory.add(i.IPv6, true, IPAddressOwnerInstance, i.ID, i.Hostname, i.NetworkID)
+		inventory.add(i.PrivateIP, false, IPAddressOwnerInstance, i.ID, i.Hostname, i.NetworkID)
+	}
+	for _, lb := range loadBalancers {
+		inventory.add(lb.PublicIP, true, IPAddressOwnerLoadBalancer, lb.ID, lb.Name, lb.NetworkID)
+		inventory.add(lb.PrivateIP, false, IPAddressOwnerLoadBalancer, lb.ID, lb.Name, lb.NetworkID)
+	}
+	for _, cluster := range clusters {
+		nodes := append([]KubernetesInstance{}, cluster.Instances...)
+		for _, pool := range cluster.Pools {
+			nodes = append(nodes, pool.Instances...)
+		}
+		for _, node := range nodes {
+			inventory.add(node.PublicIP, true, IPAddressOwnerKubernetesNode, node.ID, node.Hostname, cluster.NetworkID)
+		}
+	}
+	for _, db := range databases {
+		inventory.add(db.PublicIPv4, true, IPAddressOwnerDatabase, db.ID, db.Name, db.NetworkID)
+		inventory.add(db.PrivateIPv4, false, IPAddressOwnerDatabase, db.ID, db.Name, db.NetworkID)
+	}
+	for _, ip := range reserved {
+		if !ip.Assigned() {
+			inventory.add(ip.IP, true, IPAddressOwnerReservedIP, ip.ID, ip.Name, "")
+		}
+	}
+
+	sort.SliceStable(inventory.addresses, func(i, j int) bool {
+		a := net.ParseIP(inventory.addresses[i].Address)
+		b := net.ParseIP(inventory.addresses[j].Address)
+		return bytes.Compare(a.To16(), b.To16()) < 0
+	})
+
+	return inventory.addresses, nil
+}
+
+// ipInventory collects the addresses for ListIPAddresses, skipping duplicates such as nodes
+// listed both on a cluster and on its pools
+type ipInventory struct {
+	addresses []IPAddress
+	reserved  map[string]string
+	seen      map[string]bool
+}
+
+func (inv *ipInventory) add(address string, public bool, ownerType, ownerID, ownerName, networkID string) {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return
+	}
+
+	key := ownerType + "/" + ownerID + "/" + ip.String()
+	if inv.seen[key] {
+		return
+	}
+	inv.seen[key] = true
+
+	version := 6
+	if ip.To4() != nil {
+		version = 4
+	}
+
+	inv.addresses = append(inv.addresses, IPAddress{
+		Address:      ip.String(),
+		Public:       public,
+		Version:      version,
+		OwnerType:    ownerType,
+		OwnerID:      ownerID,
+		OwnerName:    ownerName,
+		NetworkID:    networkID,
+		ReservedIPID: inv.reserved[ip.String()],
+	})
+}
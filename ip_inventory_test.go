@@ -0,0 +1,54 @@
+package civogo
+
+import (
+	"testing"
+)
+
+func TestListIPAddresses(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances": {`{"page": 1, "per_page": 20, "pages": 1, "items": [
+			{"id": "i-1", "hostname": "web", "network_id": "net-1", "public_ip": "74.220.1.10", "private_ip": "192.168.1.10", "ipv6": "2a00:1::10"}
+		]}`},
+		"GET /v2/loadbalancers": {`[{"id": "lb-1", "name": "web-lb", "network_id": "net-1", "public_ip": "74.220.1.20", "private_ip": "192.168.1.20"}]`},
+		"GET /v2/kubernetes/clusters": {`{"page": 1, "per_page": 20, "pages": 1, "items": [
+			{"id": "k-1", "name": "prod", "network_id": "net-2",
+			 "instances": [{"id": "n-1", "hostname": "prod-node-1", "public_ip": "74.220.1.30"}],
+			 "pools": [{"id": "pool-1", "instances": [{"id": "n-1", "hostname": "prod-node-1", "public_ip": "74.220.1.30"}]}]}
+		]}`},
+		"GET /v2/databases": {`{"page": 1, "per_page": 20, "pages": 1, "items": [
+			{"id": "db-1", "name": "orders", "network_id": "net-1", "public_ipv4": "74.220.1.40", "private_ipv4": "192.168.1.40"}
+		]}`},
+		"GET /v2/ips": {
+			`{"page": 1, "per_page": 100, "pages": 2, "items": [{"id": "rip-1", "name": "web-ip", "ip": "74.220.1.10", "assigned_to": {"id": "i-1", "type": "instance", "name": "web"}}]}`,
+			`{"page": 2, "per_page": 100, "pages": 2, "items": [{"id": "rip-2", "name": "spare", "ip": "74.220.1.50"}]}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.ListIPAddresses()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []IPAddress{
+		{Address: "74.220.1.10", Public: true, Version: 4, OwnerType: IPAddressOwnerInstance, OwnerID: "i-1", OwnerName: "web", NetworkID: "net-1", ReservedIPID: "rip-1"},
+		{Address: "74.220.1.20", Public: true, Version: 4, OwnerType: IPAddressOwnerLoadBalancer, OwnerID: "lb-1", OwnerName: "web-lb", NetworkID: "net-1"},
+		{Address: "74.220.1.30", Public: true, Version: 4, OwnerType: IPAddressOwnerKubernetesNode, OwnerID: "n-1", OwnerName: "prod-node-1", NetworkID: "net-2"},
+		{Address: "74.220.1.40", Public: true, Version: 4, OwnerType: IPAddressOwnerDatabase, OwnerID: "db-1", OwnerName: "orders", NetworkID: "net-1"},
+		{Address: "74.220.1.50", Public: true, Version: 4, OwnerType: IPAddressOwnerReservedIP, OwnerID: "rip-2", OwnerName: "spare"},
+		{Address: "192.168.1.10", Version: 4, OwnerType: IPAddressOwnerInstance, OwnerID: "i-1", OwnerName: "web", NetworkID: "net-1"},
+		{Address: "192.168.1.20", Version: 4, OwnerType: IPAddressOwnerLoadBalancer, OwnerID: "lb-1", OwnerName: "web-lb", NetworkID: "net-1"},
+		{Address: "192.168.1.40", Version: 4, OwnerType: IPAddressOwnerDatabase, OwnerID: "db-1", OwnerName: "orders", NetworkID: "net-1"},
+		{Address: "2a00:1::10", Public: true, Version: 6, OwnerType: IPAddressOwnerInstance, OwnerID: "i-1", OwnerName: "web", NetworkID: "net-1"},
+	}
+
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d addresses, got %d: %+v", len(expected), len(got), got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Expected %+v, got %+v", expected[i], got[i])
+		}
+	}
+}
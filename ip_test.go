@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -187,3 +188,101 @@ func TestUnAssignIP(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestAssignIPToInstance(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"action":"assign","assign_to_id":"234567","assign_to_type":"instance","region":"TEST"}`,
+					URL:          "/v2/ips/12345/actions",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.AssignIPToInstance("12345", "234567")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &SimpleResponse{Result: "success"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestFindInstanceReservedIP(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/ips": `{"page": 1, "per_page": 20, "pages": 1, "items": [
+			{"id": "1", "name": "lb-ip", "ip": "127.0.0.1", "assigned_to": {"id": "234567", "type": "loadbalancer", "name": "lb"}},
+			{"id": "2", "name": "web-ip", "ip": "127.0.0.2", "assigned_to": {"id": "234567", "type": "instance", "name": "web"}}
+		]}`,
+	})
+	defer server.Close()
+
+	got, err := client.FindInstanceReservedIP("234567")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "2" {
+		t.Errorf("Expected %s, got %s", "2", got.ID)
+	}
+
+	if _, err := client.FindInstanceReservedIP("999"); !errors.Is(err, ZeroMatchesError) {
+		t.Errorf("Expected ZeroMatchesError, got %v", err)
+	}
+}
+
+func TestFindInstanceReservedIPOnLaterPage(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/ips": {
+			`{"page": 1, "per_page": 100, "pages": 2, "items": [{"id": "1", "name": "lb-ip", "ip": "127.0.0.1", "assigned_to": {"id": "lb-1", "type": "loadbalancer", "name": "lb"}}]}`,
+			`{"page": 2, "per_page": 100, "pages": 2, "items": [{"id": "2", "name": "web-ip", "ip": "127.0.0.2", "assigned_to": {"id": "234567", "type": "instance", "name": "web"}}]}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.FindInstanceReservedIP("234567")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "2" {
+		t.Errorf("Expected %s, got %s", "2", got.ID)
+	}
+}
+
+func TestAssignIPToLoadBalancer(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"action":"assign","assign_to_id":"lb-1","assign_to_type":"loadbalancer","region":"TEST"}`,
+					URL:          "/v2/ips/12345/actions",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.AssignIPToLoadBalancer("12345", "lb-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Result != ResultSuccess {
+		t.Errorf("Expected %s, got %s", ResultSuccess, got.Result)
+	}
+
+	if _, err := client.AssignIP("12345", "db-1", "database", "TEST"); err == nil {
+		t.Errorf("Expected an error for an unknown resource type")
+	}
+}
@@ -0,0 +1,135 @@
+//go:build go1.23
+
+package civogo
+
+import (
+	"context"
+	"iter"
+)
+
+// pagedSeq iterates over every item of a paginated endpoint, fetching the next page only
+// once the items of the current one have been used. Iteration stops after the first error.
+func pagedSeq[T any](ctx context.Context, c *Client, path string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for page := 1; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			current, err := fetchPage[T](c, path, page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range current.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(current.Items) == 0 || page >= current.Pages {
+				return
+			}
+		}
+	}
+}
+
+// listSeq iterates over the result of an endpoint that returns everything in one response
+func listSeq[T any](ctx context.Context, list func() ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if err := ctx.Err(); err != nil {
+			yield(zero, err)
+			return
+		}
+
+		items, err := list()
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		for _, item := range items {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Instances iterates over the instances in the client's region, a page at a time
+func (c *Client) Instances(ctx context.Context) iter.Seq2[Instance, error] {
+	return pagedSeq[Instance](ctx, c, "/v2/instances")
+}
+
+// KubernetesClusters iterates over the Kubernetes clusters in the client's region, a page at a time
+func (c *Client) KubernetesClusters(ctx context.Context) iter.Seq2[KubernetesCluster, error] {
+	return pagedSeq[KubernetesCluster](ctx, c, "/v2/kubernetes/clusters")
+}
+
+// Databases iterates over the databases in the client's region, a page at a time
+func (c *Client) Databases(ctx context.Context) iter.Seq2[Database, error] {
+	return pagedSeq[Database](ctx, c, "/v2/databases")
+}
+
+// ObjectStores iterates over the objectstores in the client's region, a page at a time
+func (c *Client) ObjectStores(ctx context.Context) iter.Seq2[ObjectStore, error] {
+	return pagedSeq[ObjectStore](ctx, c, "/v2/objectstores")
+}
+
+// IPs iterates over the reserved IPs in the client's region, a page at a time
+func (c *Client) IPs(ctx context.Context) iter.Seq2[IP, error] {
+	return pagedSeq[IP](ctx, c, "/v2/ips")
+}
+
+// KfClusters iterates over the Kubeflow clusters in the client's region, a page at a time
+func (c *Client) KfClusters(ctx context.Context) iter.Seq2[KfCluster, error] {
+	return pagedSeq[KfCluster](ctx, c, "/v2/kfclusters")
+}
+
+// Volumes iterates over the volumes in the client's region
+func (c *Client) Volumes(ctx context.Context) iter.Seq2[Volume, error] {
+	return listSeq(ctx, c.ListVolumes)
+}
+
+// Networks iterates over the networks in the client's region
+func (c *Client) Networks(ctx context.Context) iter.Seq2[Network, error] {
+	return listSeq(ctx, c.ListNetworks)
+}
+
+// Firewalls iterates over the firewalls in the client's region
+func (c *Client) Firewalls(ctx context.Context) iter.Seq2[Firewall, error] {
+	return listSeq(ctx, c.ListFirewalls)
+}
+
+// LoadBalancers iterates over the load balancers in the client's region
+func (c *Client) LoadBalancers(ctx context.Context) iter.Seq2[LoadBalancer, error] {
+	return listSeq(ctx, c.ListLoadBalancers)
+}
+
+// SSHKeys iterates over the SSH keys of the account
+func (c *Client) SSHKeys(ctx context.Context) iter.Seq2[SSHKey, error] {
+	return listSeq(ctx, c.ListSSHKeys)
+}
+
+// DiskImages iterates over the disk images available in the client's region
+func (c *Client) DiskImages(ctx context.Context) iter.Seq2[DiskImage, error] {
+	return listSeq(ctx, c.ListDiskImages)
+}
+
+// DNSDomains iterates over the DNS domains of the account
+func (c *Client) DNSDomains(ctx context.Context) iter.Seq2[DNSDomain, error] {
+	return listSeq(ctx, c.ListDNSDomains)
+}
+
+// Webhooks iterates over the webhooks of the account
+func (c *Client) Webhooks(ctx context.Context) iter.Seq2[Webhook, error] {
+	return listSeq(ctx, c.ListWebhooks)
+}
@@ -0,0 +1,79 @@
+//go:build go1.23
+
+package civogo
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestInstancesIterator(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances": {
+			`{"page": 1, "per_page": 2, "pages": 2, "items": [{"id": "i-1"}, {"id": "i-2"}]}`,
+			`{"page": 2, "per_page": 2, "pages": 2, "items": [{"id": "i-3"}]}`,
+		},
+	})
+	defer server.Close()
+
+	var ids []string
+	for instance, err := range client.Instances(context.Background()) {
+		if err != nil {
+			t.Fatalf("Iteration returned an error: %s", err)
+		}
+		ids = append(ids, instance.ID)
+	}
+
+	if len(ids) != 3 || ids[0] != "i-1" || ids[2] != "i-3" {
+		t.Errorf("Expected every instance across both pages, got %v", ids)
+	}
+}
+
+func TestInstancesIteratorBreak(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/instances": {
+			`{"page": 1, "per_page": 2, "pages": 2, "items": [{"id": "i-1"}, {"id": "i-2"}]}`,
+			`{"page": 2, "per_page": 2, "pages": 2, "items": [{"id": "i-3"}]}`,
+		},
+	})
+	defer server.Close()
+
+	for instance := range client.Instances(context.Background()) {
+		if instance.ID == "i-1" {
+			break
+		}
+	}
+
+	// the second page wasn't fetched by the iterator, so it's the next response served
+	page, err := client.ListInstances(2, 2)
+	if err != nil || len(page.Items) != 1 || page.Items[0].ID != "i-3" {
+		t.Errorf("Expected the iterator to stop without fetching the second page, got %+v %v", page, err)
+	}
+}
+
+func TestVolumesIterator(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes": {`[{"id": "vol-1"}, {"id": "vol-2"}]`},
+	})
+	defer server.Close()
+
+	count := 0
+	for _, err := range client.Volumes(context.Background()) {
+		if err != nil {
+			t.Fatalf("Iteration returned an error: %s", err)
+		}
+		count++
+	}
+	if count != 2 {
+		t.Errorf("Expected %d, got %d", 2, count)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, err := range client.Volumes(ctx) {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package civogo
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// GetKubernetesClusterKubeconfig returns the raw kubeconfig of a cluster, it fails with
+// KubernetesClusterNotReadyError if the cluster hasn't been built yet
+func (c *Client) GetKubernetesClusterKubeconfig(id string) ([]byte, error) {
+	cluster, err := c.GetKubernetesCluster(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.KubeConfig == "" {
+		err := fmt.Errorf("cluster %s doesn't have a kubeconfig yet", id)
+		return nil, KubernetesClusterNotReadyError.wrap(err)
+	}
+
+	return []byte(cluster.KubeConfig), nil
+}
+
+// kubeconfigFile is the subset of a kubeconfig file that MergeKubeconfig needs to understand,
+// every other field is kept as is
+type kubeconfigFile struct {
+	APIVersion     string                 `yaml:"apiVersion,omitempty"`
+	Kind           string                 `yaml:"kind,omitempty"`
+	Clusters       []kubeconfigEntry      `yaml:"clusters"`
+	Contexts       []kubeconfigEntry      `yaml:"contexts"`
+	Users          []kubeconfigEntry      `yaml:"users"`
+	CurrentContext string                 `yaml:"current-context"`
+	Extra          map[string]interface{} `yaml:",inline"`
+}
+
+// kubeconfigEntry is a named cluster, context or user in a kubeconfig file
+type kubeconfigEntry struct {
+	Name  string                 `yaml:"name"`
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// MergeKubeconfig merges the clusters, contexts and users of kubeconfig into existing, replacing
+// entries with the same name, and switches the current context to the one from kubeconfig.
+// An empty existing file is treated as a new one.
+func MergeKubeconfig(existing, kubeconfig []byte) ([]byte, error) {
+	base := &kubeconfigFile{}
+	if err := yaml.Unmarshal(existing, base); err != nil {
+		return nil, InvalidKubeconfigError.wrap(fmt.Errorf("existing kubeconfig: %w", err))
+	}
+
+	incoming := &kubeconfigFile{}
+	if err := yaml.Unmarshal(kubeconfig, incoming); err != nil {
+		return nil, InvalidKubeconfigError.wrap(err)
+	}
+	if len(incoming.Clusters) == 0 || len(incoming.Contexts) == 0 || len(incoming.Users) == 0 {
+		err := fmt.Errorf("kubeconfig must contain at least one cluster, context and user")
+		return nil, InvalidKubeconfigError.wrap(err)
+	}
+
+	if base.APIVersion == "" {
+		base.APIVersion = "v1"
+	}
+	if base.Kind == "" {
+		base.Kind = "Config"
+	}
+	base.Clusters = mergeKubeconfigEntries(base.Clusters, incoming.Clusters)
+	base.Contexts = mergeKubeconfigEntries(base.Contexts, incoming.Contexts)
+	base.Users = mergeKubeconfigEntries(base.Users, incoming.Users)
+	if incoming.CurrentContext != "" {
+		base.CurrentContext = incoming.CurrentContext
+	}
+
+	return yaml.Marshal(base)
+}
+
+func mergeKubeconfigEntries(existing, incoming []kubeconfigEntry) []kubeconfigEntry {
+	merged := append([]kubeconfigEntry{}, existing...)
+	for _, entry := range incoming {
+		replaced := false
+		for i := range merged {
+			if merged[i].Name == entry.Name {
+				merged[i] = entry
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, entry)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,123 @@
+package civogo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: my-cluster
+  cluster:
+    server: https://10.0.0.1:6443
+contexts:
+- name: my-cluster
+  context:
+    cluster: my-cluster
+    user: my-cluster
+users:
+- name: my-cluster
+  user:
+    token: new-token
+current-context: my-cluster
+`
+
+func TestGetKubernetesClusterKubeconfig(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters/12345": {`{"id": "12345", "kubeconfig": "apiVersion: v1\n"}`},
+		"GET /v2/kubernetes/clusters/67890": {`{"id": "67890", "status": "BUILDING"}`},
+	})
+	defer server.Close()
+
+	got, err := client.GetKubernetesClusterKubeconfig("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if string(got) != "apiVersion: v1\n" {
+		t.Errorf("Expected %q, got %q", "apiVersion: v1\n", string(got))
+	}
+
+	_, err = client.GetKubernetesClusterKubeconfig("67890")
+	if !errors.Is(err, KubernetesClusterNotReadyError) {
+		t.Errorf("Expected KubernetesClusterNotReadyError, got %v", err)
+	}
+}
+
+func TestMergeKubeconfig(t *testing.T) {
+	existing := `apiVersion: v1
+kind: Config
+preferences: {}
+clusters:
+- name: other
+  cluster:
+    server: https://192.168.0.1:6443
+- name: my-cluster
+  cluster:
+    server: https://10.0.0.99:6443
+contexts:
+- name: other
+  context:
+    cluster: other
+    user: other
+users:
+- name: other
+  user:
+    token: other-token
+- name: my-cluster
+  user:
+    token: old-token
+current-context: other
+`
+
+	got, err := MergeKubeconfig([]byte(existing), []byte(testKubeconfig))
+	if err != nil {
+		t.Errorf("Merge returned an error: %s", err)
+		return
+	}
+
+	merged := &kubeconfigFile{}
+	if err := yaml.Unmarshal(got, merged); err != nil {
+		t.Fatalf("Unable to parse merged kubeconfig: %s", err)
+	}
+
+	if len(merged.Clusters) != 2 || len(merged.Contexts) != 2 || len(merged.Users) != 2 {
+		t.Errorf("Expected 2 clusters, contexts and users, got %d, %d and %d", len(merged.Clusters), len(merged.Contexts), len(merged.Users))
+	}
+	if merged.CurrentContext != "my-cluster" {
+		t.Errorf("Expected %s, got %s", "my-cluster", merged.CurrentContext)
+	}
+	if _, ok := merged.Extra["preferences"]; !ok {
+		t.Errorf("Expected preferences to be kept")
+	}
+	if !strings.Contains(string(got), "https://10.0.0.1:6443") || strings.Contains(string(got), "old-token") {
+		t.Errorf("Expected my-cluster entries to be replaced, got:\n%s", got)
+	}
+}
+
+func TestMergeKubeconfigEmptyExisting(t *testing.T) {
+	got, err := MergeKubeconfig(nil, []byte(testKubeconfig))
+	if err != nil {
+		t.Errorf("Merge returned an error: %s", err)
+		return
+	}
+
+	merged := &kubeconfigFile{}
+	if err := yaml.Unmarshal(got, merged); err != nil {
+		t.Fatalf("Unable to parse merged kubeconfig: %s", err)
+	}
+	if merged.Kind != "Config" || len(merged.Clusters) != 1 {
+		t.Errorf("Expected a single cluster Config, got %+v", merged)
+	}
+}
+
+func TestMergeKubeconfigInvalid(t *testing.T) {
+	_, err := MergeKubeconfig(nil, []byte("apiVersion: v1\n"))
+	if !errors.Is(err, InvalidKubeconfigError) {
+		t.Errorf("Expected InvalidKubeconfigError, got %v", err)
+	}
+}
@@ -34,15 +34,24 @@ type KubernetesInstance struct {
 
 // KubernetesPool represents a single pool within a Kubernetes cluster
 type KubernetesPool struct {
-	ID               string               `json:"id"`
-	Count            int                  `json:"count,omitempty"`
-	Size             string               `json:"size,omitempty"`
-	InstanceNames    []string             `json:"instance_names,omitempty"`
-	Instances        []KubernetesInstance `json:"instances,omitempty"`
-	Labels           map[string]string    `json:"labels,omitempty"`
-	Annotations      map[string]string    `json:"annotations,omitempty"`
-	Taints           []corev1.Taint       `json:"taints,omitempty"`
-	PublicIPNodePool bool                 `json:"public_ip_node_pool,omitempty"`
+	ID               string                    `json:"id"`
+	Count            int                       `json:"count,omitempty"`
+	Size             string                    `json:"size,omitempty"`
+	InstanceNames    []string                  `json:"instance_names,omitempty"`
+	Instances        []KubernetesInstance      `json:"instances,omitempty"`
+	Labels           map[string]string         `json:"labels,omitempty"`
+	Annotations      map[string]string         `json:"annotations,omitempty"`
+	Taints           []corev1.Taint            `json:"taints,omitempty"`
+	PublicIPNodePool bool                      `json:"public_ip_node_pool,omitempty"`
+	Autoscaler       *KubernetesPoolAutoscaler `json:"autoscaler,omitempty"`
+}
+
+// KubernetesPoolAutoscaler is the cluster autoscaler configuration of a pool, a MinNodes of 0
+// lets the autoscaler remove every node of the pool
+type KubernetesPoolAutoscaler struct {
+	Enabled  bool `json:"enabled"`
+	MinNodes int  `json:"min_nodes"`
+	MaxNodes int  `json:"max_nodes,omitempty"`
 }
 
 // KubernetesInstalledApplication is an application within our marketplace available for
@@ -62,6 +71,7 @@ type KubernetesInstalledApplication struct {
 	ImageURL      string                              `json:"image_url,omitempty"`
 	Plan          string                              `json:"plan,omitempty"`
 	Configuration map[string]ApplicationConfiguration `json:"configuration,omitempty"`
+	Status        string                              `json:"status,omitempty"`
 }
 
 // ApplicationConfiguration is a configuration for installed application
@@ -187,13 +197,23 @@ type KubernetesMarketplaceApplication struct {
 	Plans        []KubernetesMarketplacePlan `json:"plans"`
 }
 
+// Types of kubernetes version reported in KubernetesVersion.Type
+const (
+	KubernetesVersionTypeStable     = "stable"
+	KubernetesVersionTypeLegacy     = "legacy"
+	KubernetesVersionTypeDeprecated = "deprecated"
+)
+
 // KubernetesVersion represents an available version of k3s to install
 type KubernetesVersion struct {
 	Label       string `json:"label"`
 	Version     string `json:"version"`
 	Type        string `json:"type"`
 	Default     bool   `json:"default,omitempty"`
+	Deprecated  bool   `json:"deprecated,omitempty"`
 	ClusterType string `json:"clusterType,omitempty"`
+	// UpgradableTo lists the versions a cluster on this version can be upgraded to
+	UpgradableTo []string `json:"upgradable_to,omitempty"`
 }
 
 // ListKubernetesClusters returns all cluster of kubernetes in the account
@@ -248,6 +268,17 @@ func (c *Client) FindKubernetesCluster(search string) (*KubernetesCluster, error
 // NewKubernetesClusters create a new cluster of kubernetes
 func (c *Client) NewKubernetesClusters(kc *KubernetesClusterConfig) (*KubernetesCluster, error) {
 	kc.Region = c.Region
+	if kc.CNIPlugin != "" {
+		if err := checkCNIPlugin(kc.CNIPlugin); err != nil {
+			return nil, err
+		}
+	}
+	if kc.ClusterType != "" {
+		if err := c.checkKubernetesClusterType(kc.ClusterType, kc.KubernetesVersion); err != nil {
+			return nil, err
+		}
+	}
+
 	body, err := c.SendPostRequest("/v2/kubernetes/clusters", kc)
 	if err != nil {
 		return nil, decodeError(err)
@@ -290,6 +321,26 @@ func (c *Client) UpdateKubernetesCluster(id string, i *KubernetesClusterConfig)
 	return kubernetes, nil
 }
 
+// GetKubernetesClusterFirewall returns the firewall, including its rules, used by a kubernetes cluster's nodes
+func (c *Client) GetKubernetesClusterFirewall(id string) (*Firewall, error) {
+	cluster, err := c.GetKubernetesCluster(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.FirewallID == "" {
+		err := fmt.Errorf("kubernetes cluster %s doesn't have a firewall", id)
+		return nil, ZeroMatchesError.wrap(err)
+	}
+
+	return c.GetFirewall(cluster.FirewallID)
+}
+
+// SetKubernetesClusterFirewall changes the firewall used by a kubernetes cluster's nodes
+func (c *Client) SetKubernetesClusterFirewall(id, firewallID string) (*KubernetesCluster, error) {
+	return c.UpdateKubernetesCluster(id, &KubernetesClusterConfig{FirewallID: firewallID})
+}
+
 // ListKubernetesMarketplaceApplications returns all application inside marketplace
 func (c *Client) ListKubernetesMarketplaceApplications() ([]KubernetesMarketplaceApplication, error) {
 	resp, err := c.SendGetRequest("/v2/kubernetes/applications")
@@ -306,7 +357,13 @@ func (c *Client) ListKubernetesMarketplaceApplications() ([]KubernetesMarketplac
 }
 
 // DeleteKubernetesCluster deletes a cluster
+// With ResolveNames set the cluster's name can be used instead of its ID
 func (c *Client) DeleteKubernetesCluster(id string) (*SimpleResponse, error) {
+	id, err := c.resolveExactID(ResourceTypeKubernetesCluster, id)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s", id))
 	if err != nil {
 		return nil, decodeError(err)
@@ -328,8 +385,8 @@ func (c *Client) RecycleKubernetesCluster(id string, hostname string) (*SimpleRe
 	return c.DecodeSimpleResponse(body)
 }
 
-// ListAvailableKubernetesVersions returns all version of kubernetes available
-func (c *Client) ListAvailableKubernetesVersions() ([]KubernetesVersion, error) {
+// ListKubernetesVersions returns all the versions of kubernetes available in the client's region
+func (c *Client) ListKubernetesVersions() ([]KubernetesVersion, error) {
 	resp, err := c.SendGetRequest("/v2/kubernetes/versions")
 	if err != nil {
 		return nil, decodeError(err)
@@ -340,9 +397,40 @@ func (c *Client) ListAvailableKubernetesVersions() ([]KubernetesVersion, error)
 		return nil, err
 	}
 
+	for i := range kubernetes {
+		if kubernetes[i].Type == KubernetesVersionTypeDeprecated {
+			kubernetes[i].Deprecated = true
+		}
+	}
+
 	return kubernetes, nil
 }
 
+// ListAvailableKubernetesVersions returns all version of kubernetes available
+//
+// Deprecated: use ListKubernetesVersions instead
+func (c *Client) ListAvailableKubernetesVersions() ([]KubernetesVersion, error) {
+	return c.ListKubernetesVersions()
+}
+
+// GetDefaultKubernetesVersion returns the version of kubernetes new clusters are built with
+// when KubernetesClusterConfig.KubernetesVersion is left empty
+func (c *Client) GetDefaultKubernetesVersion() (*KubernetesVersion, error) {
+	versions, err := c.ListKubernetesVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, version := range versions {
+		if version.Default {
+			return &version, nil
+		}
+	}
+
+	err = fmt.Errorf("unable to find the default kubernetes version, zero matches")
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // ListKubernetesClusterInstances returns all cluster instances
 func (c *Client) ListKubernetesClusterInstances(id string) ([]Instance, error) {
 	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/instances", id))
@@ -0,0 +1,80 @@
+package civogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InstalledApplication returns the installed application with the given name, or nil if
+// the application isn't installed on the cluster
+func (k *KubernetesCluster) InstalledApplication(name string) *KubernetesInstalledApplication {
+	for i, app := range k.InstalledApplications {
+		if strings.EqualFold(app.Name, name) || strings.EqualFold(app.Application, name) {
+			return &k.InstalledApplications[i]
+		}
+	}
+	return nil
+}
+
+// InstallKubernetesClusterApplication installs a marketplace application on a cluster, plan
+// is the label of one of the application's plans and can be empty if it doesn't have any
+func (c *Client) InstallKubernetesClusterApplication(clusterID, app, plan string) (*KubernetesCluster, error) {
+	apps, err := c.ListKubernetesMarketplaceApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := marketplaceApplicationWithPlan(apps, app, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.UpdateKubernetesCluster(clusterID, &KubernetesClusterConfig{Applications: application})
+}
+
+// RemoveKubernetesClusterApplication uninstalls a marketplace application from a cluster
+func (c *Client) RemoveKubernetesClusterApplication(clusterID, app string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/applications/%s", clusterID, app))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
+// marketplaceApplicationWithPlan returns the "name:plan" string the API expects to install an application
+func marketplaceApplicationWithPlan(apps []KubernetesMarketplaceApplication, name, plan string) (string, error) {
+	for _, app := range apps {
+		if !strings.EqualFold(app.Name, name) {
+			continue
+		}
+
+		if plan == "" {
+			if len(app.Plans) > 0 {
+				err := fmt.Errorf("application %s requires one of the plans %s", app.Name, marketplacePlanLabels(app.Plans))
+				return "", DatabaseKubernetesApplicationInvalidPlanError.wrap(err)
+			}
+			return app.Name, nil
+		}
+
+		for _, p := range app.Plans {
+			if strings.EqualFold(p.Label, plan) {
+				return app.Name + ":" + p.Label, nil
+			}
+		}
+
+		err := fmt.Errorf("application %s has no plan %s, must be one of %s", app.Name, plan, marketplacePlanLabels(app.Plans))
+		return "", DatabaseKubernetesApplicationInvalidPlanError.wrap(err)
+	}
+
+	err := fmt.Errorf("unable to find application %s in the marketplace", name)
+	return "", DatabaseKubernetesApplicationNotFoundError.wrap(err)
+}
+
+func marketplacePlanLabels(plans []KubernetesMarketplacePlan) string {
+	labels := make([]string, 0, len(plans))
+	for _, p := range plans {
+		labels = append(labels, p.Label)
+	}
+	return strings.Join(labels, ", ")
+}
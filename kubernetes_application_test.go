@@ -0,0 +1,83 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+)
+
+const testMarketplaceApplications = `[
+	{"name": "Traefik", "version": "2.9", "maintainer": "@Rancher_Labs", "description": "Ingress", "category": "architecture"},
+	{"name": "MariaDB", "version": "10.4.7", "dependencies": ["Longhorn"], "maintainer": "hello@civo.com", "description": "MySQL fork", "category": "database", "plans": [
+		{"label": "5GB", "configuration": {"VOLUME_SIZE": {"value": "5Gi"}}},
+		{"label": "10GB", "configuration": {"VOLUME_SIZE": {"value": "10Gi"}}}
+	]}
+]`
+
+func TestInstallKubernetesClusterApplication(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/applications":   {testMarketplaceApplications},
+		"PUT /v2/kubernetes/clusters/12345": {`{"id": "12345", "installed_applications": [{"application": "MariaDB", "name": "MariaDB", "plan": "10GB", "status": "installing"}]}`},
+	})
+	defer server.Close()
+
+	got, err := client.InstallKubernetesClusterApplication("12345", "mariadb", "10gb")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	app := got.InstalledApplication("MariaDB")
+	if app == nil || app.Status != "installing" {
+		t.Errorf("Expected MariaDB to be installing, got %+v", app)
+	}
+}
+
+func TestInstallKubernetesClusterApplicationInvalid(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/applications": {testMarketplaceApplications},
+	})
+	defer server.Close()
+
+	tests := []struct {
+		app, plan string
+		expected  error
+	}{
+		{"Unknown", "", DatabaseKubernetesApplicationNotFoundError},
+		{"MariaDB", "", DatabaseKubernetesApplicationInvalidPlanError},
+		{"MariaDB", "1TB", DatabaseKubernetesApplicationInvalidPlanError},
+	}
+
+	for _, test := range tests {
+		_, err := client.InstallKubernetesClusterApplication("12345", test.app, test.plan)
+		if !errors.Is(err, test.expected) {
+			t.Errorf("Expected %s for %s:%s, got %v", test.expected, test.app, test.plan, err)
+		}
+	}
+}
+
+func TestMarketplaceApplicationWithPlan(t *testing.T) {
+	apps := []KubernetesMarketplaceApplication{
+		{Name: "Traefik"},
+		{Name: "MariaDB", Plans: []KubernetesMarketplacePlan{{Label: "5GB"}}},
+	}
+
+	got, err := marketplaceApplicationWithPlan(apps, "traefik", "")
+	if err != nil || got != "Traefik" {
+		t.Errorf("Expected Traefik, got %q (%v)", got, err)
+	}
+
+	got, err = marketplaceApplicationWithPlan(apps, "MariaDB", "5gb")
+	if err != nil || got != "MariaDB:5GB" {
+		t.Errorf("Expected MariaDB:5GB, got %q (%v)", got, err)
+	}
+}
+
+func TestRemoveKubernetesClusterApplication(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"DELETE /v2/kubernetes/clusters/12345/applications/Traefik": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	got, err := client.RemoveKubernetesClusterApplication("12345", "Traefik")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
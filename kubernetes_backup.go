@@ -0,0 +1,103 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// KubernetesBackupSchedule is how often a recurring cluster backup is taken
+type KubernetesBackupSchedule string
+
+// Schedules a cluster backup can be taken on, an empty schedule takes a single backup
+const (
+	KubernetesBackupScheduleHourly KubernetesBackupSchedule = "hourly"
+	KubernetesBackupScheduleDaily  KubernetesBackupSchedule = "daily"
+	KubernetesBackupScheduleWeekly KubernetesBackupSchedule = "weekly"
+)
+
+// KubernetesClusterBackup is a backup of a cluster's resources and persistent volumes
+type KubernetesClusterBackup struct {
+	ID            string                   `json:"id"`
+	ClusterID     string                   `json:"cluster_id"`
+	Name          string                   `json:"name"`
+	Status        string                   `json:"status"`
+	Schedule      KubernetesBackupSchedule `json:"schedule,omitempty"`
+	RetentionDays int                      `json:"retention_days,omitempty"`
+	CreatedAt     time.Time                `json:"created_at,omitempty"`
+	ExpiresAt     time.Time                `json:"expires_at,omitempty"`
+}
+
+// KubernetesClusterBackupConfig is the configuration for creating a new KubernetesClusterBackup,
+// backups are kept until they're deleted unless RetentionDays is set
+type KubernetesClusterBackupConfig struct {
+	Name          string                   `json:"name"`
+	Schedule      KubernetesBackupSchedule `json:"schedule,omitempty"`
+	RetentionDays int                      `json:"retention_days,omitempty"`
+	Region        string                   `json:"region"`
+}
+
+// Validate checks the schedule and retention of a KubernetesClusterBackupConfig
+func (b *KubernetesClusterBackupConfig) Validate() error {
+	switch b.Schedule {
+	case "", KubernetesBackupScheduleHourly, KubernetesBackupScheduleDaily, KubernetesBackupScheduleWeekly:
+	default:
+		err := fmt.Errorf("unknown schedule %q, must be one of %s, %s or %s", b.Schedule, KubernetesBackupScheduleHourly, KubernetesBackupScheduleDaily, KubernetesBackupScheduleWeekly)
+		return InvalidKubernetesBackupError.wrap(err)
+	}
+
+	if b.RetentionDays < 0 {
+		err := fmt.Errorf("retention must be a positive number of days, got %d", b.RetentionDays)
+		return InvalidKubernetesBackupError.wrap(err)
+	}
+
+	return nil
+}
+
+// CreateKubernetesClusterBackup takes a backup of a cluster, or sets up recurring backups if a schedule is given
+func (c *Client) CreateKubernetesClusterBackup(clusterID string, config *KubernetesClusterBackupConfig) (*KubernetesClusterBackup, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	config.Region = c.Region
+	body, err := c.SendPostRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/backups", clusterID), config)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var result = &KubernetesClusterBackup{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListKubernetesClusterBackups returns all backups of a cluster
+func (c *Client) ListKubernetesClusterBackups(clusterID string) ([]KubernetesClusterBackup, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/backups", clusterID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var backups = make([]KubernetesClusterBackup, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&backups); err != nil {
+		return nil, err
+	}
+
+	return backups, nil
+}
+
+// RestoreKubernetesClusterBackup restores a cluster's resources and persistent volumes from one of its backups
+func (c *Client) RestoreKubernetesClusterBackup(clusterID, backupID string) (*SimpleResponse, error) {
+	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/backups/%s/restore", clusterID, backupID), map[string]string{
+		"region": c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
@@ -0,0 +1,100 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateKubernetesClusterBackup(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"nightly","schedule":"daily","retention_days":7,"region":"TEST"}`,
+					URL:          "/v2/kubernetes/clusters/12345/backups",
+					ResponseBody: `{"id": "b-1", "cluster_id": "12345", "name": "nightly", "status": "scheduled", "schedule": "daily", "retention_days": 7}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateKubernetesClusterBackup("12345", &KubernetesClusterBackupConfig{
+		Name:          "nightly",
+		Schedule:      KubernetesBackupScheduleDaily,
+		RetentionDays: 7,
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &KubernetesClusterBackup{ID: "b-1", ClusterID: "12345", Name: "nightly", Status: "scheduled", Schedule: KubernetesBackupScheduleDaily, RetentionDays: 7}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestKubernetesClusterBackupConfigValidate(t *testing.T) {
+	tests := []KubernetesClusterBackupConfig{
+		{Name: "bad-schedule", Schedule: "monthly"},
+		{Name: "bad-retention", RetentionDays: -1},
+	}
+
+	for _, config := range tests {
+		if err := config.Validate(); !errors.Is(err, InvalidKubernetesBackupError) {
+			t.Errorf("Expected InvalidKubernetesBackupError for %s, got %v", config.Name, err)
+		}
+	}
+
+	config := KubernetesClusterBackupConfig{Name: "once"}
+	if err := config.Validate(); err != nil {
+		t.Errorf("Expected a one-off backup to be valid, got %v", err)
+	}
+}
+
+func TestListKubernetesClusterBackups(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/kubernetes/clusters/12345/backups": `[{"id": "b-1", "cluster_id": "12345", "name": "nightly", "status": "completed", "created_at": "2023-01-02T15:04:05Z"}]`,
+	})
+	defer server.Close()
+
+	got, err := client.ListKubernetesClusterBackups("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []KubernetesClusterBackup{{ID: "b-1", ClusterID: "12345", Name: "nightly", Status: "completed", CreatedAt: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestRestoreKubernetesClusterBackup(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST"}`,
+					URL:          "/v2/kubernetes/clusters/12345/backups/b-1/restore",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.RestoreKubernetesClusterBackup("12345", "b-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Result != ResultSuccess {
+		t.Errorf("Expected %s, got %s", ResultSuccess, got.Result)
+	}
+}
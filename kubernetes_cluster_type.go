@@ -0,0 +1,90 @@
+package civogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kubernetes distributions a cluster can be built with, set in KubernetesClusterConfig.ClusterType
+const (
+	KubernetesClusterTypeK3s   = "k3s"
+	KubernetesClusterTypeTalos = "talos"
+)
+
+// CNI plugins a cluster can be built with, set in KubernetesClusterConfig.CNIPlugin
+const (
+	CNIPluginFlannel = "flannel"
+	CNIPluginCilium  = "cilium"
+)
+
+// ListKubernetesClusterTypes returns the cluster types available in the client's region,
+// based on the kubernetes versions offered for each of them
+func (c *Client) ListKubernetesClusterTypes() ([]string, error) {
+	versions, err := c.ListKubernetesVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	types := []string{}
+	seen := map[string]bool{}
+	for _, version := range versions {
+		clusterType := kubernetesVersionClusterType(version)
+		if !seen[clusterType] {
+			seen[clusterType] = true
+			types = append(types, clusterType)
+		}
+	}
+
+	return types, nil
+}
+
+// checkKubernetesClusterType makes sure a cluster type is offered in the client's region
+// and, if a version is given, that the version belongs to that cluster type
+func (c *Client) checkKubernetesClusterType(clusterType, version string) error {
+	if clusterType != KubernetesClusterTypeK3s && clusterType != KubernetesClusterTypeTalos {
+		err := fmt.Errorf("unknown cluster type %q, must be one of %s or %s", clusterType, KubernetesClusterTypeK3s, KubernetesClusterTypeTalos)
+		return KubernetesClusterInvalidTypeError.wrap(err)
+	}
+
+	versions, err := c.ListKubernetesVersions()
+	if err != nil {
+		return err
+	}
+
+	available := false
+	for _, v := range versions {
+		if kubernetesVersionClusterType(v) != clusterType {
+			continue
+		}
+		available = true
+		if version == "" || v.Version == version {
+			return nil
+		}
+	}
+
+	if !available {
+		err := fmt.Errorf("cluster type %s is not available in region %s", clusterType, c.Region)
+		return KubernetesClusterInvalidTypeError.wrap(err)
+	}
+
+	err = fmt.Errorf("kubernetes version %s is not available for cluster type %s", version, clusterType)
+	return KubernetesClusterInvalidVersionError.wrap(err)
+}
+
+// kubernetesVersionClusterType returns the cluster type of a version, versions that don't
+// report one predate Talos support and are k3s
+func kubernetesVersionClusterType(version KubernetesVersion) string {
+	if version.ClusterType == "" {
+		return KubernetesClusterTypeK3s
+	}
+	return strings.ToLower(version.ClusterType)
+}
+
+// checkCNIPlugin makes sure a CNI plugin is one of the ones supported by the API
+func checkCNIPlugin(plugin string) error {
+	if plugin != CNIPluginFlannel && plugin != CNIPluginCilium {
+		err := fmt.Errorf("unknown CNI plugin %q, must be one of %s or %s", plugin, CNIPluginFlannel, CNIPluginCilium)
+		return KubernetesClusterInvalidCNIPluginError.wrap(err)
+	}
+	return nil
+}
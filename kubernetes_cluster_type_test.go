@@ -0,0 +1,98 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const testKubernetesVersions = `[
+	{"version": "1.28.7-k3s1", "type": "stable", "default": true, "clusterType": "k3s"},
+	{"version": "1.27.1-k3s1", "type": "stable"},
+	{"version": "talos-v1.5.0", "type": "stable", "clusterType": "talos"}
+]`
+
+func TestListKubernetesClusterTypes(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/versions": {testKubernetesVersions},
+	})
+	defer server.Close()
+
+	got, err := client.ListKubernetesClusterTypes()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []string{KubernetesClusterTypeK3s, KubernetesClusterTypeTalos}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestNewKubernetesClustersTalos(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/versions":  {testKubernetesVersions},
+		"POST /v2/kubernetes/clusters": {`{"id": "12345", "name": "talos-cluster", "cluster_type": "talos"}`},
+	})
+	defer server.Close()
+
+	got, err := client.NewKubernetesClusters(&KubernetesClusterConfig{
+		Name:              "talos-cluster",
+		ClusterType:       KubernetesClusterTypeTalos,
+		KubernetesVersion: "talos-v1.5.0",
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.ClusterType != KubernetesClusterTypeTalos {
+		t.Errorf("Expected %s, got %s", KubernetesClusterTypeTalos, got.ClusterType)
+	}
+}
+
+func TestNewKubernetesClustersInvalidType(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/versions": {`[{"version": "1.28.7-k3s1", "type": "stable"}]`},
+	})
+	defer server.Close()
+
+	tests := []struct {
+		config   KubernetesClusterConfig
+		expected error
+	}{
+		{KubernetesClusterConfig{Name: "a", ClusterType: "rke2"}, KubernetesClusterInvalidTypeError},
+		{KubernetesClusterConfig{Name: "b", ClusterType: KubernetesClusterTypeTalos}, KubernetesClusterInvalidTypeError},
+		{KubernetesClusterConfig{Name: "c", ClusterType: KubernetesClusterTypeK3s, KubernetesVersion: "talos-v1.5.0"}, KubernetesClusterInvalidVersionError},
+	}
+
+	for _, test := range tests {
+		config := test.config
+		_, err := client.NewKubernetesClusters(&config)
+		if !errors.Is(err, test.expected) {
+			t.Errorf("Expected %s for %s, got %v", test.expected, config.Name, err)
+		}
+	}
+}
+
+func TestNewKubernetesClustersCNIPlugin(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"POST /v2/kubernetes/clusters": {`{"id": "12345", "name": "cilium-cluster", "cni_plugin": "cilium"}`},
+	})
+	defer server.Close()
+
+	got, err := client.NewKubernetesClusters(&KubernetesClusterConfig{Name: "cilium-cluster", CNIPlugin: CNIPluginCilium})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.CNIPlugin != CNIPluginCilium {
+		t.Errorf("Expected %s, got %s", CNIPluginCilium, got.CNIPlugin)
+	}
+
+	_, err = client.NewKubernetesClusters(&KubernetesClusterConfig{Name: "calico-cluster", CNIPlugin: "calico"})
+	if !errors.Is(err, KubernetesClusterInvalidCNIPluginError) {
+		t.Errorf("Expected KubernetesClusterInvalidCNIPluginError, got %v", err)
+	}
+}
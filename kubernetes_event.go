@@ -0,0 +1,38 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// KubernetesClusterEvent is a provisioning or scaling event recorded against a cluster
+type KubernetesClusterEvent struct {
+	ID        string    `json:"id"`
+	ClusterID string    `json:"cluster_id"`
+	Category  string    `json:"category"`
+	Level     string    `json:"level"`
+	Reason    string    `json:"reason,omitempty"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListKubernetesClusterEvents returns the events recorded for a cluster, oldest first
+func (c *Client) ListKubernetesClusterEvents(id string) ([]KubernetesClusterEvent, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/events", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	events := make([]KubernetesClusterEvent, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&events); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+	return events, nil
+}
@@ -0,0 +1,43 @@
+package civogo
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListKubernetesClusterEvents(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/kubernetes/clusters/12345/events": `[
+			{"id": "2", "cluster_id": "12345", "category": "provisioning", "level": "error", "reason": "OutOfCapacity", "message": "Unable to create node", "created_at": "2023-01-02T15:06:05Z"},
+			{"id": "1", "cluster_id": "12345", "category": "provisioning", "level": "info", "message": "Creating control plane", "created_at": "2023-01-02T15:04:05Z"}
+		]`,
+	})
+	defer server.Close()
+
+	got, err := client.ListKubernetesClusterEvents("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []KubernetesClusterEvent{{
+		ID:        "1",
+		ClusterID: "12345",
+		Category:  "provisioning",
+		Level:     "info",
+		Message:   "Creating control plane",
+		CreatedAt: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+	}, {
+		ID:        "2",
+		ClusterID: "12345",
+		Category:  "provisioning",
+		Level:     "error",
+		Reason:    "OutOfCapacity",
+		Message:   "Unable to create node",
+		CreatedAt: time.Date(2023, 1, 2, 15, 6, 5, 0, time.UTC),
+	}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
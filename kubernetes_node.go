@@ -0,0 +1,63 @@
+package civogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RecycleKubernetesNode replaces a single node of a cluster with a fresh one. The node is
+// cordoned and drained by the API before it is deleted.
+func (c *Client) RecycleKubernetesNode(clusterID, nodeName string) (*SimpleResponse, error) {
+	return c.RecycleKubernetesCluster(clusterID, nodeName)
+}
+
+// DrainAndRecycleKubernetesNode recycles a node and waits until it has been removed from the
+// cluster and its replacement is ACTIVE and the cluster is ready again, returning the new node
+func (c *Client) DrainAndRecycleKubernetesNode(clusterID, nodeName string, opts WaitOptions) (*KubernetesInstance, error) {
+	cluster, err := c.GetKubernetesCluster(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[string]bool{}
+	found := false
+	for _, instance := range cluster.Instances {
+		known[instance.ID] = true
+		if strings.EqualFold(instance.Hostname, nodeName) {
+			found = true
+		}
+	}
+	if !found {
+		err := fmt.Errorf("unable to find node %s in cluster %s", nodeName, clusterID)
+		return nil, DatabaseKubernetesNodeNotFoundError.wrap(err)
+	}
+
+	if _, err := c.RecycleKubernetesNode(clusterID, nodeName); err != nil {
+		return nil, err
+	}
+
+	var replacement *KubernetesInstance
+	err = waitFor(opts, func() (bool, error) {
+		cluster, err := c.GetKubernetesCluster(clusterID)
+		if err != nil {
+			return false, err
+		}
+
+		replacement = nil
+		for i, instance := range cluster.Instances {
+			if strings.EqualFold(instance.Hostname, nodeName) && known[instance.ID] {
+				return false, nil
+			}
+			if !known[instance.ID] {
+				replacement = &cluster.Instances[i]
+			}
+		}
+
+		return replacement != nil && kubernetesClusterSettled(cluster), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return replacement, nil
+}
@@ -0,0 +1,42 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDrainAndRecycleKubernetesNode(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters/12345": {
+			`{"id": "12345", "status": "ACTIVE", "ready": true, "instances": [{"id": "a", "hostname": "node-a", "status": "ACTIVE"}, {"id": "b", "hostname": "node-b", "status": "ACTIVE"}]}`,
+			`{"id": "12345", "status": "ACTIVE", "ready": true, "instances": [{"id": "a", "hostname": "node-a", "status": "ACTIVE"}, {"id": "b", "hostname": "node-b", "status": "DELETING"}]}`,
+			`{"id": "12345", "status": "SCALING", "ready": false, "instances": [{"id": "a", "hostname": "node-a", "status": "ACTIVE"}, {"id": "c", "hostname": "node-c", "status": "BUILDING"}]}`,
+			`{"id": "12345", "status": "ACTIVE", "ready": true, "instances": [{"id": "a", "hostname": "node-a", "status": "ACTIVE"}, {"id": "c", "hostname": "node-c", "status": "ACTIVE"}]}`,
+		},
+		"POST /v2/kubernetes/clusters/12345/recycle": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	got, err := client.DrainAndRecycleKubernetesNode("12345", "node-b", WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.ID != "c" {
+		t.Errorf("Expected %s, got %s", "c", got.ID)
+	}
+}
+
+func TestDrainAndRecycleKubernetesNodeNotFound(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters/12345": {`{"id": "12345", "status": "ACTIVE", "ready": true, "instances": [{"id": "a", "hostname": "node-a", "status": "ACTIVE"}]}`},
+	})
+	defer server.Close()
+
+	_, err := client.DrainAndRecycleKubernetesNode("12345", "node-z", WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if !errors.Is(err, DatabaseKubernetesNodeNotFoundError) {
+		t.Errorf("Expected DatabaseKubernetesNodeNotFoundError, got %v", err)
+	}
+}
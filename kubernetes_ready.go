@@ -0,0 +1,74 @@
+package civogo
+
+// KubernetesPoolReadiness is the node count of a pool compared to the count it was configured with
+type KubernetesPoolReadiness struct {
+	ID       string
+	Expected int
+	Ready    int
+}
+
+// KubernetesClusterReadiness is the state of each condition WaitForKubernetesClusterReady checks
+type KubernetesClusterReadiness struct {
+	Cluster *KubernetesCluster
+	// Active is true once the cluster's status is ACTIVE
+	Active bool
+	// ControlPlaneUp is true once the cluster has an API endpoint
+	ControlPlaneUp bool
+	// NodesJoined is true once every pool has the expected number of ACTIVE nodes
+	NodesJoined bool
+	// DNSReady is true once the cluster's DNS entry has been created
+	DNSReady bool
+	Pools    []KubernetesPoolReadiness
+}
+
+// Ready returns true if every condition is met
+func (r *KubernetesClusterReadiness) Ready() bool {
+	return r.Active && r.ControlPlaneUp && r.NodesJoined && r.DNSReady
+}
+
+// WaitForKubernetesClusterReady polls a cluster until it's ACTIVE, its control plane and DNS are
+// up and every pool has its expected number of nodes. The readiness from the last poll is returned
+// along with any error, so callers can tell which condition wasn't met when the wait times out.
+func (c *Client) WaitForKubernetesClusterReady(id string, opts WaitOptions) (*KubernetesClusterReadiness, error) {
+	var readiness *KubernetesClusterReadiness
+	err := waitFor(opts, func() (bool, error) {
+		cluster, err := c.GetKubernetesCluster(id)
+		if err != nil {
+			return false, err
+		}
+
+		readiness = kubernetesClusterReadiness(cluster)
+		return readiness.Ready(), nil
+	})
+
+	return readiness, err
+}
+
+func kubernetesClusterReadiness(cluster *KubernetesCluster) *KubernetesClusterReadiness {
+	readiness := &KubernetesClusterReadiness{
+		Cluster:        cluster,
+		Active:         cluster.Status == KubernetesClusterStatusActive,
+		ControlPlaneUp: cluster.APIEndPoint != "",
+		NodesJoined:    true,
+		DNSReady:       cluster.DNSEntry != "",
+	}
+
+	for _, pool := range cluster.Pools {
+		ready := len(pool.InstanceNames)
+		if len(pool.Instances) > 0 {
+			ready = 0
+			for _, instance := range pool.Instances {
+				if instance.Status == KubernetesClusterStatusActive {
+					ready++
+				}
+			}
+		}
+
+		readiness.Pools = append(readiness.Pools, KubernetesPoolReadiness{ID: pool.ID, Expected: pool.Count, Ready: ready})
+		if ready < pool.Count {
+			readiness.NodesJoined = false
+		}
+	}
+
+	return readiness
+}
@@ -0,0 +1,49 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForKubernetesClusterReady(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters/12345": {
+			`{"id": "12345", "status": "BUILDING", "pools": [{"id": "pool-1", "count": 2}]}`,
+			`{"id": "12345", "status": "ACTIVE", "api_endpoint": "https://10.0.0.1:6443", "dns_entry": "12345.k8s.civo.com", "pools": [{"id": "pool-1", "count": 2, "instances": [{"id": "a", "status": "ACTIVE"}, {"id": "b", "status": "BUILDING"}]}]}`,
+			`{"id": "12345", "status": "ACTIVE", "api_endpoint": "https://10.0.0.1:6443", "dns_entry": "12345.k8s.civo.com", "pools": [{"id": "pool-1", "count": 2, "instances": [{"id": "a", "status": "ACTIVE"}, {"id": "b", "status": "ACTIVE"}]}]}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.WaitForKubernetesClusterReady("12345", WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if !got.Ready() || got.Pools[0].Ready != 2 {
+		t.Errorf("Expected the cluster to be ready with 2 nodes, got %+v", got)
+	}
+}
+
+func TestWaitForKubernetesClusterReadyTimeout(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters/12345": {
+			`{"id": "12345", "status": "ACTIVE", "api_endpoint": "https://10.0.0.1:6443", "pools": [{"id": "pool-1", "count": 3, "instance_names": ["a", "b"]}]}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.WaitForKubernetesClusterReady("12345", WaitOptions{Timeout: 5 * time.Millisecond, Interval: time.Millisecond})
+	if !errors.Is(err, TimeoutError) {
+		t.Errorf("Expected TimeoutError, got %v", err)
+	}
+
+	if got == nil || !got.Active || !got.ControlPlaneUp || got.DNSReady || got.NodesJoined {
+		t.Errorf("Expected only DNS and nodes to be pending, got %+v", got)
+	}
+	if got != nil && (got.Pools[0].Expected != 3 || got.Pools[0].Ready != 2) {
+		t.Errorf("Expected 2 of 3 nodes, got %+v", got.Pools[0])
+	}
+}
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -654,3 +655,103 @@ func TestListAvailableKubernetesVersions(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestListKubernetesVersions(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/kubernetes/versions": `[
+		  {
+			"version": "1.28.7-k3s1",
+			"type": "stable",
+			"default": true
+		  },
+		  {
+			"version": "1.22.2-k3s1",
+			"type": "deprecated"
+		  }
+		]`,
+	})
+	defer server.Close()
+	got, err := client.ListKubernetesVersions()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []KubernetesVersion{{
+		Default: true,
+		Type:    "stable",
+		Version: "1.28.7-k3s1",
+	}, {
+		Type:       "deprecated",
+		Version:    "1.22.2-k3s1",
+		Deprecated: true,
+	}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestGetDefaultKubernetesVersion(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/kubernetes/versions": `[{"version": "1.27.1-k3s1", "type": "stable"}, {"version": "1.28.7-k3s1", "type": "stable", "default": true}]`,
+	})
+	defer server.Close()
+	got, err := client.GetDefaultKubernetesVersion()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Version != "1.28.7-k3s1" {
+		t.Errorf("Expected %s, got %s", "1.28.7-k3s1", got.Version)
+	}
+}
+
+func TestGetKubernetesClusterFirewall(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters/12345": {`{"id": "12345", "name": "cluster-name", "firewall_id": "67890"}`},
+		"GET /v2/kubernetes/clusters/54321": {`{"id": "54321", "name": "no-firewall"}`},
+		"GET /v2/firewalls/67890":           {`{"id": "67890", "name": "k8s-firewall", "rules": [{"id": "1", "firewall_id": "67890", "protocol": "tcp", "start_port": "6443", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow"}]}`},
+	})
+	defer server.Close()
+
+	got, err := client.GetKubernetesClusterFirewall("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.ID != "67890" || len(got.Rules) != 1 {
+		t.Errorf("Expected firewall 67890 with 1 rule, got %+v", got)
+	}
+
+	if _, err := client.GetKubernetesClusterFirewall("54321"); !errors.Is(err, ZeroMatchesError) {
+		t.Errorf("Expected ZeroMatchesError, got %v", err)
+	}
+}
+
+func TestSetKubernetesClusterFirewall(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","firewall_id":"67890"}`,
+					URL:          "/v2/kubernetes/clusters/12345",
+					ResponseBody: `{"id": "12345", "name": "cluster-name", "firewall_id": "67890"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.SetKubernetesClusterFirewall("12345", "67890")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.FirewallID != "67890" {
+		t.Errorf("Expected %s, got %s", "67890", got.FirewallID)
+	}
+}
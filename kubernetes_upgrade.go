@@ -0,0 +1,104 @@
+package civogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KubernetesClusterStatusActive is the status of a cluster that isn't being built or changed
+const KubernetesClusterStatusActive = "ACTIVE"
+
+// CanUpgradeTo returns true if a cluster on this version can be upgraded to the given version
+func (v *KubernetesVersion) CanUpgradeTo(version string) bool {
+	for _, target := range v.UpgradableTo {
+		if target == version {
+			return true
+		}
+	}
+	return false
+}
+
+// UpgradeKubernetesCluster upgrades a cluster to the given kubernetes version, checking first
+// that the version is available and is a valid upgrade from the cluster's current version
+func (c *Client) UpgradeKubernetesCluster(id, version string) (*KubernetesCluster, error) {
+	cluster, err := c.GetKubernetesCluster(id)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := c.ListKubernetesVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkKubernetesUpgrade(versions, cluster.KubernetesVersion, version); err != nil {
+		return nil, err
+	}
+
+	return c.UpdateKubernetesCluster(id, &KubernetesClusterConfig{KubernetesVersion: version})
+}
+
+// WaitForKubernetesClusterUpgrade polls a cluster until it reports the given kubernetes version,
+// is ready and every node has been recycled back to ACTIVE
+func (c *Client) WaitForKubernetesClusterUpgrade(id, version string, opts WaitOptions) (*KubernetesCluster, error) {
+	var cluster *KubernetesCluster
+	err := waitFor(opts, func() (bool, error) {
+		var err error
+		cluster, err = c.GetKubernetesCluster(id)
+		if err != nil {
+			return false, err
+		}
+
+		return cluster.KubernetesVersion == version && kubernetesClusterSettled(cluster), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// kubernetesClusterSettled returns true if the cluster and all of its nodes are ACTIVE and ready
+func kubernetesClusterSettled(cluster *KubernetesCluster) bool {
+	if !cluster.Ready || !strings.EqualFold(cluster.Status, KubernetesClusterStatusActive) {
+		return false
+	}
+
+	for _, instance := range cluster.Instances {
+		if !strings.EqualFold(instance.Status, KubernetesClusterStatusActive) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkKubernetesUpgrade returns an error unless target is an available version the cluster can
+// be upgraded to from its current version
+func checkKubernetesUpgrade(versions []KubernetesVersion, current, target string) error {
+	if current == target {
+		err := fmt.Errorf("the cluster is already running kubernetes version %s", target)
+		return KubernetesClusterAlreadyAtVersionError.wrap(err)
+	}
+
+	var from, to *KubernetesVersion
+	for i := range versions {
+		switch versions[i].Version {
+		case current:
+			from = &versions[i]
+		case target:
+			to = &versions[i]
+		}
+	}
+
+	if to == nil {
+		err := fmt.Errorf("kubernetes version %s is not available", target)
+		return KubernetesClusterInvalidVersionError.wrap(err)
+	}
+
+	if from != nil && len(from.UpgradableTo) > 0 && !from.CanUpgradeTo(target) {
+		err := fmt.Errorf("unable to upgrade from %s to %s, valid upgrades are %s", current, target, strings.Join(from.UpgradableTo, ", "))
+		return KubernetesClusterInvalidVersionError.wrap(err)
+	}
+
+	return nil
+}
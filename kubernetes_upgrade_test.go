@@ -0,0 +1,81 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUpgradeKubernetesCluster(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters/12345": {`{"id": "12345", "name": "cluster-name", "kubernetes_version": "1.27.1-k3s1"}`},
+		"GET /v2/kubernetes/versions": {`[
+			{"version": "1.27.1-k3s1", "type": "stable", "upgradable_to": ["1.28.2-k3s1"]},
+			{"version": "1.28.2-k3s1", "type": "stable", "default": true}
+		]`},
+		"PUT /v2/kubernetes/clusters/12345": {`{"id": "12345", "name": "cluster-name", "kubernetes_version": "1.27.1-k3s1", "status": "UPGRADING"}`},
+	})
+	defer server.Close()
+
+	got, err := client.UpgradeKubernetesCluster("12345", "1.28.2-k3s1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.ID != "12345" {
+		t.Errorf("Expected %s, got %s", "12345", got.ID)
+	}
+}
+
+func TestUpgradeKubernetesClusterInvalidVersion(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters/12345": {`{"id": "12345", "name": "cluster-name", "kubernetes_version": "1.27.1-k3s1"}`},
+		"GET /v2/kubernetes/versions": {`[
+			{"version": "1.26.4-k3s1", "type": "deprecated"},
+			{"version": "1.27.1-k3s1", "type": "stable", "upgradable_to": ["1.28.2-k3s1"]},
+			{"version": "1.28.2-k3s1", "type": "stable", "default": true}
+		]`},
+	})
+	defer server.Close()
+
+	for _, version := range []string{"1.26.4-k3s1", "1.99.0-k3s1"} {
+		_, err := client.UpgradeKubernetesCluster("12345", version)
+		if !errors.Is(err, KubernetesClusterInvalidVersionError) {
+			t.Errorf("Expected KubernetesClusterInvalidVersionError for %s, got %v", version, err)
+		}
+	}
+}
+
+func TestUpgradeKubernetesClusterAlreadyAtVersion(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters/12345": {`{"id": "12345", "name": "cluster-name", "kubernetes_version": "1.28.2-k3s1"}`},
+		"GET /v2/kubernetes/versions":       {`[{"version": "1.28.2-k3s1", "type": "stable", "default": true}]`},
+	})
+	defer server.Close()
+
+	if _, err := client.UpgradeKubernetesCluster("12345", "1.28.2-k3s1"); !errors.Is(err, KubernetesClusterAlreadyAtVersionError) {
+		t.Errorf("Expected KubernetesClusterAlreadyAtVersionError, got %v", err)
+	}
+}
+
+func TestWaitForKubernetesClusterUpgrade(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters/12345": {
+			`{"id": "12345", "kubernetes_version": "1.27.1-k3s1", "status": "UPGRADING", "ready": false}`,
+			`{"id": "12345", "kubernetes_version": "1.28.2-k3s1", "status": "ACTIVE", "ready": true, "instances": [{"id": "a", "status": "ACTIVE"}, {"id": "b", "status": "BUILDING"}]}`,
+			`{"id": "12345", "kubernetes_version": "1.28.2-k3s1", "status": "ACTIVE", "ready": true, "instances": [{"id": "a", "status": "ACTIVE"}, {"id": "b", "status": "ACTIVE"}]}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.WaitForKubernetesClusterUpgrade("12345", "1.28.2-k3s1", WaitOptions{Timeout: time.Second, Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Instances[1].Status != KubernetesClusterStatusActive {
+		t.Errorf("Expected %s, got %s", KubernetesClusterStatusActive, got.Instances[1].Status)
+	}
+}
@@ -7,19 +7,46 @@ import (
 	"strings"
 )
 
-// HealthCheck represents the health check configuration for an instance pool.
+// Load balancer algorithms supported by Civo
+const (
+	LoadBalancerAlgorithmRoundRobin       = "round_robin"
+	LoadBalancerAlgorithmLeastConnections = "least_connections"
+)
+
+// Load balancer external traffic policies, matching the Kubernetes service field of the same name
+const (
+	LoadBalancerExternalTrafficPolicyCluster = "Cluster"
+	LoadBalancerExternalTrafficPolicyLocal   = "Local"
+)
+
+// Load balancer session affinity settings, matching the Kubernetes service field of the same name
+const (
+	LoadBalancerSessionAffinityNone     = "None"
+	LoadBalancerSessionAffinityClientIP = "ClientIP"
+)
+
+// HealthCheck represents the health check configuration for an instance pool or backend.
+// Interval and Timeout are in seconds, the thresholds are the number of consecutive checks
+// needed to mark a backend healthy or unhealthy
 type HealthCheck struct {
-	Port int32  `json:"port"`
-	Path string `json:"path"`
+	Port               int32  `json:"port"`
+	Path               string `json:"path"`
+	Interval           int32  `json:"interval,omitempty"`
+	Timeout            int32  `json:"timeout,omitempty"`
+	HealthyThreshold   int32  `json:"healthy_threshold,omitempty"`
+	UnhealthyThreshold int32  `json:"unhealthy_threshold,omitempty"`
 }
 
 // LoadBalancerBackend represents a backend instance being load-balanced
 type LoadBalancerBackend struct {
-	IP              string `json:"ip"`
-	Protocol        string `json:"protocol,omitempty"`
-	SourcePort      int32  `json:"source_port"`
-	TargetPort      int32  `json:"target_port"`
-	HealthCheckPort int32  `json:"health_check_port,omitempty"`
+	InstanceID       string       `json:"instance_id,omitempty"`
+	IP               string       `json:"ip"`
+	Protocol         string       `json:"protocol,omitempty"`
+	SourcePort       int32        `json:"source_port"`
+	TargetPort       int32        `json:"target_port"`
+	HealthCheckPort  int32        `json:"health_check_port,omitempty"`
+	HealthCheck      *HealthCheck `json:"health_check,omitempty"`
+	TLSCertificateID string       `json:"tls_certificate_id,omitempty"`
 }
 
 // InstancePool represents an instance pool configuration in a load balancer.
@@ -32,13 +59,17 @@ type InstancePool struct {
 	HealthCheck HealthCheck `json:"health_check"`
 }
 
-// LoadBalancerBackendConfig is the configuration for creating backends
+// LoadBalancerBackendConfig is the configuration for creating backends, either
+// by IP or by the ID of an instance
 type LoadBalancerBackendConfig struct {
-	IP              string `json:"ip"`
-	Protocol        string `json:"protocol,omitempty"`
-	SourcePort      int32  `json:"source_port"`
-	TargetPort      int32  `json:"target_port"`
-	HealthCheckPort int32  `json:"health_check_port,omitempty"`
+	InstanceID       string       `json:"instance_id,omitempty"`
+	IP               string       `json:"ip"`
+	Protocol         string       `json:"protocol,omitempty"`
+	SourcePort       int32        `json:"source_port"`
+	TargetPort       int32        `json:"target_port"`
+	HealthCheckPort  int32        `json:"health_check_port,omitempty"`
+	HealthCheck      *HealthCheck `json:"health_check,omitempty"`
+	TLSCertificateID string       `json:"tls_certificate_id,omitempty"`
 }
 
 // LoadBalancerInstancePoolConfig represents an instance pool configuration in a load balancer.
@@ -64,6 +95,7 @@ type LoadBalancer struct {
 	SessionAffinity              string                `json:"session_affinity,omitempty"`
 	SessionAffinityConfigTimeout int32                 `json:"session_affinity_config_timeout,omitempty"`
 	EnableProxyProtocol          string                `json:"enable_proxy_protocol,omitempty"`
+	RedirectHTTPToHTTPS          bool                  `json:"redirect_http_to_https,omitempty"`
 	PublicIP                     string                `json:"public_ip"`
 	PrivateIP                    string                `json:"private_ip"`
 	FirewallID                   string                `json:"firewall_id"`
@@ -89,6 +121,7 @@ type LoadBalancerConfig struct {
 	SessionAffinity              string                           `json:"session_affinity,omitempty"`
 	SessionAffinityConfigTimeout int32                            `json:"session_affinity_config_timeout,omitempty"`
 	EnableProxyProtocol          string                           `json:"enable_proxy_protocol,omitempty"`
+	RedirectHTTPToHTTPS          bool                             `json:"redirect_http_to_https,omitempty"`
 	ClusterID                    string                           `json:"cluster_id,omitempty"`
 	FirewallID                   string                           `json:"firewall_id,omitempty"`
 	FirewallRules                string                           `json:"firewall_rule,omitempty"`
@@ -114,11 +147,120 @@ type LoadBalancerUpdateConfig struct {
 	SessionAffinity              string                           `json:"session_affinity,omitempty"`
 	SessionAffinityConfigTimeout int32                            `json:"session_affinity_config_timeout,omitempty"`
 	EnableProxyProtocol          string                           `json:"enable_proxy_protocol,omitempty"`
+	RedirectHTTPToHTTPS          *bool                            `json:"redirect_http_to_https,omitempty"`
 	FirewallID                   string                           `json:"firewall_id,omitempty"`
 	MaxConcurrentRequests        *int                             `json:"max_concurrent_requests,omitempty"`
 	LoadBalancerOptions          *LoadBalancerOptions             `json:"options,omitempty"`
 }
 
+// Validate checks the algorithm, external traffic policy and session affinity of the
+// load balancer before it's sent to the API
+func (r *LoadBalancerConfig) Validate() error {
+	if err := validateLoadBalancerSettings(r.Algorithm, r.ExternalTrafficPolicy, r.SessionAffinity, r.SessionAffinityConfigTimeout); err != nil {
+		return err
+	}
+
+	if err := validateLoadBalancerBackends(r.Backends, r.InstancePools); err != nil {
+		return err
+	}
+
+	return validateLoadBalancerTLS(r.Backends, r.RedirectHTTPToHTTPS)
+}
+
+// Validate checks the algorithm, external traffic policy and session affinity of the
+// load balancer before it's sent to the API
+func (r *LoadBalancerUpdateConfig) Validate() error {
+	if err := validateLoadBalancerSettings(r.Algorithm, r.ExternalTrafficPolicy, r.SessionAffinity, r.SessionAffinityConfigTimeout); err != nil {
+		return err
+	}
+
+	if err := validateLoadBalancerBackends(r.Backends, r.InstancePools); err != nil {
+		return err
+	}
+
+	// without backends the update leaves them as they are, so there's nothing to check a redirect against
+	redirect := r.RedirectHTTPToHTTPS != nil && *r.RedirectHTTPToHTTPS && len(r.Backends) > 0
+	return validateLoadBalancerTLS(r.Backends, redirect)
+}
+
+// Validate checks the health check's timings and thresholds are consistent
+func (h *HealthCheck) Validate() error {
+	if h.Port < 0 || h.Port > 65535 {
+		err := fmt.Errorf("invalid health check port %d", h.Port)
+		return InvalidLoadBalancerConfigError.wrap(err)
+	}
+	if h.Interval < 0 || h.Timeout < 0 || h.HealthyThreshold < 0 || h.UnhealthyThreshold < 0 {
+		err := fmt.Errorf("health check interval, timeout and thresholds can't be negative")
+		return InvalidLoadBalancerConfigError.wrap(err)
+	}
+	if h.Interval > 0 && h.Timeout > h.Interval {
+		err := fmt.Errorf("health check timeout %ds is longer than the interval %ds", h.Timeout, h.Interval)
+		return InvalidLoadBalancerConfigError.wrap(err)
+	}
+	if h.Path != "" && !strings.HasPrefix(h.Path, "/") {
+		err := fmt.Errorf("health check path %q must start with /", h.Path)
+		return InvalidLoadBalancerConfigError.wrap(err)
+	}
+
+	return nil
+}
+
+func validateLoadBalancerBackends(backends []LoadBalancerBackendConfig, pools []LoadBalancerInstancePoolConfig) error {
+	for _, backend := range backends {
+		if backend.IP == "" && backend.InstanceID == "" {
+			err := fmt.Errorf("a backend needs either an IP or an instance ID")
+			return InvalidLoadBalancerConfigError.wrap(err)
+		}
+		if backend.HealthCheck != nil {
+			if err := backend.HealthCheck.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, pool := range pools {
+		if err := pool.HealthCheck.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateLoadBalancerSettings(algorithm, externalTrafficPolicy, sessionAffinity string, sessionAffinityTimeout int32) error {
+	switch algorithm {
+	case "", LoadBalancerAlgorithmRoundRobin, LoadBalancerAlgorithmLeastConnections:
+	default:
+		err := fmt.Errorf("invalid algorithm %q, valid algorithms are %s and %s", algorithm, LoadBalancerAlgorithmRoundRobin, LoadBalancerAlgorithmLeastConnections)
+		return InvalidLoadBalancerConfigError.wrap(err)
+	}
+
+	switch externalTrafficPolicy {
+	case "", LoadBalancerExternalTrafficPolicyCluster, LoadBalancerExternalTrafficPolicyLocal:
+	default:
+		err := fmt.Errorf("invalid external traffic policy %q, valid policies are %s and %s", externalTrafficPolicy, LoadBalancerExternalTrafficPolicyCluster, LoadBalancerExternalTrafficPolicyLocal)
+		return InvalidLoadBalancerConfigError.wrap(err)
+	}
+
+	switch sessionAffinity {
+	case "", LoadBalancerSessionAffinityNone, LoadBalancerSessionAffinityClientIP:
+	default:
+		err := fmt.Errorf("invalid session affinity %q, valid values are %s and %s", sessionAffinity, LoadBalancerSessionAffinityNone, LoadBalancerSessionAffinityClientIP)
+		return InvalidLoadBalancerConfigError.wrap(err)
+	}
+
+	if sessionAffinityTimeout < 0 {
+		err := fmt.Errorf("the session affinity timeout can't be negative")
+		return InvalidLoadBalancerConfigError.wrap(err)
+	}
+	if sessionAffinityTimeout > 0 && sessionAffinity != LoadBalancerSessionAffinityClientIP {
+		err := fmt.Errorf("a session affinity timeout can only be set with %s session affinity", LoadBalancerSessionAffinityClientIP)
+		return InvalidLoadBalancerConfigError.wrap(err)
+	}
+
+	return nil
+}
+
 // ListLoadBalancers returns all load balancers owned by the calling API account
 func (c *Client) ListLoadBalancers() ([]LoadBalancer, error) {
 	resp, err := c.SendGetRequest("/v2/loadbalancers")
@@ -185,6 +327,14 @@ func (c *Client) FindLoadBalancer(search string) (*LoadBalancer, error) {
 
 // CreateLoadBalancer creates a new load balancer
 func (c *Client) CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	if r.Region == "" {
+		r.Region = c.Region
+	}
+
 	body, err := c.SendPostRequest("/v2/loadbalancers", r)
 	if err != nil {
 		return nil, decodeError(err)
@@ -200,6 +350,14 @@ func (c *Client) CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, error
 
 // UpdateLoadBalancer updates a load balancer
 func (c *Client) UpdateLoadBalancer(id string, r *LoadBalancerUpdateConfig) (*LoadBalancer, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	if r.Region == "" {
+		r.Region = c.Region
+	}
+
 	body, err := c.SendPutRequest(fmt.Sprintf("/v2/loadbalancers/%s", id), r)
 	if err != nil {
 		return nil, decodeError(err)
@@ -214,7 +372,13 @@ func (c *Client) UpdateLoadBalancer(id string, r *LoadBalancerUpdateConfig) (*Lo
 }
 
 // DeleteLoadBalancer deletes a load balancer
+// With ResolveNames set the load balancer's name can be used instead of its ID
 func (c *Client) DeleteLoadBalancer(id string) (*SimpleResponse, error) {
+	id, err := c.resolveExactID(ResourceTypeLoadBalancer, id)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/loadbalancers/%s", id))
 	if err != nil {
 		return nil, decodeError(err)
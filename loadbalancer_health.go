@@ -0,0 +1,67 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Health states reported for a load balancer backend
+const (
+	LoadBalancerBackendHealthy   = "healthy"
+	LoadBalancerBackendUnhealthy = "unhealthy"
+	LoadBalancerBackendUnknown   = "unknown"
+)
+
+// LoadBalancerBackendHealth is the result of the most recent health checks against a backend
+type LoadBalancerBackendHealth struct {
+	InstanceID string `json:"instance_id,omitempty"`
+	IP         string `json:"ip"`
+	SourcePort int32  `json:"source_port"`
+	TargetPort int32  `json:"target_port"`
+	State      string `json:"state"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// LoadBalancerHealth is the health of each backend of a load balancer
+type LoadBalancerHealth struct {
+	ID       string                      `json:"id"`
+	State    string                      `json:"state"`
+	Backends []LoadBalancerBackendHealth `json:"backends"`
+}
+
+// Healthy returns true if every backend of the load balancer is passing its health checks
+func (h *LoadBalancerHealth) Healthy() bool {
+	for _, backend := range h.Backends {
+		if backend.State != LoadBalancerBackendHealthy {
+			return false
+		}
+	}
+	return true
+}
+
+// UnhealthyBackends returns the backends that aren't passing their health checks
+func (h *LoadBalancerHealth) UnhealthyBackends() []LoadBalancerBackendHealth {
+	unhealthy := make([]LoadBalancerBackendHealth, 0)
+	for _, backend := range h.Backends {
+		if backend.State != LoadBalancerBackendHealthy {
+			unhealthy = append(unhealthy, backend)
+		}
+	}
+	return unhealthy
+}
+
+// GetLoadBalancerHealth returns the health state of each backend of a load balancer
+func (c *Client) GetLoadBalancerHealth(id string) (*LoadBalancerHealth, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/loadbalancers/%s/health", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	health := &LoadBalancerHealth{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(health); err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
@@ -0,0 +1,82 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetLoadBalancerHealth(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/loadbalancers/12345/health": `{
+			"id": "12345",
+			"state": "available",
+			"backends": [
+				{"instance_id": "i-1", "ip": "192.168.1.3", "source_port": 80, "target_port": 31579, "state": "healthy"},
+				{"ip": "192.168.1.4", "source_port": 80, "target_port": 31579, "state": "unhealthy", "reason": "connection refused"}
+			]
+		}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetLoadBalancerHealth("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.ID != "12345" || len(got.Backends) != 2 {
+		t.Fatalf("Unexpected health %+v", got)
+	}
+	if got.Backends[0].InstanceID != "i-1" || got.Backends[0].State != LoadBalancerBackendHealthy {
+		t.Errorf("Unexpected first backend %+v", got.Backends[0])
+	}
+	if got.Healthy() {
+		t.Errorf("Expected the load balancer to be unhealthy")
+	}
+
+	unhealthy := got.UnhealthyBackends()
+	if len(unhealthy) != 1 || unhealthy[0].IP != "192.168.1.4" || unhealthy[0].Reason != "connection refused" {
+		t.Errorf("Unexpected unhealthy backends %+v", unhealthy)
+	}
+}
+
+func TestHealthCheckValidate(t *testing.T) {
+	valid := []HealthCheck{
+		{},
+		{Port: 8080, Path: "/healthz", Interval: 10, Timeout: 5, HealthyThreshold: 2, UnhealthyThreshold: 3},
+	}
+	for _, hc := range valid {
+		if err := hc.Validate(); err != nil {
+			t.Errorf("Expected %+v to be valid, got %s", hc, err)
+		}
+	}
+
+	invalid := []HealthCheck{
+		{Port: 70000},
+		{Path: "healthz"},
+		{Interval: 5, Timeout: 10},
+		{UnhealthyThreshold: -1},
+	}
+	for _, hc := range invalid {
+		if err := hc.Validate(); !errors.Is(err, InvalidLoadBalancerConfigError) {
+			t.Errorf("Expected %+v to be invalid, got %v", hc, err)
+		}
+	}
+}
+
+func TestLoadBalancerConfigValidateBackends(t *testing.T) {
+	config := &LoadBalancerConfig{
+		Name: "lb",
+		Backends: []LoadBalancerBackendConfig{
+			{InstanceID: "i-1", SourcePort: 80, TargetPort: 8080, HealthCheck: &HealthCheck{Path: "/healthz", Interval: 10, Timeout: 2}},
+		},
+	}
+	if err := config.Validate(); err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+
+	config.Backends = append(config.Backends, LoadBalancerBackendConfig{SourcePort: 80, TargetPort: 8080})
+	if err := config.Validate(); !errors.Is(err, InvalidLoadBalancerConfigError) {
+		t.Errorf("Expected InvalidLoadBalancerConfigError, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -298,6 +299,53 @@ func TestGetLoadBalancer(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestLoadBalancerConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  LoadBalancerConfig
+		wantErr bool
+	}{
+		{"defaults", LoadBalancerConfig{Name: "lb"}, false},
+		{"all settings", LoadBalancerConfig{
+			Name:                         "lb",
+			Algorithm:                    LoadBalancerAlgorithmLeastConnections,
+			ExternalTrafficPolicy:        LoadBalancerExternalTrafficPolicyLocal,
+			SessionAffinity:              LoadBalancerSessionAffinityClientIP,
+			SessionAffinityConfigTimeout: 10800,
+		}, false},
+		{"unknown algorithm", LoadBalancerConfig{Name: "lb", Algorithm: "random"}, true},
+		{"unknown traffic policy", LoadBalancerConfig{Name: "lb", ExternalTrafficPolicy: "local"}, true},
+		{"unknown session affinity", LoadBalancerConfig{Name: "lb", SessionAffinity: "Cookie"}, true},
+		{"timeout without affinity", LoadBalancerConfig{Name: "lb", SessionAffinityConfigTimeout: 60}, true},
+		{"negative timeout", LoadBalancerConfig{Name: "lb", SessionAffinity: LoadBalancerSessionAffinityClientIP, SessionAffinityConfigTimeout: -1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr && !errors.Is(err, InvalidLoadBalancerConfigError) {
+				t.Errorf("Expected InvalidLoadBalancerConfigError, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Expected no error, got %s", err)
+			}
+		})
+	}
+}
+
+func TestCreateLoadBalancerInvalidConfig(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/loadbalancers": `{"id": "56dca3ae-ea3f-480f-9b25-abf90b439729"}`,
+	})
+	defer server.Close()
+
+	_, err := client.CreateLoadBalancer(&LoadBalancerConfig{Name: "lb", Algorithm: "random"})
+	if !errors.Is(err, InvalidLoadBalancerConfigError) {
+		t.Errorf("Expected InvalidLoadBalancerConfigError, got %v", err)
+	}
+}
+
 func TestDeleteLoadBalancer(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/loadbalancers/12345": `{"result": "success"}`,
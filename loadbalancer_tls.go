@@ -0,0 +1,191 @@
+package civogo
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Protocols a load balancer backend can listen on
+const (
+	LoadBalancerProtocolTCP   = "TCP"
+	LoadBalancerProtocolHTTP  = "HTTP"
+	LoadBalancerProtocolHTTPS = "HTTPS"
+)
+
+// LoadBalancerCertificate is a TLS certificate that load balancers can use to terminate HTTPS
+type LoadBalancerCertificate struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Domains   []string  `json:"domains,omitempty"`
+	NotAfter  time.Time `json:"not_after,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// LoadBalancerCertificateConfig is used to upload a PEM encoded certificate and its private key
+type LoadBalancerCertificateConfig struct {
+	Name        string `json:"name"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"private_key"`
+	Region      string `json:"region"`
+}
+
+// Validate checks the certificate and private key are valid PEM and belong together
+func (c *LoadBalancerCertificateConfig) Validate() error {
+	if c.Name == "" {
+		err := fmt.Errorf("the certificate name is empty")
+		return InvalidLoadBalancerCertificateError.wrap(err)
+	}
+
+	pair, err := tls.X509KeyPair([]byte(c.Certificate), []byte(c.PrivateKey))
+	if err != nil {
+		return InvalidLoadBalancerCertificateError.wrap(err)
+	}
+
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return InvalidLoadBalancerCertificateError.wrap(err)
+	}
+	if time.Now().After(leaf.NotAfter) {
+		err := fmt.Errorf("the certificate expired on %s", leaf.NotAfter.Format(time.RFC3339))
+		return InvalidLoadBalancerCertificateError.wrap(err)
+	}
+
+	return nil
+}
+
+// ListLoadBalancerCertificates returns all the TLS certificates uploaded for load balancers
+func (c *Client) ListLoadBalancerCertificates() ([]LoadBalancerCertificate, error) {
+	resp, err := c.SendGetRequest("/v2/certificates")
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	certificates := make([]LoadBalancerCertificate, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&certificates); err != nil {
+		return nil, err
+	}
+
+	return certificates, nil
+}
+
+// UploadLoadBalancerCertificate uploads a PEM encoded certificate and private key, the returned
+// certificate ID can then be referenced by HTTPS backends
+func (c *Client) UploadLoadBalancerCertificate(r *LoadBalancerCertificateConfig) (*LoadBalancerCertificate, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	if r.Region == "" {
+		r.Region = c.Region
+	}
+
+	resp, err := c.SendPostRequest("/v2/certificates", r)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	certificate := &LoadBalancerCertificate{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(certificate); err != nil {
+		return nil, err
+	}
+
+	return certificate, nil
+}
+
+// DeleteLoadBalancerCertificate deletes an uploaded certificate
+func (c *Client) DeleteLoadBalancerCertificate(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/certificates/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
+// SetLoadBalancerCertificate terminates TLS with the given certificate on every backend of the
+// load balancer listening on the source port, switching those backends to HTTPS
+func (c *Client) SetLoadBalancerCertificate(id string, port int32, certificateID string) (*LoadBalancer, error) {
+	lb, err := c.GetLoadBalancer(id)
+	if err != nil {
+		return nil, err
+	}
+
+	update := loadBalancerUpdateConfigFrom(lb)
+	found := false
+	for i := range update.Backends {
+		if update.Backends[i].SourcePort == port {
+			update.Backends[i].Protocol = LoadBalancerProtocolHTTPS
+			update.Backends[i].TLSCertificateID = certificateID
+			found = true
+		}
+	}
+	if !found {
+		err := fmt.Errorf("load balancer %s has no backends on port %d", id, port)
+		return nil, InvalidLoadBalancerConfigError.wrap(err)
+	}
+
+	return c.UpdateLoadBalancer(id, update)
+}
+
+// loadBalancerUpdateConfigFrom returns an update config that keeps the load balancer as it is
+func loadBalancerUpdateConfigFrom(lb *LoadBalancer) *LoadBalancerUpdateConfig {
+	redirect := lb.RedirectHTTPToHTTPS
+	update := &LoadBalancerUpdateConfig{
+		Name:                         lb.Name,
+		ServiceName:                  lb.ServiceName,
+		Algorithm:                    lb.Algorithm,
+		ExternalTrafficPolicy:        lb.ExternalTrafficPolicy,
+		SessionAffinity:              lb.SessionAffinity,
+		SessionAffinityConfigTimeout: lb.SessionAffinityConfigTimeout,
+		EnableProxyProtocol:          lb.EnableProxyProtocol,
+		FirewallID:                   lb.FirewallID,
+		RedirectHTTPToHTTPS:          &redirect,
+		LoadBalancerOptions:          lb.Options,
+	}
+
+	for _, b := range lb.Backends {
+		update.Backends = append(update.Backends, LoadBalancerBackendConfig{
+			InstanceID:       b.InstanceID,
+			IP:               b.IP,
+			Protocol:         b.Protocol,
+			SourcePort:       b.SourcePort,
+			TargetPort:       b.TargetPort,
+			HealthCheckPort:  b.HealthCheckPort,
+			HealthCheck:      b.HealthCheck,
+			TLSCertificateID: b.TLSCertificateID,
+		})
+	}
+
+	return update
+}
+
+// validateLoadBalancerTLS checks HTTPS backends reference a certificate and that redirecting
+// HTTP to HTTPS is only enabled when there's an HTTPS backend to redirect to
+func validateLoadBalancerTLS(backends []LoadBalancerBackendConfig, redirectHTTPToHTTPS bool) error {
+	https := false
+	for _, backend := range backends {
+		switch {
+		case strings.EqualFold(backend.Protocol, LoadBalancerProtocolHTTPS):
+			if backend.TLSCertificateID == "" {
+				err := fmt.Errorf("the HTTPS backend on port %d has no TLS certificate", backend.SourcePort)
+				return InvalidLoadBalancerConfigError.wrap(err)
+			}
+			https = true
+		case backend.TLSCertificateID != "":
+			err := fmt.Errorf("the backend on port %d has a TLS certificate but uses %s", backend.SourcePort, backend.Protocol)
+			return InvalidLoadBalancerConfigError.wrap(err)
+		}
+	}
+
+	if redirectHTTPToHTTPS && !https {
+		err := fmt.Errorf("redirecting HTTP to HTTPS needs at least one HTTPS backend")
+		return InvalidLoadBalancerConfigError.wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package civogo
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// testCertificate returns a self-signed PEM certificate and key valid until notAfter
+func testCertificate(t *testing.T, notAfter time.Time) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    notAfter.Add(-48 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	priv := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(cert), string(priv)
+}
+
+func TestLoadBalancerCertificateConfigValidate(t *testing.T) {
+	cert, key := testCertificate(t, time.Now().Add(24*time.Hour))
+	expiredCert, expiredKey := testCertificate(t, time.Now().Add(-time.Hour))
+	_, otherKey := testCertificate(t, time.Now().Add(24*time.Hour))
+
+	config := &LoadBalancerCertificateConfig{Name: "example", Certificate: cert, PrivateKey: key}
+	if err := config.Validate(); err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+
+	invalid := []*LoadBalancerCertificateConfig{
+		{Certificate: cert, PrivateKey: key},
+		{Name: "example", Certificate: "not a certificate", PrivateKey: key},
+		{Name: "example", Certificate: cert, PrivateKey: otherKey},
+		{Name: "example", Certificate: expiredCert, PrivateKey: expiredKey},
+	}
+	for _, config := range invalid {
+		if err := config.Validate(); !errors.Is(err, InvalidLoadBalancerCertificateError) {
+			t.Errorf("Expected InvalidLoadBalancerCertificateError, got %v", err)
+		}
+	}
+}
+
+func TestUploadLoadBalancerCertificate(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/certificates": `{"id": "cert-1", "name": "example", "domains": ["example.com"]}`,
+	})
+	defer server.Close()
+
+	cert, key := testCertificate(t, time.Now().Add(24*time.Hour))
+	got, err := client.UploadLoadBalancerCertificate(&LoadBalancerCertificateConfig{Name: "example", Certificate: cert, PrivateKey: key})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.ID != "cert-1" || len(got.Domains) != 1 || got.Domains[0] != "example.com" {
+		t.Errorf("Unexpected certificate %+v", got)
+	}
+}
+
+func TestSetLoadBalancerCertificate(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/loadbalancers/lb-1": {`{
+			"id": "lb-1",
+			"name": "web",
+			"algorithm": "round_robin",
+			"backends": [
+				{"ip": "192.168.1.3", "protocol": "HTTP", "source_port": 80, "target_port": 8080},
+				{"ip": "192.168.1.3", "protocol": "HTTP", "source_port": 443, "target_port": 8080}
+			]
+		}`},
+		"PUT /v2/loadbalancers/lb-1": {`{
+			"id": "lb-1",
+			"name": "web",
+			"algorithm": "round_robin",
+			"backends": [
+				{"ip": "192.168.1.3", "protocol": "HTTP", "source_port": 80, "target_port": 8080},
+				{"ip": "192.168.1.3", "protocol": "HTTPS", "source_port": 443, "target_port": 8080, "tls_certificate_id": "cert-1"}
+			]
+		}`},
+	})
+	defer server.Close()
+
+	got, err := client.SetLoadBalancerCertificate("lb-1", 443, "cert-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Backends[1].TLSCertificateID != "cert-1" || got.Backends[1].Protocol != LoadBalancerProtocolHTTPS {
+		t.Errorf("Unexpected backend %+v", got.Backends[1])
+	}
+
+	if _, err := client.SetLoadBalancerCertificate("lb-1", 8443, "cert-1"); !errors.Is(err, InvalidLoadBalancerConfigError) {
+		t.Errorf("Expected InvalidLoadBalancerConfigError, got %v", err)
+	}
+}
+
+func TestLoadBalancerConfigValidateTLS(t *testing.T) {
+	https := LoadBalancerBackendConfig{IP: "192.168.1.3", Protocol: LoadBalancerProtocolHTTPS, SourcePort: 443, TargetPort: 8080, TLSCertificateID: "cert-1"}
+	http := LoadBalancerBackendConfig{IP: "192.168.1.3", Protocol: LoadBalancerProtocolHTTP, SourcePort: 80, TargetPort: 8080}
+
+	valid := &LoadBalancerConfig{Backends: []LoadBalancerBackendConfig{http, https}, RedirectHTTPToHTTPS: true}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+
+	noCert := https
+	noCert.TLSCertificateID = ""
+	certOnHTTP := http
+	certOnHTTP.TLSCertificateID = "cert-1"
+
+	invalid := []*LoadBalancerConfig{
+		{Backends: []LoadBalancerBackendConfig{noCert}},
+		{Backends: []LoadBalancerBackendConfig{certOnHTTP}},
+		{Backends: []LoadBalancerBackendConfig{http}, RedirectHTTPToHTTPS: true},
+	}
+	for _, config := range invalid {
+		if err := config.Validate(); !errors.Is(err, InvalidLoadBalancerConfigError) {
+			t.Errorf("Expected InvalidLoadBalancerConfigError, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Package netutil contains helpers for working with the IP addresses and CIDR
+// ranges used in Civo firewall rules
+package netutil
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// NormalizeCIDR returns the canonical form of a CIDR range. Bare IP addresses are
+// treated as single hosts (/32 for IPv4 and /128 for IPv6) and host bits are
+// cleared, so "10.0.0.5/8" becomes "10.0.0.0/8".
+func NormalizeCIDR(cidr string) (string, error) {
+	value := strings.TrimSpace(cidr)
+	if value == "" {
+		return "", fmt.Errorf("empty CIDR")
+	}
+
+	if !strings.Contains(value, "/") {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return "", fmt.Errorf("invalid IP address %q", cidr)
+		}
+		if ip.To4() != nil {
+			return ip.String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+
+	_, network, err := net.ParseCIDR(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid CIDR %q", cidr)
+	}
+
+	return network.String(), nil
+}
+
+// NormalizeCIDRs normalizes each CIDR in the list, dropping any duplicates while
+// keeping the original order
+func NormalizeCIDRs(cidrs []string) ([]string, error) {
+	normalized := make([]string, 0, len(cidrs))
+	seen := map[string]bool{}
+
+	for _, cidr := range cidrs {
+		value, err := NormalizeCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		normalized = append(normalized, value)
+	}
+
+	return normalized, nil
+}
+
+// CIDRsOverlap returns true if the two CIDR ranges share at least one address
+func CIDRsOverlap(a, b string) (bool, error) {
+	networkA, err := parseCIDR(a)
+	if err != nil {
+		return false, err
+	}
+
+	networkB, err := parseCIDR(b)
+	if err != nil {
+		return false, err
+	}
+
+	return networkA.Contains(networkB.IP) || networkB.Contains(networkA.IP), nil
+}
+
+// AnyCIDRsOverlap returns true if any range in a overlaps any range in b
+func AnyCIDRsOverlap(a, b []string) (bool, error) {
+	for _, cidrA := range a {
+		for _, cidrB := range b {
+			overlap, err := CIDRsOverlap(cidrA, cidrB)
+			if err != nil {
+				return false, err
+			}
+			if overlap {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func parseCIDR(cidr string) (*net.IPNet, error) {
+	value, err := NormalizeCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, network, err := net.ParseCIDR(value)
+	return network, err
+}
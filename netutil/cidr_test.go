@@ -0,0 +1,69 @@
+package netutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeCIDR(t *testing.T) {
+	cases := map[string]string{
+		"10.0.0.1":       "10.0.0.1/32",
+		" 10.0.0.5/8 ":   "10.0.0.0/8",
+		"0.0.0.0/0":      "0.0.0.0/0",
+		"2001:db8::1":    "2001:db8::1/128",
+		"2001:db8::1/32": "2001:db8::/32",
+	}
+
+	for input, expected := range cases {
+		got, err := NormalizeCIDR(input)
+		if err != nil {
+			t.Errorf("Expected %q to be valid, got %s", input, err)
+			continue
+		}
+		if got != expected {
+			t.Errorf("Expected %s, got %s", expected, got)
+		}
+	}
+
+	for _, input := range []string{"", "10.0.0", "10.0.0.0/33", "example.com"} {
+		if _, err := NormalizeCIDR(input); err == nil {
+			t.Errorf("Expected %q to be invalid", input)
+		}
+	}
+}
+
+func TestNormalizeCIDRs(t *testing.T) {
+	got, err := NormalizeCIDRs([]string{"10.0.0.1", "192.168.1.0/24", "10.0.0.1/32"})
+	if err != nil {
+		t.Errorf("Expected the CIDRs to be valid, got %s", err)
+		return
+	}
+
+	expected := []string{"10.0.0.1/32", "192.168.1.0/24"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestCIDRsOverlap(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"10.0.0.0/8", "10.1.2.3", true},
+		{"10.0.0.0/24", "10.0.0.0/16", true},
+		{"10.0.0.0/24", "10.0.1.0/24", false},
+		{"0.0.0.0/0", "2001:db8::/32", false},
+	}
+
+	for _, c := range cases {
+		got, err := CIDRsOverlap(c.a, c.b)
+		if err != nil {
+			t.Errorf("Expected %s and %s to be valid, got %s", c.a, c.b, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("Expected overlap of %s and %s to be %t, got %t", c.a, c.b, c.expected, got)
+		}
+	}
+}
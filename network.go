@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 )
 
@@ -23,6 +24,7 @@ type Network struct {
 	NameserversV6         []string `json:"nameservers_v6,omitempty"`
 	VlanID                int      `json:"vlan_id" validate:"required" schema:"vlan_id"`
 	PhysicalInterface     string   `json:"physical_interface,omitempty" schema:"physical_interface"`
+	HardwareAddr          string   `json:"hardware_addr,omitempty" schema:"hardware_addr"`
 	GatewayIPv4           string   `json:"gateway_ipv4" validate:"required" schema:"gateway_ipv4"`
 	AllocationPoolV4Start string   `json:"allocation_pool_v4_start" validate:"required" schema:"allocation_pool_v4_start"`
 	AllocationPoolV4End   string   `json:"allocation_pool_v4_end" validate:"required" schema:"allocation_pool_v4_end"`
@@ -33,6 +35,7 @@ type Subnet struct {
 	ID         string `json:"id"`
 	Name       string `json:"name,omitempty"`
 	NetworkID  string `json:"network_id"`
+	CIDR       string `json:"cidr,omitempty"`
 	SubnetSize string `json:"subnet_size,omitempty"`
 	Status     string `json:"status,omitempty"`
 }
@@ -40,6 +43,23 @@ type Subnet struct {
 // SubnetConfig contains incoming request parameters for the subnet object
 type SubnetConfig struct {
 	Name string `json:"name" validate:"required" schema:"name"`
+	CIDR string `json:"cidr,omitempty" schema:"cidr"`
+}
+
+// Validate checks a SubnetConfig has a name and, if set, a valid IPv4 CIDR
+func (sc *SubnetConfig) Validate() error {
+	if sc.Name == "" {
+		err := fmt.Errorf("the subnet name is empty")
+		return InvalidNetworkConfigError.wrap(err)
+	}
+
+	if sc.CIDR != "" {
+		if _, err := parseIPv4CIDR(sc.CIDR); err != nil {
+			return InvalidNetworkConfigError.wrap(err)
+		}
+	}
+
+	return nil
 }
 
 // Route represents a route within a subnet
@@ -65,6 +85,9 @@ type VLANConnectConfig struct {
 	// PhysicalInterface is the base interface(default: eth0) at which we want to setup VLAN.
 	PhysicalInterface string `json:"physical_interface,omitempty" schema:"physical_interface"`
 
+	// HardwareAddr is the hardware address of the interface the VLAN is attached to
+	HardwareAddr string `json:"hardware_addr,omitempty" schema:"hardware_addr"`
+
 	// CIDRv4 is the CIDR of the VLAN to connect to
 	CIDRv4 string `json:"cidr_v4" validate:"required" schema:"cidr_v4"`
 
@@ -91,6 +114,75 @@ type NetworkConfig struct {
 	VLanConfig    *VLANConnectConfig `json:"vlan_connect,omitempty"`
 }
 
+// Validate checks the addressing of a NetworkConfig before it's sent to the API
+func (nc *NetworkConfig) Validate() error {
+	if nc.CIDRv4 != "" {
+		if _, err := parseIPv4CIDR(nc.CIDRv4); err != nil {
+			return InvalidNetworkConfigError.wrap(err)
+		}
+	}
+
+	for _, nameserver := range nc.NameserversV4 {
+		if ip := net.ParseIP(nameserver); ip == nil || ip.To4() == nil {
+			err := fmt.Errorf("invalid IPv4 nameserver %q", nameserver)
+			return InvalidNetworkConfigError.wrap(err)
+		}
+	}
+	for _, nameserver := range nc.NameserversV6 {
+		if ip := net.ParseIP(nameserver); ip == nil || ip.To4() != nil {
+			err := fmt.Errorf("invalid IPv6 nameserver %q", nameserver)
+			return InvalidNetworkConfigError.wrap(err)
+		}
+	}
+
+	if nc.VLanConfig != nil {
+		if err := nc.VLanConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the VLAN ID is in range and that the gateway and allocation pool are inside the CIDR
+func (v *VLANConnectConfig) Validate() error {
+	if v.VlanID < 1 || v.VlanID > 4094 {
+		err := fmt.Errorf("invalid VLAN ID %d, must be between 1 and 4094", v.VlanID)
+		return InvalidNetworkConfigError.wrap(err)
+	}
+
+	network, err := parseIPv4CIDR(v.CIDRv4)
+	if err != nil {
+		return InvalidNetworkConfigError.wrap(err)
+	}
+
+	addresses := map[string]string{
+		"gateway":               v.GatewayIPv4,
+		"allocation pool start": v.AllocationPoolV4Start,
+		"allocation pool end":   v.AllocationPoolV4End,
+	}
+	for _, name := range []string{"gateway", "allocation pool start", "allocation pool end"} {
+		address := addresses[name]
+		if address == "" {
+			continue
+		}
+		if ip := net.ParseIP(address); ip == nil || !network.Contains(ip) {
+			err := fmt.Errorf("%s %q is not inside %s", name, address, v.CIDRv4)
+			return InvalidNetworkConfigError.wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func parseIPv4CIDR(cidr string) (*net.IPNet, error) {
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil || ip.To4() == nil {
+		return nil, fmt.Errorf("invalid IPv4 CIDR %q", cidr)
+	}
+	return network, nil
+}
+
 // NetworkResult represents the result from a network create/update call
 type NetworkResult struct {
 	ID     string `json:"id"`
@@ -193,6 +285,61 @@ func (c *Client) FindNetwork(search string) (*Network, error) {
 	}
 }
 
+// EnsureNetwork returns the network whose label exactly matches the config,
+// creating it if it doesn't exist yet. When the network exists and the config
+// sets NameserversV4, the nameservers are reconciled to the configured ones.
+func (c *Client) EnsureNetwork(nc NetworkConfig) (*Network, error) {
+	if nc.Label == "" {
+		err := fmt.Errorf("the network label is empty")
+		return nil, ParameterLabelInvalidError.wrap(err)
+	}
+
+	if nc.Region == "" {
+		nc.Region = c.Region
+	}
+
+	networks, err := c.ListNetworks()
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	for _, network := range networks {
+		if network.Label != nc.Label && network.Name != nc.Label {
+			continue
+		}
+
+		if len(nc.NameserversV4) == 0 || equalStrings(network.NameserversV4, nc.NameserversV4) {
+			return &network, nil
+		}
+
+		update := NetworkConfig{Label: nc.Label, Region: nc.Region, NameserversV4: nc.NameserversV4}
+		if _, err := c.UpdateNetwork(network.ID, update); err != nil {
+			return nil, err
+		}
+
+		return c.GetNetwork(network.ID)
+	}
+
+	result, err := c.CreateNetwork(nc)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetNetwork(result.ID)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // RenameNetwork renames an existing private network
 func (c *Client) RenameNetwork(label, id string) (*NetworkResult, error) {
 	nc := NetworkConfig{Label: label, Region: c.Region}
@@ -210,7 +357,13 @@ func (c *Client) RenameNetwork(label, id string) (*NetworkResult, error) {
 }
 
 // DeleteNetwork deletes a private network
+// With ResolveNames set the network's name can be used instead of its ID
 func (c *Client) DeleteNetwork(id string) (*SimpleResponse, error) {
+	id, err := c.resolveExactID(ResourceTypeNetwork, id)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/networks/%s", id))
 	if err != nil {
 		return nil, decodeError(err)
@@ -248,6 +401,10 @@ func (c *Client) ListSubnets(networkID string) ([]Subnet, error) {
 
 // CreateSubnet creates a new subnet for a private network
 func (c *Client) CreateSubnet(networkID string, subnet SubnetConfig) (*Subnet, error) {
+	if err := subnet.Validate(); err != nil {
+		return nil, err
+	}
+
 	body, err := c.SendPostRequest(fmt.Sprintf("/v2/networks/%s/subnets", networkID), subnet)
 	if err != nil {
 		return nil, decodeError(err)
@@ -334,6 +491,13 @@ func (c *Client) DeleteSubnet(networkID, subnetID string) (*SimpleResponse, erro
 
 // CreateNetwork creates a new network
 func (c *Client) CreateNetwork(nc NetworkConfig) (*NetworkResult, error) {
+	if err := nc.Validate(); err != nil {
+		return nil, err
+	}
+	if nc.Region == "" {
+		nc.Region = c.Region
+	}
+
 	body, err := c.SendPostRequest("/v2/networks", nc)
 	if err != nil {
 		return nil, decodeError(err)
@@ -349,6 +513,13 @@ func (c *Client) CreateNetwork(nc NetworkConfig) (*NetworkResult, error) {
 
 // UpdateNetwork updates an existing network
 func (c *Client) UpdateNetwork(id string, nc NetworkConfig) (*NetworkResult, error) {
+	if err := nc.Validate(); err != nil {
+		return nil, err
+	}
+	if nc.Region == "" {
+		nc.Region = c.Region
+	}
+
 	body, err := c.SendPutRequest("/v2/networks/"+id, nc)
 	if err != nil {
 		return nil, decodeError(err)
@@ -0,0 +1,76 @@
+package civogo
+
+// NetworkDetail is a network along with its subnets and the resources attached to it
+type NetworkDetail struct {
+	Network              *Network
+	Subnets              []Subnet
+	InstanceIDs          []string
+	KubernetesClusterIDs []string
+	LoadBalancerIDs      []string
+	FirewallIDs          []string
+}
+
+// GetNetworkDetail returns a network with its subnets and the IDs of the instances, kubernetes
+// clusters, load balancers and firewalls attached to it
+func (c *Client) GetNetworkDetail(id string) (*NetworkDetail, error) {
+	network, err := c.GetNetwork(id)
+	if err != nil {
+		return nil, err
+	}
+
+	subnets, err := c.ListSubnets(id)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &NetworkDetail{
+		Network:              network,
+		Subnets:              subnets,
+		InstanceIDs:          []string{},
+		KubernetesClusterIDs: []string{},
+		LoadBalancerIDs:      []string{},
+		FirewallIDs:          []string{},
+	}
+
+	instances, err := c.ListAllInstances()
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if instance.NetworkID == id {
+			detail.InstanceIDs = append(detail.InstanceIDs, instance.ID)
+		}
+	}
+
+	clusters, err := listAllPages[KubernetesCluster](c, "/v2/kubernetes/clusters")
+	if err != nil {
+		return nil, err
+	}
+	for _, cluster := range clusters {
+		if cluster.NetworkID == id {
+			detail.KubernetesClusterIDs = append(detail.KubernetesClusterIDs, cluster.ID)
+		}
+	}
+
+	loadbalancers, err := c.ListLoadBalancers()
+	if err != nil {
+		return nil, err
+	}
+	for _, loadbalancer := range loadbalancers {
+		if loadbalancer.NetworkID == id {
+			detail.LoadBalancerIDs = append(detail.LoadBalancerIDs, loadbalancer.ID)
+		}
+	}
+
+	firewalls, err := c.ListFirewalls()
+	if err != nil {
+		return nil, err
+	}
+	for _, firewall := range firewalls {
+		if firewall.NetworkID == id {
+			detail.FirewallIDs = append(detail.FirewallIDs, firewall.ID)
+		}
+	}
+
+	return detail, nil
+}
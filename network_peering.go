@@ -0,0 +1,99 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Statuses a network peering goes through, a peering with another account stays pending
+// until it's accepted from that account
+const (
+	NetworkPeeringStatusPending = "PENDING"
+	NetworkPeeringStatusActive  = "ACTIVE"
+	NetworkPeeringStatusFailed  = "FAILED"
+)
+
+// NetworkPeering links two private networks so traffic can be routed between them
+type NetworkPeering struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	NetworkID     string    `json:"network_id"`
+	PeerNetworkID string    `json:"peer_network_id"`
+	PeerAccountID string    `json:"peer_account_id,omitempty"`
+	Status        string    `json:"status"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+}
+
+// NetworkPeeringConfig contains the networks to peer, PeerAccountID is only needed when
+// the peer network belongs to a different account
+type NetworkPeeringConfig struct {
+	Name          string `json:"name"`
+	NetworkID     string `json:"network_id"`
+	PeerNetworkID string `json:"peer_network_id"`
+	PeerAccountID string `json:"peer_account_id,omitempty"`
+	Region        string `json:"region"`
+}
+
+// Validate checks both sides of the peering are set and aren't the same network
+func (p *NetworkPeeringConfig) Validate() error {
+	if p.NetworkID == "" || p.PeerNetworkID == "" {
+		err := fmt.Errorf("both the network and the peer network are needed")
+		return InvalidNetworkPeeringError.wrap(err)
+	}
+	if p.NetworkID == p.PeerNetworkID && p.PeerAccountID == "" {
+		err := fmt.Errorf("network %s can't be peered with itself", p.NetworkID)
+		return InvalidNetworkPeeringError.wrap(err)
+	}
+
+	return nil
+}
+
+// ListNetworkPeerings returns all the network peerings of the account
+func (c *Client) ListNetworkPeerings() ([]NetworkPeering, error) {
+	resp, err := c.SendGetRequest("/v2/network-peerings")
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	peerings := make([]NetworkPeering, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&peerings); err != nil {
+		return nil, err
+	}
+
+	return peerings, nil
+}
+
+// CreateNetworkPeering peers two networks, in the same or in different accounts
+func (c *Client) CreateNetworkPeering(p *NetworkPeeringConfig) (*NetworkPeering, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	if p.Region == "" {
+		p.Region = c.Region
+	}
+
+	resp, err := c.SendPostRequest("/v2/network-peerings", p)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	peering := &NetworkPeering{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(peering); err != nil {
+		return nil, err
+	}
+
+	return peering, nil
+}
+
+// DeleteNetworkPeering removes a network peering, the networks themselves are left as they are
+func (c *Client) DeleteNetworkPeering(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/network-peerings/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
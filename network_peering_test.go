@@ -0,0 +1,85 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCreateNetworkPeering(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"prod-to-shared","network_id":"net-1","peer_network_id":"net-2","region":"TEST"}`,
+					URL:          "/v2/network-peerings",
+					ResponseBody: `{"id": "peer-1", "name": "prod-to-shared", "network_id": "net-1", "peer_network_id": "net-2", "status": "ACTIVE"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateNetworkPeering(&NetworkPeeringConfig{Name: "prod-to-shared", NetworkID: "net-1", PeerNetworkID: "net-2"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &NetworkPeering{ID: "peer-1", Name: "prod-to-shared", NetworkID: "net-1", PeerNetworkID: "net-2", Status: NetworkPeeringStatusActive}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestCreateNetworkPeeringInvalid(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{})
+	defer server.Close()
+
+	invalid := []*NetworkPeeringConfig{
+		{NetworkID: "net-1"},
+		{NetworkID: "net-1", PeerNetworkID: "net-1"},
+	}
+	for _, config := range invalid {
+		if _, err := client.CreateNetworkPeering(config); !errors.Is(err, InvalidNetworkPeeringError) {
+			t.Errorf("Expected InvalidNetworkPeeringError, got %v", err)
+		}
+	}
+}
+
+func TestListNetworkPeerings(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/network-peerings": `[{"id": "peer-1", "network_id": "net-1", "peer_network_id": "net-9", "peer_account_id": "acc-2", "status": "PENDING"}]`,
+	})
+	defer server.Close()
+
+	got, err := client.ListNetworkPeerings()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []NetworkPeering{{ID: "peer-1", NetworkID: "net-1", PeerNetworkID: "net-9", PeerAccountID: "acc-2", Status: NetworkPeeringStatusPending}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestDeleteNetworkPeering(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/network-peerings/peer-1": `{"result": "success"}`,
+	})
+	defer server.Close()
+
+	got, err := client.DeleteNetworkPeering("peer-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &SimpleResponse{Result: "success"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
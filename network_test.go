@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -232,6 +233,43 @@ func TestFindNetwork(t *testing.T) {
 	}
 }
 
+func TestEnsureNetworkExisting(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/networks": `[{"id": "12345", "label": "production", "status": "Active"}, {"id": "67890", "label": "production-2", "status": "Active"}]`,
+	})
+	defer server.Close()
+
+	got, err := client.EnsureNetwork(NetworkConfig{Label: "production"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "12345" {
+		t.Errorf("Expected %s, got %s", "12345", got.ID)
+	}
+}
+
+func TestEnsureNetworkCreate(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/networks":       {`[{"id": "67890", "label": "production-2", "status": "Active"}]`},
+		"POST /v2/networks":      {`{"id": "12345", "label": "production", "result": "success"}`},
+		"GET /v2/networks/12345": {`{"id": "12345", "label": "production", "status": "Active"}`},
+	})
+	defer server.Close()
+
+	got, err := client.EnsureNetwork(NetworkConfig{Label: "production"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "12345" {
+		t.Errorf("Expected %s, got %s", "12345", got.ID)
+	}
+	if got.Label != "production" {
+		t.Errorf("Expected %s, got %s", "production", got.Label)
+	}
+}
+
 func TestRenameNetwork(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/networks/76cc107f-fbef-4e2b-b97f-f5d34f4075d3": `{
@@ -349,6 +387,35 @@ func TestCreateSubnet(t *testing.T) {
 	}
 }
 
+func TestCreateSubnetWithCIDR(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"web","cidr":"10.0.1.0/24"}`,
+					URL:          "/v2/networks/12345/subnets",
+					ResponseBody: `{"id": "6789", "network_id": "12345", "name": "web", "cidr": "10.0.1.0/24", "status": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateSubnet("12345", SubnetConfig{Name: "web", CIDR: "10.0.1.0/24"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.CIDR != "10.0.1.0/24" {
+		t.Errorf("Expected %s, got %s", "10.0.1.0/24", got.CIDR)
+	}
+
+	if _, err := client.CreateSubnet("12345", SubnetConfig{Name: "web", CIDR: "10.0.1.0"}); !errors.Is(err, InvalidNetworkConfigError) {
+		t.Errorf("Expected InvalidNetworkConfigError, got %v", err)
+	}
+}
+
 func TestListSubnets(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/networks/12345/subnets": `[{
@@ -388,3 +455,129 @@ func TestDeleteSubnet(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestNetworkConfigValidate(t *testing.T) {
+	valid := NetworkConfig{
+		Label:         "private-net",
+		CIDRv4:        "10.10.0.0/16",
+		NameserversV4: []string{"8.8.8.8", "1.1.1.1"},
+		VLanConfig: &VLANConnectConfig{
+			VlanID:                100,
+			CIDRv4:                "192.168.1.0/24",
+			GatewayIPv4:           "192.168.1.1",
+			AllocationPoolV4Start: "192.168.1.10",
+			AllocationPoolV4End:   "192.168.1.200",
+		},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	tests := map[string]NetworkConfig{
+		"bad cidr":          {Label: "a", CIDRv4: "10.10.0.0/33"},
+		"ipv6 cidr":         {Label: "a", CIDRv4: "2001:db8::/32"},
+		"bad nameserver":    {Label: "a", NameserversV4: []string{"dns.google"}},
+		"ipv4 nameserverv6": {Label: "a", NameserversV6: []string{"8.8.8.8"}},
+		"bad vlan id":       {Label: "a", VLanConfig: &VLANConnectConfig{VlanID: 5000, CIDRv4: "192.168.1.0/24"}},
+		"gateway outside":   {Label: "a", VLanConfig: &VLANConnectConfig{VlanID: 1, CIDRv4: "192.168.1.0/24", GatewayIPv4: "192.168.2.1"}},
+	}
+	for name, config := range tests {
+		if err := config.Validate(); !errors.Is(err, InvalidNetworkConfigError) {
+			t.Errorf("%s: expected InvalidNetworkConfigError, got %v", name, err)
+		}
+	}
+}
+
+func TestUpdateNetwork(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"label":"private-net","default":"","ipv4_enabled":null,"nameservers_v4":["8.8.8.8"],"cidr_v4":"","ipv6_enabled":null,"nameservers_v6":null,"region":"TEST"}`,
+					URL:          "/v2/networks/12345",
+					ResponseBody: `{"id": "12345", "label": "private-net", "result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.UpdateNetwork("12345", NetworkConfig{Label: "private-net", NameserversV4: []string{"8.8.8.8"}})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Result != "success" {
+		t.Errorf("Expected %s, got %s", "success", got.Result)
+	}
+}
+
+func TestGetNetworkDetail(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/networks/net-1":         {`{"id": "net-1", "label": "private-net", "cidr": "10.0.0.0/24"}`},
+		"GET /v2/networks/net-1/subnets": {`[{"id": "sub-1", "name": "web", "network_id": "net-1"}]`},
+		"GET /v2/instances":              {`{"page": 1, "per_page": 200, "pages": 1, "items": [{"id": "i-1", "network_id": "net-1"}, {"id": "i-2", "network_id": "net-2"}]}`},
+		"GET /v2/kubernetes/clusters": {
+			`{"page": 1, "per_page": 100, "pages": 2, "items": [{"id": "k-1", "network_id": "net-1"}]}`,
+			`{"page": 2, "per_page": 100, "pages": 2, "items": [{"id": "k-2", "network_id": "net-1"}]}`,
+		},
+		"GET /v2/loadbalancers": {`[{"id": "lb-1", "network_id": "net-2"}]`},
+		"GET /v2/firewalls":     {`[{"id": "fw-1", "network_id": "net-1"}]`},
+	})
+	defer server.Close()
+
+	got, err := client.GetNetworkDetail("net-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &NetworkDetail{
+		Network:              &Network{ID: "net-1", Label: "private-net", CIDR: "10.0.0.0/24"},
+		Subnets:              []Subnet{{ID: "sub-1", Name: "web", NetworkID: "net-1"}},
+		InstanceIDs:          []string{"i-1"},
+		KubernetesClusterIDs: []string{"k-1", "k-2"},
+		LoadBalancerIDs:      []string{},
+		FirewallIDs:          []string{"fw-1"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestCreateNetworkWithVLANHardwareAddr(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"label":"vlan-net","default":"","ipv4_enabled":null,"nameservers_v4":null,"cidr_v4":"","ipv6_enabled":null,"nameservers_v6":null,"region":"TEST","vlan_connect":{"vlan_id":20,"physical_interface":"eth1","hardware_addr":"52:54:00:12:34:56","cidr_v4":"172.16.0.0/24","gateway_ipv4":"172.16.0.1","allocation_pool_v4_start":"172.16.0.10","allocation_pool_v4_end":"172.16.0.100"}}`,
+					URL:          "/v2/networks",
+					ResponseBody: `{"id": "12345", "label": "vlan-net", "result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateNetwork(NetworkConfig{
+		Label: "vlan-net",
+		VLanConfig: &VLANConnectConfig{
+			VlanID:                20,
+			PhysicalInterface:     "eth1",
+			HardwareAddr:          "52:54:00:12:34:56",
+			CIDRv4:                "172.16.0.0/24",
+			GatewayIPv4:           "172.16.0.1",
+			AllocationPoolV4Start: "172.16.0.10",
+			AllocationPoolV4End:   "172.16.0.100",
+		},
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Result != "success" {
+		t.Errorf("Expected %s, got %s", "success", got.Result)
+	}
+}
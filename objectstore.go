@@ -7,6 +7,9 @@ import (
 	"strings"
 )
 
+// ObjectStoreStatusActive is the status of an objectstore that's ready to be used
+const ObjectStoreStatusActive = "active"
+
 // ObjectStore is the struct for the ObjectStore model
 type ObjectStore struct {
 	ID        string      `json:"id"`
@@ -55,6 +58,14 @@ type UpdateObjectStoreRequest struct {
 	Region string `json:"region"`
 }
 
+func checkObjectStoreSize(maxSizeGB int64) error {
+	if maxSizeGB <= 0 {
+		err := fmt.Errorf("the objectstore size must be greater than 0GB, got %dGB", maxSizeGB)
+		return InvalidObjectStoreSizeError.wrap(err)
+	}
+	return nil
+}
+
 // ListObjectStores returns all objectstores in that specific region
 func (c *Client) ListObjectStores() (*PaginatedObjectstores, error) {
 	resp, err := c.SendGetRequest("/v2/objectstores")
@@ -121,6 +132,14 @@ func (c *Client) FindObjectStore(search string) (*ObjectStore, error) {
 
 // NewObjectStore creates a new objectstore
 func (c *Client) NewObjectStore(v *CreateObjectStoreRequest) (*ObjectStore, error) {
+	if err := checkObjectStoreSize(v.MaxSizeGB); err != nil {
+		return nil, err
+	}
+
+	if v.Region == "" {
+		v.Region = c.Region
+	}
+
 	body, err := c.SendPostRequest("/v2/objectstores", v)
 	if err != nil {
 		return nil, decodeError(err)
@@ -136,6 +155,14 @@ func (c *Client) NewObjectStore(v *CreateObjectStoreRequest) (*ObjectStore, erro
 
 // UpdateObjectStore updates an objectstore
 func (c *Client) UpdateObjectStore(id string, v *UpdateObjectStoreRequest) (*ObjectStore, error) {
+	if err := checkObjectStoreSize(v.MaxSizeGB); err != nil {
+		return nil, err
+	}
+
+	if v.Region == "" {
+		v.Region = c.Region
+	}
+
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/objectstores/%s", id), v)
 	if err != nil {
 		return nil, decodeError(err)
@@ -0,0 +1,68 @@
+package civogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ObjectStoreS3Config has everything needed to point an S3 client at an objectstore, the
+// field names match the ones used by the AWS SDKs so they can be copied straight across
+type ObjectStoreS3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle is set as the bucket name is part of the path rather than the hostname
+	UsePathStyle bool
+}
+
+// EnvVars returns the config as the environment variables read by the AWS CLI and SDKs
+func (s *ObjectStoreS3Config) EnvVars() map[string]string {
+	return map[string]string{
+		"AWS_ACCESS_KEY_ID":     s.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY": s.SecretAccessKey,
+		"AWS_REGION":            s.Region,
+		"AWS_ENDPOINT_URL_S3":   s.Endpoint,
+	}
+}
+
+// GetObjectStoreS3Config returns the endpoint, region and owner credentials of an objectstore
+// so an S3 client can be created for it straight away
+func (c *Client) GetObjectStoreS3Config(id string) (*ObjectStoreS3Config, error) {
+	store, err := c.GetObjectStore(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var credential *ObjectStoreCredential
+	switch {
+	case store.OwnerInfo.CredentialID != "":
+		credential, err = c.GetObjectStoreCredential(store.OwnerInfo.CredentialID)
+	case store.OwnerInfo.AccessKeyID != "":
+		credential, err = c.FindObjectStoreCredential(store.OwnerInfo.AccessKeyID)
+		if err == nil && credential.SecretAccessKeyID == "" {
+			// listing credentials doesn't always include the secret, fetching one does
+			credential, err = c.GetObjectStoreCredential(credential.ID)
+		}
+	default:
+		err = ZeroMatchesError.wrap(fmt.Errorf("objectstore %s has no owner credential", id))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := store.BucketURL
+	if endpoint != "" && !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+
+	return &ObjectStoreS3Config{
+		Endpoint:        endpoint,
+		Region:          c.Region,
+		Bucket:          store.Name,
+		AccessKeyID:     credential.AccessKeyID,
+		SecretAccessKey: credential.SecretAccessKeyID,
+		UsePathStyle:    true,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGetObjectStoreS3Config(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/objectstores/12345": {`{
+			"id": "12345",
+			"name": "backups",
+			"max_size": 500,
+			"owner_info": {"access_key_id": "AKIA123", "credential_id": "cred-1"},
+			"objectstore_endpoint": "objectstore.lon1.civo.com",
+			"status": "active"
+		}`},
+		"GET /v2/objectstore/credentials/cred-1": {`{
+			"id": "cred-1",
+			"name": "owner",
+			"access_key_id": "AKIA123",
+			"secret_access_key_id": "secret",
+			"status": "ready"
+		}`},
+	})
+	defer server.Close()
+
+	got, err := client.GetObjectStoreS3Config("12345")
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+
+	expected := &ObjectStoreS3Config{
+		Endpoint:        "https://objectstore.lon1.civo.com",
+		Region:          "TEST",
+		Bucket:          "backups",
+		AccessKeyID:     "AKIA123",
+		SecretAccessKey: "secret",
+		UsePathStyle:    true,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	env := got.EnvVars()
+	if env["AWS_ENDPOINT_URL_S3"] != expected.Endpoint || env["AWS_SECRET_ACCESS_KEY"] != "secret" {
+		t.Errorf("Unexpected environment %+v", env)
+	}
+}
+
+func TestGetObjectStoreS3ConfigWithoutOwner(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/objectstores/12345": {`{"id": "12345", "name": "backups", "status": "active"}`},
+	})
+	defer server.Close()
+
+	if _, err := client.GetObjectStoreS3Config("12345"); !errors.Is(err, ZeroMatchesError) {
+		t.Errorf("Expected ZeroMatchesError, got %v", err)
+	}
+}
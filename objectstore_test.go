@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -135,3 +136,13 @@ func TestDeleteObjectStore(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestNewObjectStoreInvalidSize(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{})
+	defer server.Close()
+
+	_, err := client.NewObjectStore(&CreateObjectStoreRequest{Name: "test-objectstore"})
+	if !errors.Is(err, InvalidObjectStoreSizeError) {
+		t.Errorf("Expected InvalidObjectStoreSizeError, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package civogo
+
+import (
+	"errors"
+	"strings"
+)
+
+// OperationResult is the structured outcome of a call that changes a resource, so callers can
+// branch on OK and ErrorCode instead of comparing SimpleResponse.Result to a string
+type OperationResult struct {
+	OK bool
+	// ErrorCode is the API's error code, or the name of the error for failures returned as an
+	// error, e.g. "ZeroMatchesError"
+	ErrorCode  string
+	Message    string
+	ResourceID string
+}
+
+// Operation returns the response as an OperationResult
+func (r *SimpleResponse) Operation() OperationResult {
+	message := r.ErrorReason
+	if r.ErrorDetails != "" {
+		if message != "" {
+			message += ", "
+		}
+		message += r.ErrorDetails
+	}
+
+	return OperationResult{
+		OK:         r.Result == ResultSuccess,
+		ErrorCode:  r.ErrorCode,
+		Message:    message,
+		ResourceID: r.ID,
+	}
+}
+
+// NewOperationResult combines the two results of a call that returns a SimpleResponse into an
+// OperationResult, so a failure is handled the same way whether the API reported it in the
+// response or the call returned an error:
+//
+//	op := civogo.NewOperationResult(client.DeleteInstance(id))
+//	if !op.OK {
+//		log.Printf("deleting %s failed with %s: %s", id, op.ErrorCode, op.Message)
+//	}
+func NewOperationResult(resp *SimpleResponse, err error) OperationResult {
+	if err != nil {
+		op := OperationResult{Message: err.Error()}
+		if resp != nil {
+			op.ResourceID = resp.ID
+		}
+
+		var wrapped wrapError
+		var quota *QuotaExceededError
+		switch {
+		case errors.As(err, &wrapped):
+			op.ErrorCode = wrapped.msg
+			if wrapped.err != nil {
+				op.Message = wrapped.err.Error()
+			}
+		case errors.As(err, &quota):
+			op.ErrorCode = "QuotaExceededError"
+		}
+		return op
+	}
+
+	if resp == nil {
+		return OperationResult{}
+	}
+
+	op := resp.Operation()
+	if !op.OK && op.ErrorCode == "" && op.Message == "" {
+		op.Message = strings.TrimSpace(string(resp.Result))
+	}
+	return op
+}
+
+// Operation returns the result of creating or updating the firewall as an OperationResult
+func (r *FirewallResult) Operation() OperationResult {
+	return OperationResult{OK: r.Result == ResultSuccess, ResourceID: r.ID}
+}
+
+// Operation returns the result of creating or updating the network as an OperationResult
+func (r *NetworkResult) Operation() OperationResult {
+	return OperationResult{OK: r.Result == ResultSuccess, ResourceID: r.ID}
+}
+
+// Operation returns the result of creating the volume as an OperationResult
+func (r *VolumeResult) Operation() OperationResult {
+	return OperationResult{OK: r.Result == ResultSuccess, ResourceID: r.ID}
+}
@@ -0,0 +1,64 @@
+package civogo
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSimpleResponseOperation(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/volumes/12345": `{"id": "12345", "result": "success"}`,
+	})
+	defer server.Close()
+
+	got := NewOperationResult(client.DeleteVolume("12345"))
+	expected := OperationResult{OK: true, ResourceID: "12345"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestSimpleResponseOperationFailed(t *testing.T) {
+	resp := &SimpleResponse{ID: "12345", Result: "failed", ErrorCode: "volume_attached", ErrorReason: "The volume is attached", ErrorDetails: "detach it first"}
+
+	got := resp.Operation()
+	expected := OperationResult{ErrorCode: "volume_attached", Message: "The volume is attached, detach it first", ResourceID: "12345"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	got = NewOperationResult(&SimpleResponse{Result: "failed"}, nil)
+	if got.OK || got.Message != "failed" {
+		t.Errorf("Expected a failed result without details to keep its result, got %+v", got)
+	}
+}
+
+func TestNewOperationResultFromError(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{})
+	defer server.Close()
+
+	got := NewOperationResult(client.DeleteVolume("12345"))
+	if got.OK || got.ErrorCode == "" || got.Message == "" {
+		t.Errorf("Expected the error to become a failed result, got %+v", got)
+	}
+
+	got = NewOperationResult(nil, ZeroMatchesError.wrap(fmt.Errorf("unable to find 12345, zero matches")))
+	expected := OperationResult{ErrorCode: "ZeroMatchesError", Message: "unable to find 12345, zero matches"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	got = NewOperationResult(nil, &QuotaExceededError{Resource: QuotaResourceInstanceCount, Limit: 10, Usage: 10, Requested: 1})
+	if got.OK || got.ErrorCode != "QuotaExceededError" {
+		t.Errorf("Expected a quota failure, got %+v", got)
+	}
+}
+
+func TestVolumeResultOperation(t *testing.T) {
+	got := (&VolumeResult{ID: "12345", Name: "data", Result: "success"}).Operation()
+	expected := OperationResult{OK: true, ResourceID: "12345"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
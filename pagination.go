@@ -0,0 +1,50 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// IteratorPageSize is the number of items fetched per request when every page of a paginated
+// endpoint is read
+const IteratorPageSize = 100
+
+// listPage is the shape of a page returned by the API's paginated list endpoints
+type listPage[T any] struct {
+	Page    int `json:"page"`
+	PerPage int `json:"per_page"`
+	Pages   int `json:"pages"`
+	Items   []T `json:"items"`
+}
+
+// fetchPage returns a single page of IteratorPageSize items from a paginated endpoint
+func fetchPage[T any](c *Client, path string, page int) (*listPage[T], error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("%s?page=%d&per_page=%d", path, page, IteratorPageSize))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	current := &listPage[T]{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(current); err != nil {
+		return nil, err
+	}
+
+	return current, nil
+}
+
+// listAllPages returns the items of every page of a paginated endpoint
+func listAllPages[T any](c *Client, path string) ([]T, error) {
+	items := make([]T, 0)
+	for page := 1; ; page++ {
+		current, err := fetchPage[T](c, path, page)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, current.Items...)
+		if len(current.Items) == 0 || page >= current.Pages {
+			return items, nil
+		}
+	}
+}
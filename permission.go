@@ -3,8 +3,14 @@ package civogo
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"strings"
 )
 
+// PermissionWildcard can be used in place of the resource or the action of a permission code,
+// e.g. "kubernetes.*" or "*.*"
+const PermissionWildcard = "*"
+
 // Permission represents a permission and the description for it
 type Permission struct {
 	Code        string `json:"code"`
@@ -26,3 +32,29 @@ func (c *Client) ListPermissions() ([]Permission, error) {
 
 	return permissions, nil
 }
+
+// ParsePermissionCode splits a permission code like "instance.create" into its resource and action
+func ParsePermissionCode(code string) (string, string, error) {
+	resource, action, found := strings.Cut(strings.TrimSpace(code), ".")
+	if !found || resource == "" || action == "" || strings.Contains(action, ".") {
+		err := fmt.Errorf("%q isn't a valid permission, it should look like resource.action", code)
+		return "", "", InvalidPermissionError.wrap(err)
+	}
+
+	return resource, action, nil
+}
+
+// PermissionCodeMatches reports whether a granted permission, which may use wildcards, covers code
+func PermissionCodeMatches(granted, code string) bool {
+	grantedResource, grantedAction, err := ParsePermissionCode(granted)
+	if err != nil {
+		return false
+	}
+	resource, action, err := ParsePermissionCode(code)
+	if err != nil {
+		return false
+	}
+
+	return (grantedResource == PermissionWildcard || grantedResource == resource) &&
+		(grantedAction == PermissionWildcard || grantedAction == action)
+}
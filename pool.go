@@ -4,20 +4,32 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
-// KubernetesClusterPoolUpdateConfig is used to create a new cluster pool
+// KubernetesClusterPoolUpdateConfig is used to create a new cluster pool. Nil Taints leave the
+// pool's taints unchanged, an empty slice removes them.
 type KubernetesClusterPoolUpdateConfig struct {
-	ID               string            `json:"id,omitempty"`
-	Count            *int              `json:"count,omitempty"`
-	Size             string            `json:"size,omitempty"`
-	Labels           map[string]string `json:"labels,omitempty"`
-	Taints           []corev1.Taint    `json:"taints"`
-	PublicIPNodePool bool              `json:"public_ip_node_pool,omitempty"`
-	Region           string            `json:"region,omitempty"`
+	ID               string                    `json:"id,omitempty"`
+	Count            *int                      `json:"count,omitempty"`
+	Size             string                    `json:"size,omitempty"`
+	Labels           map[string]string         `json:"labels,omitempty"`
+	Taints           []corev1.Taint            `json:"taints"`
+	PublicIPNodePool bool                      `json:"public_ip_node_pool,omitempty"`
+	Autoscaler       *KubernetesPoolAutoscaler `json:"autoscaler,omitempty"`
+	Region           string                    `json:"region,omitempty"`
+}
+
+// kubernetesPoolUpdateRequest is the payload of UpdateKubernetesClusterPool. Its taints field
+// hides the config's one so that nil taints are left out instead of sent as null, which the
+// API would take as a request to clear the pool's taints.
+type kubernetesPoolUpdateRequest struct {
+	*KubernetesClusterPoolUpdateConfig
+	Taints *[]corev1.Taint `json:"taints,omitempty"`
 }
 
 // ListKubernetesClusterPools returns all the pools for a kubernetes cluster
@@ -37,6 +49,10 @@ func (c *Client) ListKubernetesClusterPools(cid string) ([]KubernetesPool, error
 
 // CreateKubernetesClusterPool update a single kubernetes cluster by its full ID
 func (c *Client) CreateKubernetesClusterPool(id string, i *KubernetesClusterPoolConfig) (*SimpleResponse, error) {
+	if err := validateKubernetesPoolScheduling(i.Labels, i.Taints); err != nil {
+		return nil, err
+	}
+
 	i.Region = c.Region
 	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/pools", id), i)
 	if err != nil {
@@ -106,7 +122,25 @@ func (c *Client) DeleteKubernetesClusterPoolInstance(cid, pid, id string) (*Simp
 
 // UpdateKubernetesClusterPool updates a pool for a kubernetes cluster
 func (c *Client) UpdateKubernetesClusterPool(cid, pid string, config *KubernetesClusterPoolUpdateConfig) (*KubernetesPool, error) {
-	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/pools/%s", cid, pid), config)
+	if err := validateKubernetesPoolScheduling(config.Labels, config.Taints); err != nil {
+		return nil, err
+	}
+
+	payload := *config
+	if payload.Region == "" {
+		payload.Region = c.Region
+	}
+
+	request := &kubernetesPoolUpdateRequest{KubernetesClusterPoolUpdateConfig: &payload}
+	if config.Taints != nil {
+		request.Taints = &payload.Taints
+	}
+	return c.sendKubernetesPoolUpdate(cid, pid, request)
+}
+
+// sendKubernetesPoolUpdate sends the changes in payload to a pool and returns the updated pool
+func (c *Client) sendKubernetesPoolUpdate(cid, pid string, payload interface{}) (*KubernetesPool, error) {
+	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/pools/%s", cid, pid), payload)
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -119,6 +153,47 @@ func (c *Client) UpdateKubernetesClusterPool(cid, pid string, config *Kubernetes
 	return pool, nil
 }
 
+// kubernetesPoolScaleRequest is the payload of ScaleKubernetesClusterPool, it has no taints
+// field so the pool's taints are left unchanged
+type kubernetesPoolScaleRequest struct {
+	Count  int    `json:"count"`
+	Region string `json:"region"`
+}
+
+// ScaleKubernetesClusterPool changes the number of nodes in a pool for a kubernetes cluster
+func (c *Client) ScaleKubernetesClusterPool(cid, pid string, count int) (*KubernetesPool, error) {
+	return c.sendKubernetesPoolUpdate(cid, pid, &kubernetesPoolScaleRequest{Count: count, Region: c.Region})
+}
+
+// kubernetesPoolAutoscalingRequest is the payload changing a pool's autoscaler, like
+// kubernetesPoolScaleRequest it leaves the pool's taints out
+type kubernetesPoolAutoscalingRequest struct {
+	Autoscaler *KubernetesPoolAutoscaler `json:"autoscaler"`
+	Region     string                    `json:"region"`
+}
+
+// SetKubernetesClusterPoolAutoscaling enables the cluster autoscaler for a pool, letting it scale
+// between minNodes and maxNodes
+func (c *Client) SetKubernetesClusterPoolAutoscaling(cid, pid string, minNodes, maxNodes int) (*KubernetesPool, error) {
+	if minNodes < 0 || maxNodes < 1 || minNodes > maxNodes {
+		err := fmt.Errorf("invalid autoscaling range %d-%d, min nodes must be between 0 and max nodes and max nodes at least 1", minNodes, maxNodes)
+		return nil, KubernetesPoolInvalidAutoscalingError.wrap(err)
+	}
+
+	return c.sendKubernetesPoolUpdate(cid, pid, &kubernetesPoolAutoscalingRequest{
+		Autoscaler: &KubernetesPoolAutoscaler{Enabled: true, MinNodes: minNodes, MaxNodes: maxNodes},
+		Region:     c.Region,
+	})
+}
+
+// DisableKubernetesClusterPoolAutoscaling disables the cluster autoscaler for a pool, leaving it at its current size
+func (c *Client) DisableKubernetesClusterPoolAutoscaling(cid, pid string) (*KubernetesPool, error) {
+	return c.sendKubernetesPoolUpdate(cid, pid, &kubernetesPoolAutoscalingRequest{
+		Autoscaler: &KubernetesPoolAutoscaler{Enabled: false},
+		Region:     c.Region,
+	})
+}
+
 // DeleteKubernetesClusterPool delete a pool inside the cluster
 func (c *Client) DeleteKubernetesClusterPool(id, poolID string) (*SimpleResponse, error) {
 	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/pools/%s", id, poolID))
@@ -128,3 +203,45 @@ func (c *Client) DeleteKubernetesClusterPool(id, poolID string) (*SimpleResponse
 
 	return c.DecodeSimpleResponse(resp)
 }
+
+// validateKubernetesPoolScheduling checks that the labels and taints of a pool are valid for
+// kubernetes, so a bad key is reported before the pool is created rather than when its nodes join
+func validateKubernetesPoolScheduling(labels map[string]string, taints []corev1.Taint) error {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			err := fmt.Errorf("invalid label key %q: %s", key, strings.Join(errs, "; "))
+			return InvalidKubernetesPoolError.wrap(err)
+		}
+		if errs := validation.IsValidLabelValue(labels[key]); len(errs) > 0 {
+			err := fmt.Errorf("invalid value for label %q: %s", key, strings.Join(errs, "; "))
+			return InvalidKubernetesPoolError.wrap(err)
+		}
+	}
+
+	for _, taint := range taints {
+		if errs := validation.IsQualifiedName(taint.Key); len(errs) > 0 {
+			err := fmt.Errorf("invalid taint key %q: %s", taint.Key, strings.Join(errs, "; "))
+			return InvalidKubernetesPoolError.wrap(err)
+		}
+		if errs := validation.IsValidLabelValue(taint.Value); len(errs) > 0 {
+			err := fmt.Errorf("invalid value for taint %q: %s", taint.Key, strings.Join(errs, "; "))
+			return InvalidKubernetesPoolError.wrap(err)
+		}
+
+		switch taint.Effect {
+		case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			err := fmt.Errorf("invalid effect %q for taint %q, must be one of %s, %s or %s", taint.Effect, taint.Key,
+				corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute)
+			return InvalidKubernetesPoolError.wrap(err)
+		}
+	}
+
+	return nil
+}
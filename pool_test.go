@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -274,3 +275,174 @@ func TestDeleteKubernetesPoolInstance(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestScaleKubernetesClusterPool(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"count":5,"region":"TEST"}`,
+					URL:          "/v2/kubernetes/clusters/12345/pools/pool-1",
+					ResponseBody: `{"id": "pool-1", "size": "g4s.kube.small", "count": 5}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.ScaleKubernetesClusterPool("12345", "pool-1", 5)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Count != 5 {
+		t.Errorf("Expected %d, got %d", 5, got.Count)
+	}
+}
+
+func TestUpdateKubernetesClusterPoolKeepsTaints(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"count":3,"region":"TEST"}`,
+					URL:          "/v2/kubernetes/clusters/12345/pools/pool-1",
+					ResponseBody: `{"id": "pool-1", "count": 3}`,
+				},
+				{
+					RequestBody:  `{"region":"TEST","taints":[]}`,
+					URL:          "/v2/kubernetes/clusters/12345/pools/pool-2",
+					ResponseBody: `{"id": "pool-2", "count": 1}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	config := &KubernetesClusterPoolUpdateConfig{Count: &[]int{3}[0]}
+	got, err := client.UpdateKubernetesClusterPool("12345", "pool-1", config)
+	if err != nil || got.ID != "pool-1" {
+		t.Errorf("Expected the update to leave the taints out, got %+v and %v", got, err)
+	}
+	if config.Region != "" {
+		t.Errorf("Expected the config to be left unchanged, got region %q", config.Region)
+	}
+
+	got, err = client.UpdateKubernetesClusterPool("12345", "pool-2", &KubernetesClusterPoolUpdateConfig{Taints: []corev1.Taint{}})
+	if err != nil || got.ID != "pool-2" {
+		t.Errorf("Expected the update to clear the taints, got %+v and %v", got, err)
+	}
+}
+
+func TestSetKubernetesClusterPoolAutoscaling(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"autoscaler":{"enabled":true,"min_nodes":1,"max_nodes":5},"region":"TEST"}`,
+					URL:          "/v2/kubernetes/clusters/12345/pools/pool-1",
+					ResponseBody: `{"id": "pool-1", "count": 2, "autoscaler": {"enabled": true, "min_nodes": 1, "max_nodes": 5}}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.SetKubernetesClusterPoolAutoscaling("12345", "pool-1", 1, 5)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &KubernetesPoolAutoscaler{Enabled: true, MinNodes: 1, MaxNodes: 5}
+	if !reflect.DeepEqual(got.Autoscaler, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got.Autoscaler)
+	}
+
+	if _, err := client.SetKubernetesClusterPoolAutoscaling("12345", "pool-1", 5, 1); !errors.Is(err, KubernetesPoolInvalidAutoscalingError) {
+		t.Errorf("Expected KubernetesPoolInvalidAutoscalingError, got %v", err)
+	}
+}
+
+func TestSetKubernetesClusterPoolAutoscalingToZero(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"autoscaler":{"enabled":true,"min_nodes":0,"max_nodes":3},"region":"TEST"}`,
+					URL:          "/v2/kubernetes/clusters/12345/pools/pool-1",
+					ResponseBody: `{"id": "pool-1", "count": 1, "autoscaler": {"enabled": true, "min_nodes": 0, "max_nodes": 3}}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.SetKubernetesClusterPoolAutoscaling("12345", "pool-1", 0, 3)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &KubernetesPoolAutoscaler{Enabled: true, MinNodes: 0, MaxNodes: 3}
+	if !reflect.DeepEqual(got.Autoscaler, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got.Autoscaler)
+	}
+}
+
+func TestDisableKubernetesClusterPoolAutoscaling(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"autoscaler":{"enabled":false,"min_nodes":0},"region":"TEST"}`,
+					URL:          "/v2/kubernetes/clusters/12345/pools/pool-1",
+					ResponseBody: `{"id": "pool-1", "count": 2, "autoscaler": {"enabled": false}}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.DisableKubernetesClusterPoolAutoscaling("12345", "pool-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Autoscaler == nil || got.Autoscaler.Enabled {
+		t.Errorf("Expected autoscaler to be disabled, got %+v", got.Autoscaler)
+	}
+}
+
+func TestValidateKubernetesPoolScheduling(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		taints []corev1.Taint
+		valid  bool
+	}{
+		{"valid", map[string]string{"node.civo.com/role": "gpu"}, []corev1.Taint{{Key: "nvidia.com/gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule}}, true},
+		{"empty", nil, nil, true},
+		{"bad label key", map[string]string{"bad key": "value"}, nil, false},
+		{"bad label value", map[string]string{"role": "not valid!"}, nil, false},
+		{"bad taint effect", nil, []corev1.Taint{{Key: "app", Value: "frontend", Effect: "Never"}}, false},
+		{"empty taint key", nil, []corev1.Taint{{Value: "frontend", Effect: corev1.TaintEffectNoExecute}}, false},
+	}
+
+	for _, test := range tests {
+		err := validateKubernetesPoolScheduling(test.labels, test.taints)
+		if test.valid && err != nil {
+			t.Errorf("%s: expected no error, got %v", test.name, err)
+		}
+		if !test.valid && !errors.Is(err, InvalidKubernetesPoolError) {
+			t.Errorf("%s: expected InvalidKubernetesPoolError, got %v", test.name, err)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package civogo
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 )
 
 // Quota represents the available limits and usage for an account's Civo quota
@@ -50,7 +52,135 @@ type Quota struct {
 	DatabaseDiskGigabytesUsage int    `json:"database_disk_gb_usage"`
 }
 
-// GetQuota returns all load balancers owned by the calling API account
+// Resources accepted by the quota helpers, they match the prefix of the quota's limit and usage fields
+const (
+	QuotaResourceInstanceCount         = "instance_count"
+	QuotaResourceCPUCore               = "cpu_core"
+	QuotaResourceRAMMegabytes          = "ram_mb"
+	QuotaResourceDiskGigabytes         = "disk_gb"
+	QuotaResourceDiskVolumeCount       = "disk_volume_count"
+	QuotaResourceDiskSnapshotCount     = "disk_snapshot_count"
+	QuotaResourcePublicIPAddress       = "public_ip_address"
+	QuotaResourceSubnetCount           = "subnet_count"
+	QuotaResourceNetworkCount          = "network_count"
+	QuotaResourceSecurityGroup         = "security_group"
+	QuotaResourceSecurityGroupRule     = "security_group_rule"
+	QuotaResourcePortCount             = "port_count"
+	QuotaResourceLoadBalancerCount     = "loadbalancer_count"
+	QuotaResourceObjectStoreGigabytes  = "objectstore_gb"
+	QuotaResourceDatabaseCount         = "database_count"
+	QuotaResourceDatabaseSnapshotCount = "database_snapshot_count"
+	QuotaResourceDatabaseCPUCore       = "database_cpu_core"
+	QuotaResourceDatabaseRAMMegabytes  = "database_ram_mb"
+	QuotaResourceDatabaseDiskGigabytes = "database_disk_gb"
+)
+
+// QuotaExceededError is returned when a request would exceed one of the account's quota limits,
+// it matches QuotaLimitReachedError when used with errors.Is. When it comes from the API
+// rejecting a request only Reason is set, as the API doesn't say which limit was hit
+type QuotaExceededError struct {
+	Resource  string
+	Limit     int
+	Usage     int
+	Requested int
+	Reason    string
+}
+
+func (e *QuotaExceededError) Error() string {
+	if e.Resource == "" {
+		return fmt.Sprintf("%s: %s", QuotaLimitReachedError, e.Reason)
+	}
+	return fmt.Sprintf("%s: %s quota exceeded, requested %d with %d of %d already in use", QuotaLimitReachedError, e.Resource, e.Requested, e.Usage, e.Limit)
+}
+
+// Is allows the error to be matched against QuotaLimitReachedError
+func (e *QuotaExceededError) Is(target error) bool {
+	return target == QuotaLimitReachedError
+}
+
+// LimitAndUsage returns the limit and the current usage of a resource, one of the QuotaResource constants
+func (q *Quota) LimitAndUsage(resource string) (int, int, error) {
+	var limit, usage int
+	switch resource {
+	case QuotaResourceInstanceCount:
+		limit, usage = q.InstanceCountLimit, q.InstanceCountUsage
+	case QuotaResourceCPUCore:
+		limit, usage = q.CPUCoreLimit, q.CPUCoreUsage
+	case QuotaResourceRAMMegabytes:
+		limit, usage = q.RAMMegabytesLimit, q.RAMMegabytesUsage
+	case QuotaResourceDiskGigabytes:
+		limit, usage = q.DiskGigabytesLimit, q.DiskGigabytesUsage
+	case QuotaResourceDiskVolumeCount:
+		limit, usage = q.DiskVolumeCountLimit, q.DiskVolumeCountUsage
+	case QuotaResourceDiskSnapshotCount:
+		limit, usage = q.DiskSnapshotCountLimit, q.DiskSnapshotCountUsage
+	case QuotaResourcePublicIPAddress:
+		limit, usage = q.PublicIPAddressLimit, q.PublicIPAddressUsage
+	case QuotaResourceSubnetCount:
+		limit, usage = q.SubnetCountLimit, q.SubnetCountUsage
+	case QuotaResourceNetworkCount:
+		limit, usage = q.NetworkCountLimit, q.NetworkCountUsage
+	case QuotaResourceSecurityGroup:
+		limit, usage = q.SecurityGroupLimit, q.SecurityGroupUsage
+	case QuotaResourceSecurityGroupRule:
+		limit, usage = q.SecurityGroupRuleLimit, q.SecurityGroupRuleUsage
+	case QuotaResourcePortCount:
+		limit, usage = q.PortCountLimit, q.PortCountUsage
+	case QuotaResourceLoadBalancerCount:
+		limit, usage = q.LoadBalancerCountLimit, q.LoadBalancerCountUsage
+	case QuotaResourceObjectStoreGigabytes:
+		limit, usage = q.ObjectStoreGigabytesLimit, q.ObjectStoreGigabytesUsage
+	case QuotaResourceDatabaseCount:
+		limit, usage = q.DatabaseCountLimit, q.DatabaseCountUsage
+	case QuotaResourceDatabaseSnapshotCount:
+		limit, usage = q.DatabaseSnapshotCountLimit, q.DatabaseSnapshotCountUsage
+	case QuotaResourceDatabaseCPUCore:
+		limit, usage = q.DatabaseCPUCoreLimit, q.DatabaseCPUCoreUsage
+	case QuotaResourceDatabaseRAMMegabytes:
+		limit, usage = q.DatabaseRAMMegabytesLimit, q.DatabaseRAMMegabytesUsage
+	case QuotaResourceDatabaseDiskGigabytes:
+		limit, usage = q.DatabaseDiskGigabytesLimit, q.DatabaseDiskGigabytesUsage
+	default:
+		err := fmt.Errorf("%q isn't a quota resource", resource)
+		return 0, 0, UnknownQuotaResourceError.wrap(err)
+	}
+
+	return limit, usage, nil
+}
+
+// Headroom returns how much more of a resource can be used before reaching its limit
+func (q *Quota) Headroom(resource string) (int, error) {
+	limit, usage, err := q.LimitAndUsage(resource)
+	if err != nil {
+		return 0, err
+	}
+
+	if usage >= limit {
+		return 0, nil
+	}
+	return limit - usage, nil
+}
+
+// Check returns a *QuotaExceededError if using requested more of a resource would go over its limit
+func (q *Quota) Check(resource string, requested int) error {
+	limit, usage, err := q.LimitAndUsage(resource)
+	if err != nil {
+		return err
+	}
+
+	if usage+requested > limit {
+		return &QuotaExceededError{
+			Resource:  resource,
+			Limit:     limit,
+			Usage:     usage,
+			Requested: requested,
+		}
+	}
+
+	return nil
+}
+
+// GetQuota returns the limits and current usage of the calling API account's quota
 func (c *Client) GetQuota() (*Quota, error) {
 	resp, err := c.SendGetRequest("/v2/quota")
 	if err != nil {
@@ -64,3 +194,33 @@ func (c *Client) GetQuota() (*Quota, error) {
 
 	return &quota, nil
 }
+
+// CheckVolumeQuota checks whether a new volume of the given size fits in the account's
+// quota, returning a *QuotaExceededError describing the current usage if it doesn't
+func (c *Client) CheckVolumeQuota(ctx context.Context, sizeGB int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	quota, err := c.GetQuota()
+	if err != nil {
+		return err
+	}
+
+	if err := quota.Check(QuotaResourceDiskVolumeCount, 1); err != nil {
+		return err
+	}
+
+	return quota.Check(QuotaResourceDiskGigabytes, sizeGB)
+}
+
+// QuotaHeadroom returns how much more of a resource the account can use before reaching its
+// limit, the resource is one of the QuotaResource constants
+func (c *Client) QuotaHeadroom(resource string) (int, error) {
+	quota, err := c.GetQuota()
+	if err != nil {
+		return 0, err
+	}
+
+	return quota.Headroom(resource)
+}
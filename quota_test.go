@@ -1,6 +1,8 @@
 package civogo
 
 import (
+	"context"
+	"errors"
 	"testing"
 )
 
@@ -181,3 +183,85 @@ func TestGetQuota(t *testing.T) {
 		t.Errorf("Expected %d, got %d", 0, got.DatabaseDiskGigabytesUsage)
 	}
 }
+
+func TestCheckVolumeQuota(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/quota": `{"disk_gb_limit": 250, "disk_gb_usage": 75, "disk_volume_count_limit": 16, "disk_volume_count_usage": 6}`,
+	})
+	defer server.Close()
+
+	if err := client.CheckVolumeQuota(context.Background(), 100); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+	}
+
+	err := client.CheckVolumeQuota(context.Background(), 200)
+	if !errors.Is(err, QuotaLimitReachedError) {
+		t.Errorf("Expected %s, got %s", QuotaLimitReachedError, err)
+	}
+
+	var quotaErr *QuotaExceededError
+	if !errors.As(err, &quotaErr) {
+		t.Errorf("Expected a *QuotaExceededError, got %T", err)
+		return
+	}
+	if quotaErr.Resource != "disk_gb" || quotaErr.Usage != 75 || quotaErr.Limit != 250 || quotaErr.Requested != 200 {
+		t.Errorf("Unexpected quota error %+v", quotaErr)
+	}
+}
+
+func TestCheckVolumeQuotaVolumeCount(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/quota": `{"disk_gb_limit": 250, "disk_gb_usage": 75, "disk_volume_count_limit": 16, "disk_volume_count_usage": 16}`,
+	})
+	defer server.Close()
+
+	var quotaErr *QuotaExceededError
+	err := client.CheckVolumeQuota(context.Background(), 10)
+	if !errors.As(err, &quotaErr) || quotaErr.Resource != "disk_volume_count" {
+		t.Errorf("Expected a disk_volume_count quota error, got %s", err)
+	}
+}
+
+func TestQuotaHeadroom(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/quota": `{"instance_count_limit": 16, "instance_count_usage": 10, "cpu_core_limit": 16, "cpu_core_usage": 18}`,
+	})
+	defer server.Close()
+
+	got, err := client.QuotaHeadroom(QuotaResourceInstanceCount)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got != 6 {
+		t.Errorf("Expected %d, got %d", 6, got)
+	}
+
+	quota, _ := client.GetQuota()
+	if headroom, _ := quota.Headroom(QuotaResourceCPUCore); headroom != 0 {
+		t.Errorf("Expected no headroom when usage is over the limit, got %d", headroom)
+	}
+	if _, err := quota.Headroom("gpus"); !errors.Is(err, UnknownQuotaResourceError) {
+		t.Errorf("Expected UnknownQuotaResourceError, got %v", err)
+	}
+}
+
+func TestDecodeErrorQuotaLimitReached(t *testing.T) {
+	err := decodeError(HTTPError{
+		Code:   403,
+		Status: "403 Forbidden",
+		Reason: `{"code": "quota_limit_reached", "reason": "The quota for instances has been reached"}`,
+	})
+
+	var quotaErr *QuotaExceededError
+	if !errors.As(err, &quotaErr) || !errors.Is(err, QuotaLimitReachedError) {
+		t.Errorf("Expected a *QuotaExceededError, got %T %v", err, err)
+		return
+	}
+	if quotaErr.Reason != "The quota for instances has been reached" {
+		t.Errorf("Unexpected reason %q", quotaErr.Reason)
+	}
+	if decodeError(err) != err {
+		t.Errorf("Expected decoding the error again to leave it as it is")
+	}
+}
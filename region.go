@@ -34,6 +34,59 @@ type Feature struct {
 	PublicIPNodePools bool `json:"public_ip_node_pools"`
 }
 
+// RegionFeature is the name of a feature that may or may not be available in a region, the
+// values match the keys of the region's features in the API
+type RegionFeature string
+
+// Features that can be checked with Region.Supports and RegionSupports
+const (
+	RegionFeatureIaaS              RegionFeature = "iaas"
+	RegionFeatureKubernetes        RegionFeature = "kubernetes"
+	RegionFeatureObjectStore       RegionFeature = "object_store"
+	RegionFeatureLoadBalancer      RegionFeature = "loadbalancer"
+	RegionFeatureGPU               RegionFeature = "gpu"
+	RegionFeatureDBaaS             RegionFeature = "dbaas"
+	RegionFeatureVolume            RegionFeature = "volume"
+	RegionFeaturePaaS              RegionFeature = "paas"
+	RegionFeatureKFaaS             RegionFeature = "kfaas"
+	RegionFeaturePublicIPNodePools RegionFeature = "public_ip_node_pools"
+)
+
+// Has reports whether the feature is enabled, returning an error for unknown features
+func (f Feature) Has(feature RegionFeature) (bool, error) {
+	switch feature {
+	case RegionFeatureIaaS:
+		return f.Iaas, nil
+	case RegionFeatureKubernetes:
+		return f.Kubernetes, nil
+	case RegionFeatureObjectStore:
+		return f.ObjectStore, nil
+	case RegionFeatureLoadBalancer:
+		return f.LoadBalancer, nil
+	case RegionFeatureGPU:
+		return f.GPU, nil
+	case RegionFeatureDBaaS:
+		return f.DBaaS, nil
+	case RegionFeatureVolume:
+		return f.Volume, nil
+	case RegionFeaturePaaS:
+		return f.PaaS, nil
+	case RegionFeatureKFaaS:
+		return f.KFaaS, nil
+	case RegionFeaturePublicIPNodePools:
+		return f.PublicIPNodePools, nil
+	}
+
+	err := fmt.Errorf("%q isn't a region feature", feature)
+	return false, UnknownRegionFeatureError.wrap(err)
+}
+
+// Supports reports whether the region has the feature enabled, unknown features are never supported
+func (r *Region) Supports(feature RegionFeature) bool {
+	supported, err := r.Features.Has(feature)
+	return err == nil && supported
+}
+
 // CreateRegionRequest is the request to create a new region
 type CreateRegionRequest struct {
 	Code           string   `json:"code"`
@@ -57,7 +110,7 @@ type ConnectRegionRequest struct {
 	Code string `json:"code"`
 }
 
-// ListRegions returns all load balancers owned by the calling API account
+// ListRegions returns all the regions available to the calling API account, with their features
 func (c *Client) ListRegions() ([]Region, error) {
 	resp, err := c.SendGetRequest("/v2/regions")
 	if err != nil {
@@ -126,6 +179,28 @@ func (c *Client) GetDefaultRegion() (*Region, error) {
 	return nil, errors.New("no default region found")
 }
 
+// RegionSupports reports whether a region has a feature enabled, so requests can be checked
+// before they're sent. An empty region checks the client's region
+func (c *Client) RegionSupports(region string, feature RegionFeature) (bool, error) {
+	if region == "" {
+		region = c.Region
+	}
+
+	regions, err := c.ListRegions()
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range regions {
+		if strings.EqualFold(r.Code, region) {
+			return r.Features.Has(feature)
+		}
+	}
+
+	err = fmt.Errorf("unable to find region %s, zero matches", region)
+	return false, ZeroMatchesError.wrap(err)
+}
+
 // CreateRegion is a function to create a region
 func (c *Client) CreateRegion(r *CreateRegionRequest) (*Region, error) {
 	resp, err := c.SendPostRequest("/v2/regions", r)
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -129,3 +130,32 @@ func TestDisconnectRegion(t *testing.T) {
 		t.Errorf("Request returned an error: %s", err)
 	}
 }
+
+func TestRegionSupports(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/regions": `[{"code":"NYC1","name":"New York 1","features":{"iaas":true,"kubernetes":true,"gpu":false}},{"code":"TEST","name":"Test 1","features":{"gpu":true}}]`,
+	})
+	defer server.Close()
+
+	got, err := client.RegionSupports("nyc1", RegionFeatureKubernetes)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if !got {
+		t.Errorf("Expected NYC1 to support kubernetes")
+	}
+
+	if got, _ := client.RegionSupports("NYC1", RegionFeatureGPU); got {
+		t.Errorf("Expected NYC1 not to support GPUs")
+	}
+	if got, _ := client.RegionSupports("", RegionFeatureGPU); !got {
+		t.Errorf("Expected the client's region to support GPUs")
+	}
+	if _, err := client.RegionSupports("NYC1", "quantum"); !errors.Is(err, UnknownRegionFeatureError) {
+		t.Errorf("Expected UnknownRegionFeatureError, got %v", err)
+	}
+	if _, err := client.RegionSupports("LON1", RegionFeatureGPU); !errors.Is(err, ZeroMatchesError) {
+		t.Errorf("Expected ZeroMatchesError, got %v", err)
+	}
+}
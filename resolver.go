@@ -0,0 +1,234 @@
+package civogo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// AmbiguousResourceError is returned by Resolve when a search matches more than one resource,
+// it matches MultipleMatchesError with errors.Is
+type AmbiguousResourceError struct {
+	Type    ResourceType
+	Search  string
+	Matches []ResourceNode
+}
+
+func (e *AmbiguousResourceError) Error() string {
+	names := make([]string, 0, len(e.Matches))
+	for _, match := range e.Matches {
+		names = append(names, fmt.Sprintf("%s (%s)", match.Name, match.ID))
+	}
+	return fmt.Sprintf("%s: unable to find %s %s because there were multiple matches: %s", MultipleMatchesError, e.Type, e.Search, strings.Join(names, ", "))
+}
+
+// Is makes the error match MultipleMatchesError
+func (e *AmbiguousResourceError) Is(target error) bool {
+	return target == MultipleMatchesError
+}
+
+// Resolve finds a resource of the given type by its name or ID. An exact ID match wins, then an
+// exact name match and finally a single resource whose ID or name contains the search. More than
+// one match at the same step returns an AmbiguousResourceError and no match a ZeroMatchesError.
+func (c *Client) Resolve(ctx context.Context, t ResourceType, search string) (*ResourceNode, error) {
+	return c.resolve(ctx, t, search, false)
+}
+
+// resolve finds a resource like Resolve, with exact set only by its full ID or name
+func (c *Client) resolve(ctx context.Context, t ResourceType, search string, exact bool) (*ResourceNode, error) {
+	list, ok := resolvers[t]
+	if !ok {
+		err := fmt.Errorf("%q isn't a resource type that can be resolved", t)
+		return nil, UnknownResourceTypeError.wrap(err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	nodes, err := list(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveNode(t, search, nodes, exact)
+}
+
+// resolveNode picks the resource matching search, see Resolve. With exact set a resource
+// whose ID or name only contains the search isn't a match.
+func resolveNode(t ResourceType, search string, nodes []ResourceNode, exact bool) (*ResourceNode, error) {
+	for i := range nodes {
+		if nodes[i].ID == search {
+			return &nodes[i], nil
+		}
+	}
+
+	steps := []func(ResourceNode) bool{
+		func(n ResourceNode) bool { return n.Name == search },
+		func(n ResourceNode) bool { return strings.Contains(n.ID, search) || strings.Contains(n.Name, search) },
+	}
+	if exact {
+		steps = steps[:1]
+	}
+	for _, matches := range steps {
+		var found []ResourceNode
+		for _, node := range nodes {
+			if matches(node) {
+				found = append(found, node)
+			}
+		}
+
+		switch {
+		case len(found) == 1:
+			return &found[0], nil
+		case len(found) > 1:
+			sortResourceNodes(found)
+			return nil, &AmbiguousResourceError{Type: t, Search: search, Matches: found}
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s %s, zero matches", t, search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// resolveID returns the ID of the resource named by nameOrID when the client has ResolveNames
+// set, otherwise it's returned unchanged
+func (c *Client) resolveID(t ResourceType, nameOrID string) (string, error) {
+	return c.resolveIDMatching(t, nameOrID, false)
+}
+
+// resolveExactID is resolveID for calls that destroy or detach a resource, a typo there mustn't
+// pick another resource that happens to contain it, so only an exact ID or name matches
+func (c *Client) resolveExactID(t ResourceType, nameOrID string) (string, error) {
+	return c.resolveIDMatching(t, nameOrID, true)
+}
+
+// resolveIDMatching is shared by resolveID and resolveExactID
+func (c *Client) resolveIDMatching(t ResourceType, nameOrID string, exact bool) (string, error) {
+	if !c.ResolveNames || nameOrID == "" {
+		return nameOrID, nil
+	}
+
+	node, err := c.resolve(context.Background(), t, nameOrID, exact)
+	if err != nil {
+		return "", err
+	}
+	return node.ID, nil
+}
+
+// resolvers list the resources of each type that Resolve can find
+var resolvers = map[ResourceType]func(c *Client) ([]ResourceNode, error){
+	ResourceTypeKubernetesCluster: func(c *Client) ([]ResourceNode, error) {
+		clusters, err := listAllPages[KubernetesCluster](c, "/v2/kubernetes/clusters")
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]ResourceNode, 0, len(clusters))
+		for _, cluster := range clusters {
+			nodes = append(nodes, ResourceNode{Type: ResourceTypeKubernetesCluster, ID: cluster.ID, Name: cluster.Name})
+		}
+		return nodes, nil
+	},
+	ResourceTypeInstance: func(c *Client) ([]ResourceNode, error) {
+		instances, err := c.ListAllInstances()
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]ResourceNode, 0, len(instances))
+		for _, instance := range instances {
+			nodes = append(nodes, ResourceNode{Type: ResourceTypeInstance, ID: instance.ID, Name: instance.Hostname})
+		}
+		return nodes, nil
+	},
+	ResourceTypeVolume: func(c *Client) ([]ResourceNode, error) {
+		volumes, err := c.ListVolumes()
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]ResourceNode, 0, len(volumes))
+		for _, volume := range volumes {
+			nodes = append(nodes, ResourceNode{Type: ResourceTypeVolume, ID: volume.ID, Name: volume.Name})
+		}
+		return nodes, nil
+	},
+	ResourceTypeFirewall: func(c *Client) ([]ResourceNode, error) {
+		firewalls, err := c.ListFirewalls()
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]ResourceNode, 0, len(firewalls))
+		for _, firewall := range firewalls {
+			nodes = append(nodes, ResourceNode{Type: ResourceTypeFirewall, ID: firewall.ID, Name: firewall.Name})
+		}
+		return nodes, nil
+	},
+	ResourceTypeNetwork: func(c *Client) ([]ResourceNode, error) {
+		networks, err := c.ListNetworks()
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]ResourceNode, 0, len(networks))
+		for _, network := range networks {
+			name := network.Label
+			if name == "" {
+				name = network.Name
+			}
+			nodes = append(nodes, ResourceNode{Type: ResourceTypeNetwork, ID: network.ID, Name: name})
+		}
+		return nodes, nil
+	},
+	ResourceTypeLoadBalancer: func(c *Client) ([]ResourceNode, error) {
+		loadBalancers, err := c.ListLoadBalancers()
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]ResourceNode, 0, len(loadBalancers))
+		for _, lb := range loadBalancers {
+			nodes = append(nodes, ResourceNode{Type: ResourceTypeLoadBalancer, ID: lb.ID, Name: lb.Name})
+		}
+		return nodes, nil
+	},
+	ResourceTypeDatabase: func(c *Client) ([]ResourceNode, error) {
+		databases, err := listAllPages[Database](c, "/v2/databases")
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]ResourceNode, 0, len(databases))
+		for _, database := range databases {
+			nodes = append(nodes, ResourceNode{Type: ResourceTypeDatabase, ID: database.ID, Name: database.Name})
+		}
+		return nodes, nil
+	},
+	ResourceTypeObjectStore: func(c *Client) ([]ResourceNode, error) {
+		stores, err := listAllPages[ObjectStore](c, "/v2/objectstores")
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]ResourceNode, 0, len(stores))
+		for _, store := range stores {
+			nodes = append(nodes, ResourceNode{Type: ResourceTypeObjectStore, ID: store.ID, Name: store.Name})
+		}
+		return nodes, nil
+	},
+	ResourceTypeSSHKey: func(c *Client) ([]ResourceNode, error) {
+		keys, err := c.ListSSHKeys()
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]ResourceNode, 0, len(keys))
+		for _, key := range keys {
+			nodes = append(nodes, ResourceNode{Type: ResourceTypeSSHKey, ID: key.ID, Name: key.Name})
+		}
+		return nodes, nil
+	},
+	ResourceTypeReservedIP: func(c *Client) ([]ResourceNode, error) {
+		ips, err := listAllPages[IP](c, "/v2/ips")
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]ResourceNode, 0, len(ips))
+		for _, ip := range ips {
+			nodes = append(nodes, ResourceNode{Type: ResourceTypeReservedIP, ID: ip.ID, Name: ip.Name})
+		}
+		return nodes, nil
+	},
+}
@@ -0,0 +1,119 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes": {`[
+			{"id": "aaaa-1111", "name": "web-data"},
+			{"id": "bbbb-2222", "name": "web-data-backup"},
+			{"id": "cccc-3333", "name": "db-data"},
+			{"id": "dddd-4444", "name": "aaaa-1111"}
+		]`},
+	})
+	defer server.Close()
+
+	cases := map[string]string{
+		"aaaa-1111":       "aaaa-1111",
+		"web-data":        "aaaa-1111",
+		"web-data-backup": "bbbb-2222",
+		"db":              "cccc-3333",
+		"dddd":            "dddd-4444",
+	}
+	for search, expected := range cases {
+		got, err := client.Resolve(context.Background(), ResourceTypeVolume, search)
+		if err != nil {
+			t.Errorf("Resolving %s returned an error: %s", search, err)
+			continue
+		}
+		if got.ID != expected || got.Type != ResourceTypeVolume {
+			t.Errorf("Expected %s to resolve to %s, got %+v", search, expected, got)
+		}
+	}
+}
+
+func TestResolveAmbiguous(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/firewalls": {`[{"id": "f-1", "name": "web-1"}, {"id": "f-2", "name": "web-2"}]`},
+	})
+	defer server.Close()
+
+	_, err := client.Resolve(context.Background(), ResourceTypeFirewall, "web")
+	if !errors.Is(err, MultipleMatchesError) {
+		t.Errorf("Expected %s, got %v", MultipleMatchesError, err)
+	}
+
+	var ambiguous *AmbiguousResourceError
+	if !errors.As(err, &ambiguous) {
+		t.Errorf("Expected an AmbiguousResourceError, got %T", err)
+		return
+	}
+	if len(ambiguous.Matches) != 2 || ambiguous.Matches[0].Name != "web-1" || ambiguous.Type != ResourceTypeFirewall {
+		t.Errorf("Expected both firewalls as matches, got %+v", ambiguous.Matches)
+	}
+
+	_, err = client.Resolve(context.Background(), ResourceTypeFirewall, "db")
+	if !errors.Is(err, ZeroMatchesError) {
+		t.Errorf("Expected %s, got %v", ZeroMatchesError, err)
+	}
+
+	_, err = client.Resolve(context.Background(), ResourceType("teapot"), "db")
+	if !errors.Is(err, UnknownResourceTypeError) {
+		t.Errorf("Expected %s, got %v", UnknownResourceTypeError, err)
+	}
+}
+
+func TestAttachVolumeResolveNames(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes":              {`[{"id": "12345", "name": "web-data"}]`},
+		"GET /v2/instances":            {`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "67890", "hostname": "web"}]}`},
+		"PUT /v2/volumes/12345/attach": {`{"id": "12345", "result": "success"}`},
+	})
+	defer server.Close()
+
+	if _, err := client.AttachVolume("web-data", VolumeAttachConfig{InstanceID: "web"}); err == nil {
+		t.Errorf("Expected names not to be resolved unless ResolveNames is set")
+	}
+
+	client.ResolveNames = true
+	got, err := client.AttachVolume("web-data", VolumeAttachConfig{InstanceID: "web"})
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestDeleteResolveNamesExactOnly(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes":          {`[{"id": "12345", "name": "web-data"}, {"id": "67890", "name": "db-data"}]`},
+		"DELETE /v2/volumes/12345": {`{"result": "success"}`},
+		"DELETE /v2/volumes/67890": {`{"result": "success"}`},
+	})
+	defer server.Close()
+	client.ResolveNames = true
+
+	for _, search := range []string{"web", "1234", "db-dat"} {
+		if _, err := client.DeleteVolume(search); !errors.Is(err, ZeroMatchesError) {
+			t.Errorf("Expected %q not to match a volume, got %v", search, err)
+		}
+	}
+
+	got, err := client.DeleteVolume("web-data")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestResolvePagedResources(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/kubernetes/clusters": {
+			`{"page": 1, "per_page": 100, "pages": 2, "items": [{"id": "c-1", "name": "web"}]}`,
+			`{"page": 2, "per_page": 100, "pages": 2, "items": [{"id": "c-2", "name": "db"}]}`,
+		},
+		"DELETE /v2/kubernetes/clusters/c-2": {`{"result": "success"}`},
+	})
+	defer server.Close()
+	client.ResolveNames = true
+
+	got, err := client.DeleteKubernetesCluster("c-2")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
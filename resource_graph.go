@@ -0,0 +1,314 @@
+package civogo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ResourceRef identifies a resource in a ResourceGraph
+type ResourceRef struct {
+	Type ResourceType
+	ID   string
+}
+
+// ResourceNode is a resource in a ResourceGraph
+type ResourceNode struct {
+	Type ResourceType
+	ID   string
+	Name string
+}
+
+// Ref returns the reference used to look the node up in its graph
+func (n ResourceNode) Ref() ResourceRef {
+	return ResourceRef{Type: n.Type, ID: n.ID}
+}
+
+// ResourceGraph links the resources of an account to the resources they use, e.g. an instance
+// uses its network and firewall, and a volume uses the instance it's attached to
+type ResourceGraph struct {
+	nodes        map[ResourceRef]ResourceNode
+	dependencies map[ResourceRef][]ResourceRef
+	dependents   map[ResourceRef][]ResourceRef
+}
+
+// Nodes returns every resource in the graph, sorted by type and name
+func (g *ResourceGraph) Nodes() []ResourceNode {
+	nodes := make([]ResourceNode, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		nodes = append(nodes, node)
+	}
+	sortResourceNodes(nodes)
+	return nodes
+}
+
+// Node returns the resource for a reference, if it's in the graph
+func (g *ResourceGraph) Node(ref ResourceRef) (ResourceNode, bool) {
+	node, ok := g.nodes[ref]
+	return node, ok
+}
+
+// DependenciesOf returns the resources directly used by ref
+func (g *ResourceGraph) DependenciesOf(ref ResourceRef) []ResourceNode {
+	return g.resolve(g.dependencies[ref])
+}
+
+// DependentsOf returns the resources directly using ref, which block it from being deleted
+func (g *ResourceGraph) DependentsOf(ref ResourceRef) []ResourceNode {
+	return g.resolve(g.dependents[ref])
+}
+
+// AllDependentsOf returns every resource that uses ref, directly or through other resources
+func (g *ResourceGraph) AllDependentsOf(ref ResourceRef) []ResourceNode {
+	seen := map[ResourceRef]bool{ref: true}
+	queue := []ResourceRef{ref}
+	var found []ResourceRef
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, dependent := range g.dependents[current] {
+			if !seen[dependent] {
+				seen[dependent] = true
+				found = append(found, dependent)
+				queue = append(queue, dependent)
+			}
+		}
+	}
+	return g.resolve(found)
+}
+
+// DeletionOrder returns the resources that need deleting to delete refs, which is refs and
+// everything that depends on them, ordered so each resource comes before the ones it uses
+func (g *ResourceGraph) DeletionOrder(refs ...ResourceRef) ([]ResourceNode, error) {
+	include := map[ResourceRef]bool{}
+	for _, ref := range refs {
+		if _, ok := g.nodes[ref]; !ok {
+			err := fmt.Errorf("unable to find %s %s in the resource graph, zero matches", ref.Type, ref.ID)
+			return nil, ZeroMatchesError.wrap(err)
+		}
+		include[ref] = true
+		for _, dependent := range g.AllDependentsOf(ref) {
+			include[dependent.Ref()] = true
+		}
+	}
+
+	// count how many included dependents each resource is waiting on
+	waiting := map[ResourceRef]int{}
+	for ref := range include {
+		for _, dependent := range g.dependents[ref] {
+			if include[dependent] {
+				waiting[ref]++
+			}
+		}
+	}
+
+	var ready []ResourceNode
+	for ref := range include {
+		if waiting[ref] == 0 {
+			ready = append(ready, g.nodes[ref])
+		}
+	}
+
+	order := make([]ResourceNode, 0, len(include))
+	for len(ready) > 0 {
+		sortResourceNodes(ready)
+		node := ready[0]
+		ready = ready[1:]
+		order = append(order, node)
+
+		for _, dependency := range g.dependencies[node.Ref()] {
+			if !include[dependency] {
+				continue
+			}
+			waiting[dependency]--
+			if waiting[dependency] == 0 {
+				ready = append(ready, g.nodes[dependency])
+			}
+		}
+	}
+
+	if len(order) != len(include) {
+		err := fmt.Errorf("the resources depend on each other in a cycle so can't be ordered")
+		return nil, InvalidResourceGraphError.wrap(err)
+	}
+
+	return order, nil
+}
+
+func (g *ResourceGraph) addNode(t ResourceType, id, name string) {
+	g.nodes[ResourceRef{Type: t, ID: id}] = ResourceNode{Type: t, ID: id, Name: name}
+}
+
+// link records that from uses to, references to resources that aren't in the graph are skipped
+func (g *ResourceGraph) link(from ResourceRef, toType ResourceType, toID string) {
+	to := ResourceRef{Type: toType, ID: toID}
+	if toID == "" || from == to {
+		return
+	}
+	if _, ok := g.nodes[to]; !ok {
+		return
+	}
+	for _, existing := range g.dependencies[from] {
+		if existing == to {
+			return
+		}
+	}
+
+	g.dependencies[from] = append(g.dependencies[from], to)
+	g.dependents[to] = append(g.dependents[to], from)
+}
+
+func (g *ResourceGraph) resolve(refs []ResourceRef) []ResourceNode {
+	nodes := make([]ResourceNode, 0, len(refs))
+	for _, ref := range refs {
+		nodes = append(nodes, g.nodes[ref])
+	}
+	sortResourceNodes(nodes)
+	return nodes
+}
+
+// sortResourceNodes sorts nodes in the same order as cleanupOrder, then by name and ID
+func sortResourceNodes(nodes []ResourceNode) {
+	rank := map[ResourceType]int{ResourceTypeLoadBalancer: -1}
+	for i, t := range cleanupOrder {
+		rank[t] = i
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Type != nodes[j].Type {
+			return rank[nodes[i].Type] < rank[nodes[j].Type]
+		}
+		if nodes[i].Name != nodes[j].Name {
+			return nodes[i].Name < nodes[j].Name
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+}
+
+// BuildResourceGraph lists the networks, firewalls, clusters, instances, volumes and load
+// balancers in the client's region and links each one to the resources it uses
+func (c *Client) BuildResourceGraph(ctx context.Context) (*ResourceGraph, error) {
+	g := &ResourceGraph{
+		nodes:        map[ResourceRef]ResourceNode{},
+		dependencies: map[ResourceRef][]ResourceRef{},
+		dependents:   map[ResourceRef][]ResourceRef{},
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	networks, err := c.ListNetworks()
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	firewalls, err := c.ListFirewalls()
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	clusters, err := listAllPages[KubernetesCluster](c, "/v2/kubernetes/clusters")
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	instances, err := c.ListAllInstances()
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	volumes, err := c.ListVolumes()
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	loadBalancers, err := c.ListLoadBalancers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, network := range networks {
+		name := network.Label
+		if name == "" {
+			name = network.Name
+		}
+		g.addNode(ResourceTypeNetwork, network.ID, name)
+	}
+	for _, firewall := range firewalls {
+		g.addNode(ResourceTypeFirewall, firewall.ID, firewall.Name)
+	}
+	for _, cluster := range clusters {
+		g.addNode(ResourceTypeKubernetesCluster, cluster.ID, cluster.Name)
+	}
+	for _, instance := range instances {
+		g.addNode(ResourceTypeInstance, instance.ID, instance.Hostname)
+	}
+	for _, volume := range volumes {
+		g.addNode(ResourceTypeVolume, volume.ID, volume.Name)
+	}
+	for _, lb := range loadBalancers {
+		g.addNode(ResourceTypeLoadBalancer, lb.ID, lb.Name)
+	}
+
+	for _, firewall := range firewalls {
+		g.link(ResourceRef{Type: ResourceTypeFirewall, ID: firewall.ID}, ResourceTypeNetwork, firewall.NetworkID)
+	}
+	for _, cluster := range clusters {
+		ref := ResourceRef{Type: ResourceTypeKubernetesCluster, ID: cluster.ID}
+		g.link(ref, ResourceTypeNetwork, cluster.NetworkID)
+		g.link(ref, ResourceTypeFirewall, cluster.FirewallID)
+	}
+
+	instancesByIP := map[string]string{}
+	instancesByName := map[string]string{}
+	for _, instance := range instances {
+		ref := ResourceRef{Type: ResourceTypeInstance, ID: instance.ID}
+		g.link(ref, ResourceTypeNetwork, instance.NetworkID)
+		g.link(ref, ResourceTypeFirewall, instance.FirewallID)
+
+		for _, ip := range []string{instance.PrivateIP, instance.PublicIP} {
+			if ip != "" {
+				instancesByIP[ip] = instance.ID
+			}
+		}
+		instancesByName[instance.Hostname] = instance.ID
+	}
+
+	for _, volume := range volumes {
+		ref := ResourceRef{Type: ResourceTypeVolume, ID: volume.ID}
+		g.link(ref, ResourceTypeInstance, volume.InstanceID)
+		g.link(ref, ResourceTypeKubernetesCluster, volume.ClusterID)
+		g.link(ref, ResourceTypeNetwork, volume.NetworkID)
+	}
+
+	for _, lb := range loadBalancers {
+		ref := ResourceRef{Type: ResourceTypeLoadBalancer, ID: lb.ID}
+		g.link(ref, ResourceTypeNetwork, lb.NetworkID)
+		g.link(ref, ResourceTypeFirewall, lb.FirewallID)
+		g.link(ref, ResourceTypeKubernetesCluster, lb.ClusterID)
+
+		for _, backend := range lb.Backends {
+			instanceID := backend.InstanceID
+			if instanceID == "" {
+				instanceID = instancesByIP[backend.IP]
+			}
+			g.link(ref, ResourceTypeInstance, instanceID)
+		}
+		for _, pool := range lb.InstancePool {
+			for _, name := range pool.Names {
+				g.link(ref, ResourceTypeInstance, instancesByName[name])
+			}
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,100 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestBuildResourceGraph(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/networks":  {`[{"id": "net-1", "label": "ci-net"}]`},
+		"GET /v2/firewalls": {`[{"id": "fw-1", "name": "ci-fw", "network_id": "net-1"}]`},
+		"GET /v2/kubernetes/clusters": {`{"page": 1, "per_page": 20, "pages": 1, "items": [
+			{"id": "k-1", "name": "ci-cluster", "network_id": "net-1", "firewall_id": "fw-1"}
+		]}`},
+		"GET /v2/instances": {`{"page": 1, "per_page": 20, "pages": 1, "items": [
+			{"id": "i-1", "hostname": "ci-web", "network_id": "net-1", "firewall_id": "fw-1", "private_ip": "192.168.1.10"}
+		]}`},
+		"GET /v2/volumes": {`[
+			{"id": "vol-1", "name": "ci-data", "instance_id": "i-1", "network_id": "net-1"},
+			{"id": "vol-2", "name": "ci-pvc", "cluster_id": "k-1", "network_id": "net-1"}
+		]`},
+		"GET /v2/loadbalancers": {`[
+			{"id": "lb-1", "name": "ci-lb", "network_id": "net-1", "firewall_id": "fw-1", "backends": [{"ip": "192.168.1.10", "source_port": 80, "target_port": 8080}]}
+		]`},
+	})
+	defer server.Close()
+
+	g, err := client.BuildResourceGraph(context.Background())
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+
+	if len(g.Nodes()) != 7 {
+		t.Errorf("Expected 7 nodes, got %+v", g.Nodes())
+	}
+
+	instance := ResourceRef{Type: ResourceTypeInstance, ID: "i-1"}
+	dependents := g.DependentsOf(instance)
+	if len(dependents) != 2 || dependents[0].ID != "lb-1" || dependents[1].ID != "vol-1" {
+		t.Errorf("Expected the load balancer and volume to depend on the instance, got %+v", dependents)
+	}
+	dependencies := g.DependenciesOf(instance)
+	if len(dependencies) != 2 || dependencies[0].ID != "fw-1" || dependencies[1].ID != "net-1" {
+		t.Errorf("Expected the instance to use the firewall and network, got %+v", dependencies)
+	}
+
+	network := ResourceRef{Type: ResourceTypeNetwork, ID: "net-1"}
+	if got := g.AllDependentsOf(network); len(got) != 6 {
+		t.Errorf("Expected everything else to depend on the network, got %+v", got)
+	}
+
+	order, err := g.DeletionOrder(network)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err)
+	}
+	position := map[string]int{}
+	for i, node := range order {
+		position[node.ID] = i
+	}
+	for _, node := range order {
+		for _, dependency := range g.DependenciesOf(node.Ref()) {
+			if position[node.ID] > position[dependency.ID] {
+				t.Errorf("Expected %s to be deleted before %s, got %+v", node.ID, dependency.ID, order)
+			}
+		}
+	}
+	if order[len(order)-1].ID != "net-1" {
+		t.Errorf("Expected the network to be deleted last, got %+v", order)
+	}
+
+	if _, err := g.DeletionOrder(ResourceRef{Type: ResourceTypeNetwork, ID: "missing"}); !errors.Is(err, ZeroMatchesError) {
+		t.Errorf("Expected ZeroMatchesError, got %v", err)
+	}
+}
+
+func TestBuildResourceGraphPagedClusters(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/networks":  {`[{"id": "net-1", "label": "ci-net"}]`},
+		"GET /v2/firewalls": {`[]`},
+		"GET /v2/kubernetes/clusters": {
+			`{"page": 1, "per_page": 100, "pages": 2, "items": [{"id": "k-1", "name": "ci-other", "network_id": "net-2"}]}`,
+			`{"page": 2, "per_page": 100, "pages": 2, "items": [{"id": "k-2", "name": "ci-cluster", "network_id": "net-1"}]}`,
+		},
+		"GET /v2/instances":     {`{"page": 1, "per_page": 20, "pages": 1, "items": []}`},
+		"GET /v2/volumes":       {`[]`},
+		"GET /v2/loadbalancers": {`[]`},
+	})
+	defer server.Close()
+
+	g, err := client.BuildResourceGraph(context.Background())
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+
+	network := ResourceRef{Type: ResourceTypeNetwork, ID: "net-1"}
+	if got := g.DependentsOf(network); len(got) != 1 || got[0].ID != "k-2" {
+		t.Errorf("Expected the cluster on the second page to depend on the network, got %+v", got)
+	}
+}
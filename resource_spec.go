@@ -0,0 +1,377 @@
+package civogo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ResourceSpecVersion is the current version of the resource spec schema
+const ResourceSpecVersion = 1
+
+// ResourceSpec is a declarative description of the networks, firewalls, volumes and instances
+// in a region, made of the same config structs used to create them. Each entry keeps the ID of
+// the resource it was exported from so references between them, e.g. an instance's network,
+// can be pointed at the newly created resources when the spec is imported.
+type ResourceSpec struct {
+	Version   int            `json:"version"`
+	Networks  []NetworkSpec  `json:"networks"`
+	Firewalls []FirewallSpec `json:"firewalls"`
+	Volumes   []VolumeSpec   `json:"volumes"`
+	Instances []InstanceSpec `json:"instances"`
+}
+
+// NetworkSpec is a network in a ResourceSpec, the default network is recorded so resources
+// can reference it but is never created, the target region's default network is used instead
+type NetworkSpec struct {
+	SourceID string        `json:"source_id"`
+	Default  bool          `json:"default,omitempty"`
+	Config   NetworkConfig `json:"config"`
+}
+
+// FirewallSpec is a firewall and its rules in a ResourceSpec
+type FirewallSpec struct {
+	SourceID string               `json:"source_id"`
+	Config   FirewallConfig       `json:"config"`
+	Rules    []FirewallRuleConfig `json:"rules"`
+}
+
+// VolumeSpec is a volume in a ResourceSpec, volumes attached to an instance are attached to
+// the new instance when it's created
+type VolumeSpec struct {
+	SourceID string       `json:"source_id"`
+	Config   VolumeConfig `json:"config"`
+}
+
+// InstanceSpec is an instance in a ResourceSpec
+type InstanceSpec struct {
+	SourceID string         `json:"source_id"`
+	Config   InstanceConfig `json:"config"`
+}
+
+// ResourceSpecImport is the outcome of ImportResourceSpec, mapping the source resources to the
+// IDs of the resources created from them
+type ResourceSpecImport struct {
+	IDs map[ResourceRef]string
+}
+
+// Config returns the config that would create a copy of the network, the region is left empty
+// so it defaults to the client's region
+func (n *Network) Config() NetworkConfig {
+	label := n.Label
+	if label == "" {
+		label = n.Name
+	}
+	ipv4Enabled, ipv6Enabled := n.IPv4Enabled, n.IPv6Enabled
+
+	config := NetworkConfig{
+		Label:         label,
+		IPv4Enabled:   &ipv4Enabled,
+		NameserversV4: append([]string(nil), n.NameserversV4...),
+		CIDRv4:        n.CIDR,
+		IPv6Enabled:   &ipv6Enabled,
+		NameserversV6: append([]string(nil), n.NameserversV6...),
+	}
+	if n.VlanID != 0 {
+		config.VLanConfig = &VLANConnectConfig{
+			VlanID:                n.VlanID,
+			PhysicalInterface:     n.PhysicalInterface,
+			HardwareAddr:          n.HardwareAddr,
+			CIDRv4:                n.CIDR,
+			GatewayIPv4:           n.GatewayIPv4,
+			AllocationPoolV4Start: n.AllocationPoolV4Start,
+			AllocationPoolV4End:   n.AllocationPoolV4End,
+		}
+	}
+
+	return config
+}
+
+// Config returns the config that would create a copy of the firewall without any rules, the
+// rules are converted separately with FirewallRule.Config
+func (f *Firewall) Config() FirewallConfig {
+	createRules := false
+	return FirewallConfig{
+		Name:        f.Name,
+		NetworkID:   f.NetworkID,
+		CreateRules: &createRules,
+	}
+}
+
+// Config returns the config that would create a copy of the rule, the firewall ID is left
+// empty so the rule can be added to any firewall
+func (r *FirewallRule) Config() FirewallRuleConfig {
+	return FirewallRuleConfig{
+		Protocol:  r.Protocol,
+		StartPort: r.StartPort,
+		EndPort:   r.EndPort,
+		Cidr:      append([]string(nil), r.Cidr...),
+		Direction: r.Direction,
+		Action:    r.Action,
+		Label:     r.Label,
+		Ports:     r.Ports,
+	}
+}
+
+// Config returns the config that would create a copy of the volume, the region is left empty
+// so it defaults to the client's region
+func (v *Volume) Config() VolumeConfig {
+	return VolumeConfig{
+		Name:          v.Name,
+		ClusterID:     v.ClusterID,
+		NetworkID:     v.NetworkID,
+		SizeGigabytes: v.SizeGigabytes,
+		Bootable:      v.Bootable,
+		VolumeType:    v.VolumeType,
+	}
+}
+
+// Config returns the config that would create a copy of the instance. The addresses aren't
+// copied as they belong to the original instance, a public IP is requested if it has one.
+func (i *Instance) Config() InstanceConfig {
+	publicIP := "none"
+	if i.PublicIP != "" {
+		publicIP = "create"
+	}
+	tags := append([]string(nil), i.Tags...)
+
+	return InstanceConfig{
+		Count:            1,
+		Hostname:         i.Hostname,
+		ReverseDNS:       i.ReverseDNS,
+		Size:             i.Size,
+		PublicIPRequired: publicIP,
+		NetworkID:        i.NetworkID,
+		TemplateID:       i.TemplateID,
+		SourceType:       i.SourceType,
+		SourceID:         i.SourceID,
+		SnapshotID:       i.SnapshotID,
+		InitialUser:      i.InitialUser,
+		SSHKeyID:         i.SSHKeyID,
+		Script:           i.Script,
+		Tags:             tags,
+		TagsList:         strings.Join(tags, " "),
+		FirewallID:       i.FirewallID,
+		VolumeType:       i.VolumeType,
+		AttachedVolumes:  append([]AttachedVolume(nil), i.AttachedVolumes...),
+		PlacementRule:    i.PlacementRule,
+	}
+}
+
+// ExportResourceSpec captures the networks, firewalls with their rules, volumes and instances
+// in the client's region as a ResourceSpec. Volumes belonging to a Kubernetes cluster are left
+// out as they're managed by the cluster.
+func (c *Client) ExportResourceSpec(ctx context.Context) (*ResourceSpec, error) {
+	spec := &ResourceSpec{
+		Version:   ResourceSpecVersion,
+		Networks:  []NetworkSpec{},
+		Firewalls: []FirewallSpec{},
+		Volumes:   []VolumeSpec{},
+		Instances: []InstanceSpec{},
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	networks, err := c.ListNetworks()
+	if err != nil {
+		return nil, err
+	}
+	for _, network := range networks {
+		spec.Networks = append(spec.Networks, NetworkSpec{
+			SourceID: network.ID,
+			Default:  network.Default,
+			Config:   network.Config(),
+		})
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	firewalls, err := c.ListFirewalls()
+	if err != nil {
+		return nil, err
+	}
+	for _, firewall := range firewalls {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		rules, err := c.ListFirewallRules(firewall.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		firewallSpec := FirewallSpec{SourceID: firewall.ID, Config: firewall.Config(), Rules: []FirewallRuleConfig{}}
+		for _, rule := range rules {
+			firewallSpec.Rules = append(firewallSpec.Rules, rule.Config())
+		}
+		spec.Firewalls = append(spec.Firewalls, firewallSpec)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	volumes, err := c.ListVolumes()
+	if err != nil {
+		return nil, err
+	}
+	for _, volume := range volumes {
+		if volume.ClusterID != "" {
+			continue
+		}
+		spec.Volumes = append(spec.Volumes, VolumeSpec{SourceID: volume.ID, Config: volume.Config()})
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	instances, err := c.ListAllInstances()
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		config := instance.Config()
+		// the listing doesn't always include the attachments, the volumes know their instance
+		config.AttachedVolumes = nil
+		for _, volume := range volumes {
+			if volume.InstanceID == instance.ID && volume.ClusterID == "" {
+				config.AttachedVolumes = append(config.AttachedVolumes, AttachedVolume{ID: volume.ID})
+			}
+		}
+		spec.Instances = append(spec.Instances, InstanceSpec{SourceID: instance.ID, Config: config})
+	}
+
+	return spec, nil
+}
+
+// ParseResourceSpec parses a ResourceSpec from JSON and checks its version
+func ParseResourceSpec(data []byte) (*ResourceSpec, error) {
+	spec := &ResourceSpec{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(spec); err != nil {
+		return nil, InvalidResourceSpecError.wrap(err)
+	}
+
+	if spec.Version != ResourceSpecVersion {
+		err := fmt.Errorf("unsupported resource spec version %d, expected %d", spec.Version, ResourceSpecVersion)
+		return nil, InvalidResourceSpecError.wrap(err)
+	}
+
+	return spec, nil
+}
+
+// ImportResourceSpec creates the resources in a ResourceSpec in the client's region, networks
+// first, then firewalls and their rules, volumes and finally instances with their volumes
+// attached. References to resources in the spec are replaced with the IDs of the new ones,
+// references to anything else are kept as they are. The import stops at the first failure and
+// returns what was created so far along with the error, the spec isn't modified.
+func (c *Client) ImportResourceSpec(ctx context.Context, spec *ResourceSpec) (*ResourceSpecImport, error) {
+	result := &ResourceSpecImport{IDs: map[ResourceRef]string{}}
+
+	if spec.Version != ResourceSpecVersion {
+		err := fmt.Errorf("unsupported resource spec version %d, expected %d", spec.Version, ResourceSpecVersion)
+		return result, InvalidResourceSpecError.wrap(err)
+	}
+
+	remap := func(t ResourceType, id string) string {
+		if newID, ok := result.IDs[ResourceRef{Type: t, ID: id}]; ok {
+			return newID
+		}
+		return id
+	}
+
+	for _, network := range spec.Networks {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		ref := ResourceRef{Type: ResourceTypeNetwork, ID: network.SourceID}
+		if network.Default {
+			defaultNetwork, err := c.GetDefaultNetwork()
+			if err != nil {
+				return result, err
+			}
+			result.IDs[ref] = defaultNetwork.ID
+			continue
+		}
+
+		config := network.Config
+		config.Region = c.Region
+		created, err := c.CreateNetwork(config)
+		if err != nil {
+			return result, err
+		}
+		result.IDs[ref] = created.ID
+	}
+
+	for _, firewall := range spec.Firewalls {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		config := firewall.Config
+		config.Region = c.Region
+		config.NetworkID = remap(ResourceTypeNetwork, config.NetworkID)
+		created, err := c.NewFirewall(&config)
+		if err != nil {
+			return result, err
+		}
+		result.IDs[ResourceRef{Type: ResourceTypeFirewall, ID: firewall.SourceID}] = created.ID
+
+		for _, rule := range firewall.Rules {
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+			rule.FirewallID = created.ID
+			rule.Region = c.Region
+			if _, err := c.NewFirewallRule(&rule); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	for _, volume := range spec.Volumes {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		config := volume.Config
+		config.Region = c.Region
+		config.NetworkID = remap(ResourceTypeNetwork, config.NetworkID)
+		created, err := c.NewVolume(&config)
+		if err != nil {
+			return result, err
+		}
+		result.IDs[ResourceRef{Type: ResourceTypeVolume, ID: volume.SourceID}] = created.ID
+	}
+
+	for _, instance := range spec.Instances {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		config := instance.Config
+		config.Region = c.Region
+		config.NetworkID = remap(ResourceTypeNetwork, config.NetworkID)
+		config.FirewallID = remap(ResourceTypeFirewall, config.FirewallID)
+		if len(config.Tags) == 0 {
+			config.Tags = strings.Fields(config.TagsList)
+		}
+		config.AttachedVolumes = make([]AttachedVolume, 0, len(instance.Config.AttachedVolumes))
+		for _, volume := range instance.Config.AttachedVolumes {
+			config.AttachedVolumes = append(config.AttachedVolumes, AttachedVolume{ID: remap(ResourceTypeVolume, volume.ID)})
+		}
+
+		created, err := c.CreateInstance(&config)
+		if err != nil {
+			return result, err
+		}
+		result.IDs[ResourceRef{Type: ResourceTypeInstance, ID: instance.SourceID}] = created.ID
+	}
+
+	return result, nil
+}
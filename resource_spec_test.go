@@ -0,0 +1,190 @@
+package civogo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestInstanceConfigFromInstance(t *testing.T) {
+	instance := Instance{
+		ID:         "i-1",
+		Hostname:   "web",
+		Size:       "g3.small",
+		NetworkID:  "n-1",
+		FirewallID: "f-1",
+		PublicIP:   "74.220.1.1",
+		PrivateIP:  "192.168.1.2",
+		TemplateID: "ubuntu",
+		Tags:       []string{"prod", "web"},
+	}
+
+	got := instance.Config()
+	if got.PublicIPRequired != "create" || got.PrivateIPv4 != "" {
+		t.Errorf("Expected a new public IP and no private address, got %q and %q", got.PublicIPRequired, got.PrivateIPv4)
+	}
+	if got.Count != 1 || got.Hostname != "web" || got.Size != "g3.small" || got.TemplateID != "ubuntu" {
+		t.Errorf("Expected the instance settings to be copied, got %+v", got)
+	}
+	if got.TagsList != "prod web" {
+		t.Errorf("Expected %q, got %q", "prod web", got.TagsList)
+	}
+
+	got.Tags[0] = "changed"
+	if instance.Tags[0] != "prod" {
+		t.Errorf("Expected the config not to share the instance's tags")
+	}
+}
+
+func TestFirewallRuleConfigFromRule(t *testing.T) {
+	rule := FirewallRule{ID: "r-1", FirewallID: "f-1", Protocol: "tcp", StartPort: "80", EndPort: "80", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow", Label: "web"}
+
+	got := rule.Config()
+	expected := FirewallRuleConfig{Protocol: "tcp", StartPort: "80", EndPort: "80", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow", Label: "web"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestExportResourceSpec(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/networks":            {`[{"id": "n-default", "label": "default", "default": true}, {"id": "n-1", "label": "private", "cidr": "10.0.0.0/24", "ipv4_enabled": true}]`},
+		"GET /v2/firewalls":           {`[{"id": "f-1", "name": "web", "network_id": "n-1"}]`},
+		"GET /v2/firewalls/f-1/rules": {`[{"id": "r-1", "firewall_id": "f-1", "protocol": "tcp", "start_port": "443", "end_port": "443", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow"}]`},
+		"GET /v2/volumes":             {`[{"id": "v-1", "name": "data", "network_id": "n-1", "instance_id": "i-1", "size_gb": 20}, {"id": "v-2", "name": "pvc", "cluster_id": "k-1", "size_gb": 10}]`},
+		"GET /v2/instances":           {`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "i-1", "hostname": "web", "size": "g3.small", "network_id": "n-1", "firewall_id": "f-1"}]}`},
+	})
+	defer server.Close()
+
+	got, err := client.ExportResourceSpec(context.Background())
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Version != ResourceSpecVersion || len(got.Networks) != 2 || len(got.Firewalls) != 1 || len(got.Instances) != 1 {
+		t.Errorf("Expected every resource to be exported, got %+v", got)
+		return
+	}
+	if !got.Networks[0].Default || got.Networks[1].Config.CIDRv4 != "10.0.0.0/24" {
+		t.Errorf("Expected the networks to be exported, got %+v", got.Networks)
+	}
+	if len(got.Firewalls[0].Rules) != 1 || got.Firewalls[0].Rules[0].StartPort != "443" {
+		t.Errorf("Expected the firewall rules to be exported, got %+v", got.Firewalls[0].Rules)
+	}
+	if len(got.Volumes) != 1 || got.Volumes[0].SourceID != "v-1" {
+		t.Errorf("Expected only the volume outside the cluster to be exported, got %+v", got.Volumes)
+	}
+	if !reflect.DeepEqual(got.Instances[0].Config.AttachedVolumes, []AttachedVolume{{ID: "v-1"}}) {
+		t.Errorf("Expected the instance to keep its volume, got %+v", got.Instances[0].Config.AttachedVolumes)
+	}
+
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Errorf("Marshalling returned an error: %s", err)
+		return
+	}
+	parsed, err := ParseResourceSpec(data)
+	if err != nil {
+		t.Errorf("Parsing returned an error: %s", err)
+		return
+	}
+	if parsed.Instances[0].Config.Hostname != "web" || parsed.Firewalls[0].Rules[0].StartPort != "443" {
+		t.Errorf("Expected the spec to round trip, got %+v", parsed.Instances[0].Config)
+	}
+}
+
+func TestParseResourceSpecVersion(t *testing.T) {
+	_, err := ParseResourceSpec([]byte(`{"version": 2}`))
+	if !errors.Is(err, InvalidResourceSpecError) {
+		t.Errorf("Expected %s, got %v", InvalidResourceSpecError, err)
+	}
+}
+
+func TestImportResourceSpec(t *testing.T) {
+	var mu sync.Mutex
+	bodies := map[string]map[string]interface{}{}
+	responses := map[string]string{
+		"GET /v2/networks":                 `[{"id": "new-default", "label": "default", "default": true}]`,
+		"POST /v2/networks":                `{"id": "new-n-1", "label": "private", "result": "success"}`,
+		"POST /v2/firewalls":               `{"id": "new-f-1", "name": "web", "result": "success"}`,
+		"POST /v2/firewalls/new-f-1/rules": `{"id": "new-r-1"}`,
+		"POST /v2/volumes":                 `{"id": "new-v-1", "name": "data", "result": "success"}`,
+		"POST /v2/instances":               `{"id": "new-i-1", "hostname": "web"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		key := req.Method + " " + req.URL.Path
+		body, _ := io.ReadAll(req.Body)
+		decoded := map[string]interface{}{}
+		json.Unmarshal(body, &decoded)
+
+		mu.Lock()
+		bodies[key] = decoded
+		mu.Unlock()
+
+		response, ok := responses[key]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"code": "not_found", "reason": "failed to find a matching request"}`))
+			return
+		}
+		rw.Write([]byte(response))
+	}))
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	spec := &ResourceSpec{
+		Version: ResourceSpecVersion,
+		Networks: []NetworkSpec{
+			{SourceID: "n-default", Default: true, Config: NetworkConfig{Label: "default"}},
+			{SourceID: "n-1", Config: NetworkConfig{Label: "private"}},
+		},
+		Firewalls: []FirewallSpec{
+			{SourceID: "f-1", Config: FirewallConfig{Name: "web", NetworkID: "n-1"}, Rules: []FirewallRuleConfig{{Protocol: "tcp", StartPort: "443"}}},
+		},
+		Volumes: []VolumeSpec{
+			{SourceID: "v-1", Config: VolumeConfig{Name: "data", NetworkID: "n-1", SizeGigabytes: 20}},
+		},
+		Instances: []InstanceSpec{
+			{SourceID: "i-1", Config: InstanceConfig{Hostname: "web", NetworkID: "n-1", FirewallID: "f-1", TagsList: "prod", AttachedVolumes: []AttachedVolume{{ID: "v-1"}}}},
+		},
+	}
+
+	got, err := client.ImportResourceSpec(context.Background(), spec)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := map[ResourceRef]string{
+		{Type: ResourceTypeNetwork, ID: "n-default"}: "new-default",
+		{Type: ResourceTypeNetwork, ID: "n-1"}:       "new-n-1",
+		{Type: ResourceTypeFirewall, ID: "f-1"}:      "new-f-1",
+		{Type: ResourceTypeVolume, ID: "v-1"}:        "new-v-1",
+		{Type: ResourceTypeInstance, ID: "i-1"}:      "new-i-1",
+	}
+	if !reflect.DeepEqual(got.IDs, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got.IDs)
+	}
+
+	if bodies["POST /v2/firewalls"]["network_id"] != "new-n-1" || bodies["POST /v2/volumes"]["network_id"] != "new-n-1" {
+		t.Errorf("Expected the firewall and volume to use the new network, got %v and %v", bodies["POST /v2/firewalls"], bodies["POST /v2/volumes"])
+	}
+	instance := bodies["POST /v2/instances"]
+	if instance["network_id"] != "new-n-1" || instance["firewall_id"] != "new-f-1" || instance["tags"] != "prod" {
+		t.Errorf("Expected the instance to use the new network and firewall, got %v", instance)
+	}
+	attached, _ := json.Marshal(instance["attached_volumes"])
+	if string(attached) != `[{"id":"new-v-1"}]` {
+		t.Errorf("Expected the new volume to be attached, got %s", attached)
+	}
+	if spec.Instances[0].Config.AttachedVolumes[0].ID != "v-1" {
+		t.Errorf("Expected the spec not to be modified")
+	}
+}
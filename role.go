@@ -3,6 +3,8 @@ package civogo
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -16,6 +18,38 @@ type Role struct {
 	UpdatedAt   time.Time `json:"updated_at,omitempty"`
 }
 
+// PermissionCodes returns the role's permissions as a list, the API stores them comma separated
+func (r *Role) PermissionCodes() []string {
+	return splitCommaList(r.Permissions)
+}
+
+// Allows reports whether any of the role's permissions covers the permission code
+func (r *Role) Allows(code string) bool {
+	for _, granted := range r.PermissionCodes() {
+		if PermissionCodeMatches(granted, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRolePermissions checks a comma separated list of permissions isn't empty and
+// that each one is a valid permission code
+func validateRolePermissions(permissions string) error {
+	codes := splitCommaList(permissions)
+	if len(codes) == 0 {
+		err := fmt.Errorf("a role needs at least one permission")
+		return InvalidPermissionError.wrap(err)
+	}
+	for _, code := range codes {
+		if _, _, err := ParsePermissionCode(code); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ListRoles returns all roles (built-in and user defined)
 func (c *Client) ListRoles() ([]Role, error) {
 	resp, err := c.SendGetRequest("/v2/roles")
@@ -31,8 +65,13 @@ func (c *Client) ListRoles() ([]Role, error) {
 	return roles, nil
 }
 
-// CreateRole creates a new role with a set of permissions for use within an organisation
+// CreateRole creates a new role with a set of permissions for use within an organisation,
+// the permissions are comma separated codes as returned by ListPermissions
 func (c *Client) CreateRole(name, permissions string) (*Role, error) {
+	if err := validateRolePermissions(permissions); err != nil {
+		return nil, err
+	}
+
 	data := map[string]string{"name": name, "permissions": permissions}
 	resp, err := c.SendPostRequest("/v2/roles", data)
 	if err != nil {
@@ -56,3 +95,8 @@ func (c *Client) DeleteRole(id string) (*SimpleResponse, error) {
 
 	return c.DecodeSimpleResponse(resp)
 }
+
+// CreateRoleWithPermissions creates a new role from a list of permission codes
+func (c *Client) CreateRoleWithPermissions(name string, permissions []string) (*Role, error) {
+	return c.CreateRole(name, strings.Join(permissions, ","))
+}
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -69,3 +70,36 @@ func TestDeleteRole(t *testing.T) {
 		t.Errorf("Expected %s, got %s", "success", got.Result)
 	}
 }
+
+func TestCreateRoleInvalidPermissions(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/roles": `{"id":"12345","name":"Broken"}`,
+	})
+	defer server.Close()
+
+	for _, permissions := range []string{"", "instance", "instance.create,.create", "instance.create.now"} {
+		if _, err := client.CreateRole("Broken", permissions); !errors.Is(err, InvalidPermissionError) {
+			t.Errorf("Expected InvalidPermissionError for %q, got %v", permissions, err)
+		}
+	}
+}
+
+func TestRoleAllows(t *testing.T) {
+	role := &Role{Permissions: "instance.create, kubernetes.*"}
+
+	for code, expected := range map[string]bool{
+		"instance.create":   true,
+		"instance.destroy":  false,
+		"kubernetes.create": true,
+		"billing.update":    false,
+	} {
+		if got := role.Allows(code); got != expected {
+			t.Errorf("Expected Allows(%q) to be %t, got %t", code, expected, got)
+		}
+	}
+
+	admin := &Role{Permissions: "*.*"}
+	if !admin.Allows("billing.update") {
+		t.Errorf("Expected *.* to allow billing.update")
+	}
+}
@@ -0,0 +1,160 @@
+package civogo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SelfCheckOperation is an operation the caller intends to perform and wants to
+// verify the API key has access to
+type SelfCheckOperation string
+
+// Operations that can be verified by SelfCheck, each one is checked using a
+// read-only call against the matching resource
+const (
+	SelfCheckInstances     SelfCheckOperation = "instances"
+	SelfCheckVolumes       SelfCheckOperation = "volumes"
+	SelfCheckNetworks      SelfCheckOperation = "networks"
+	SelfCheckFirewalls     SelfCheckOperation = "firewalls"
+	SelfCheckKubernetes    SelfCheckOperation = "kubernetes"
+	SelfCheckLoadBalancers SelfCheckOperation = "loadbalancers"
+	SelfCheckObjectStores  SelfCheckOperation = "objectstores"
+	SelfCheckDatabases     SelfCheckOperation = "databases"
+	SelfCheckDNS           SelfCheckOperation = "dns"
+)
+
+// SelfCheckConfig describes what SelfCheck should verify
+type SelfCheckConfig struct {
+	// Operations lists the operations the caller needs access to
+	Operations []SelfCheckOperation
+}
+
+// SelfCheckResult is the outcome of a single check
+type SelfCheckResult struct {
+	Name  string
+	OK    bool
+	Error error
+}
+
+// SelfCheckReport is the readiness report returned by SelfCheck
+type SelfCheckReport struct {
+	Reachable     SelfCheckResult
+	Authenticated SelfCheckResult
+	Region        SelfCheckResult
+	Operations    []SelfCheckResult
+	// ResolvedRegion is the region the client is configured for, when it could be found
+	ResolvedRegion *Region
+}
+
+// Ready returns true if every check in the report passed
+func (r *SelfCheckReport) Ready() bool {
+	if !r.Reachable.OK || !r.Authenticated.OK || !r.Region.OK {
+		return false
+	}
+	for _, op := range r.Operations {
+		if !op.OK {
+			return false
+		}
+	}
+	return true
+}
+
+// Failures returns the checks in the report that didn't pass
+func (r *SelfCheckReport) Failures() []SelfCheckResult {
+	failures := []SelfCheckResult{}
+	for _, result := range append([]SelfCheckResult{r.Reachable, r.Authenticated, r.Region}, r.Operations...) {
+		if !result.OK {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// SelfCheck verifies the API is reachable, the API key is valid, the client's region
+// exists and that the API key can access each of the requested operations. The returned
+// report is always populated, the error is only set if the context was cancelled.
+func (c *Client) SelfCheck(ctx context.Context, config SelfCheckConfig) (*SelfCheckReport, error) {
+	report := &SelfCheckReport{
+		Reachable:     SelfCheckResult{Name: "reachable"},
+		Authenticated: SelfCheckResult{Name: "authenticated"},
+		Region:        SelfCheckResult{Name: "region"},
+	}
+
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+	report.Reachable.Error = c.Ping()
+	report.Reachable.OK = report.Reachable.Error == nil
+
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+	_, err := c.GetQuota()
+	report.Authenticated.Error = err
+	report.Authenticated.OK = err == nil
+
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+	report.ResolvedRegion, report.Region.Error = c.resolveClientRegion()
+	report.Region.OK = report.Region.Error == nil
+
+	for _, op := range config.Operations {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		result := SelfCheckResult{Name: string(op)}
+		result.Error = c.checkOperation(op)
+		result.OK = result.Error == nil
+		report.Operations = append(report.Operations, result)
+	}
+
+	return report, nil
+}
+
+func (c *Client) resolveClientRegion() (*Region, error) {
+	regions, err := c.ListRegions()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, region := range regions {
+		if strings.EqualFold(region.Code, c.Region) {
+			return &region, nil
+		}
+	}
+
+	err = fmt.Errorf("unable to find region %s", c.Region)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+func (c *Client) checkOperation(op SelfCheckOperation) error {
+	var err error
+
+	switch op {
+	case SelfCheckInstances:
+		_, err = c.ListInstances(1, 1)
+	case SelfCheckVolumes:
+		_, err = c.ListVolumes()
+	case SelfCheckNetworks:
+		_, err = c.ListNetworks()
+	case SelfCheckFirewalls:
+		_, err = c.ListFirewalls()
+	case SelfCheckKubernetes:
+		_, err = c.ListKubernetesClusters()
+	case SelfCheckLoadBalancers:
+		_, err = c.ListLoadBalancers()
+	case SelfCheckObjectStores:
+		_, err = c.ListObjectStores()
+	case SelfCheckDatabases:
+		_, err = c.ListDatabases()
+	case SelfCheckDNS:
+		_, err = c.ListDNSDomains()
+	default:
+		err = fmt.Errorf("unknown operation %s", op)
+	}
+
+	return err
+}
@@ -0,0 +1,52 @@
+package civogo
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSelfCheck(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/ping":    {`{"result": "success"}`},
+		"GET /v2/quota":   {`{"id": "44aab548-61ca-11e5-860e-5cf9389be614", "instance_count_limit": 16}`},
+		"GET /v2/regions": {`[{"code": "LON1", "name": "London 1"}, {"code": "TEST", "name": "Test", "default": true}]`},
+		"GET /v2/volumes": {`[]`},
+	})
+	defer server.Close()
+
+	got, err := client.SelfCheck(context.Background(), SelfCheckConfig{
+		Operations: []SelfCheckOperation{SelfCheckVolumes, SelfCheckFirewalls},
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if !got.Reachable.OK || !got.Authenticated.OK || !got.Region.OK {
+		t.Errorf("Expected reachable, authenticated and region checks to pass, got %+v", got)
+	}
+	if got.ResolvedRegion == nil || got.ResolvedRegion.Code != "TEST" {
+		t.Errorf("Expected %s, got %+v", "TEST", got.ResolvedRegion)
+	}
+	if got.Ready() {
+		t.Errorf("Expected the report not to be ready")
+	}
+
+	failures := got.Failures()
+	if len(failures) != 1 || failures[0].Name != "firewalls" {
+		t.Errorf("Expected only the firewalls check to fail, got %+v", failures)
+	}
+}
+
+func TestSelfCheckCancelled(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.SelfCheck(ctx, SelfCheckConfig{})
+	if err != context.Canceled {
+		t.Errorf("Expected %s, got %s", context.Canceled, err)
+	}
+}
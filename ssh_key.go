@@ -2,6 +2,9 @@ package civogo
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -21,12 +24,12 @@ type SSHKey struct {
 func (c *Client) ListSSHKeys() ([]SSHKey, error) {
 	resp, err := c.SendGetRequest("/v2/sshkeys")
 	if err != nil {
-		return nil, err
+		return nil, decodeError(err)
 	}
 
 	sshKeys := make([]SSHKey, 0)
 	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&sshKeys); err != nil {
-		return nil, decodeError(err)
+		return nil, err
 	}
 
 	return sshKeys, nil
@@ -34,6 +37,11 @@ func (c *Client) ListSSHKeys() ([]SSHKey, error) {
 
 // NewSSHKey creates a new SSH key record
 func (c *Client) NewSSHKey(name string, publicKey string) (*SimpleResponse, error) {
+	if strings.TrimSpace(publicKey) == "" {
+		err := fmt.Errorf("the public key is empty")
+		return nil, ParameterPublicKeyEmptyError.wrap(err)
+	}
+
 	resp, err := c.SendPostRequest("/v2/sshkeys", map[string]string{
 		"name":       name,
 		"public_key": publicKey,
@@ -97,7 +105,13 @@ func (c *Client) FindSSHKey(search string) (*SSHKey, error) {
 }
 
 // DeleteSSHKey deletes an SSH key
+// With ResolveNames set the SSH key's name can be used instead of its ID
 func (c *Client) DeleteSSHKey(id string) (*SimpleResponse, error) {
+	id, err := c.resolveExactID(ResourceTypeSSHKey, id)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/sshkeys/%s", id))
 	if err != nil {
 		return nil, decodeError(err)
@@ -105,3 +119,63 @@ func (c *Client) DeleteSSHKey(id string) (*SimpleResponse, error) {
 
 	return c.DecodeSimpleResponse(resp)
 }
+
+// FindSSHKeyByPublicKey finds an uploaded SSH key matching the public key, comparing the
+// fingerprint so the same key uploaded with a different comment or name is still found
+func (c *Client) FindSSHKeyByPublicKey(publicKey string) (*SSHKey, error) {
+	fingerprint, err := SSHKeyFingerprint(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := c.ListSSHKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if key.Fingerprint == fingerprint {
+			return &key, nil
+		}
+		if key.Fingerprint == "" {
+			if keyFingerprint, err := SSHKeyFingerprint(key.PublicKey); err == nil && keyFingerprint == fingerprint {
+				return &key, nil
+			}
+		}
+	}
+
+	err = fmt.Errorf("unable to find an SSH key with fingerprint %s, zero matches", fingerprint)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// SSHKeyFingerprint returns the SHA256 fingerprint of a public key in authorized_keys format, in
+// the same "SHA256:..." form OpenSSH and the API use
+func SSHKeyFingerprint(publicKey string) (string, error) {
+	blob, err := parseSSHPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
+
+// parseSSHPublicKey returns the decoded key from an authorized_keys line, which may have
+// options before the key type and a comment after the key
+func parseSSHPublicKey(publicKey string) ([]byte, error) {
+	fields := strings.Fields(publicKey)
+	for i := 0; i+1 < len(fields); i++ {
+		blob, err := base64.StdEncoding.DecodeString(fields[i+1])
+		if err != nil || len(blob) < 4 {
+			continue
+		}
+
+		length := binary.BigEndian.Uint32(blob)
+		if uint64(length)+4 <= uint64(len(blob)) && string(blob[4:4+length]) == fields[i] {
+			return blob, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to parse the public key, expected the authorized_keys format \"type base64-key [comment]\"")
+	return nil, InvalidSSHKeyError.wrap(err)
+}
@@ -1,7 +1,9 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -74,3 +76,55 @@ func TestFindSSHKey(t *testing.T) {
 		t.Errorf("Expected %s, got %s", "unable to find missing, zero matches", err.Error())
 	}
 }
+
+const testSSHPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAICQCZwCreFVdJtNgC1VY794SsNZUbUznC/Xp/jzTGEqb ci@example"
+
+func TestSSHKeyFingerprint(t *testing.T) {
+	expected := "SHA256:KJbpps7iLn/KZJT6NoHgbTwFG3ukX/65TTb4WQhC75s"
+	for _, key := range []string{
+		testSSHPublicKey,
+		"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAICQCZwCreFVdJtNgC1VY794SsNZUbUznC/Xp/jzTGEqb",
+		`no-pty,from="10.0.0.0/8" ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAICQCZwCreFVdJtNgC1VY794SsNZUbUznC/Xp/jzTGEqb laptop`,
+	} {
+		got, err := SSHKeyFingerprint(key)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %s", key, err)
+			continue
+		}
+		if got != expected {
+			t.Errorf("Expected %s, got %s", expected, got)
+		}
+	}
+
+	for _, key := range []string{"", "ssh-rsa AAAA", "ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAICQCZwCreFVdJtNgC1VY794SsNZUbUznC/Xp/jzTGEqb"} {
+		if _, err := SSHKeyFingerprint(key); !errors.Is(err, InvalidSSHKeyError) {
+			t.Errorf("Expected InvalidSSHKeyError for %q, got %v", key, err)
+		}
+	}
+}
+
+func TestFindSSHKeyByPublicKey(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/sshkeys": `[
+			{"id": "12345", "name": "RSA Key", "fingerprint": "SHA256:SS4+2d7Zl1Pt5Bc9af9NubyA0yI+fdopOUlEhUoEna0"},
+			{"id": "67890", "name": "CI Key", "fingerprint": "SHA256:KJbpps7iLn/KZJT6NoHgbTwFG3ukX/65TTb4WQhC75s"}]`,
+	})
+	defer server.Close()
+
+	got, err := client.FindSSHKeyByPublicKey(strings.Replace(testSSHPublicKey, "ci@example", "renamed", 1))
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	if got.ID != "67890" {
+		t.Errorf("Expected %s, got %s", "67890", got.ID)
+	}
+}
+
+func TestNewSSHKeyEmpty(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{})
+	defer server.Close()
+
+	if _, err := client.NewSSHKey("test", " "); !errors.Is(err, ParameterPublicKeyEmptyError) {
+		t.Errorf("Expected ParameterPublicKeyEmptyError, got %v", err)
+	}
+}
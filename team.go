@@ -27,6 +27,27 @@ type TeamMember struct {
 	UpdatedAt   time.Time `json:"updated_at,omitempty"`
 }
 
+// RoleIDs returns the member's roles as a list, the API stores them comma separated
+func (m *TeamMember) RoleIDs() []string {
+	return splitCommaList(m.Roles)
+}
+
+// PermissionCodes returns the member's permissions as a list, the API stores them comma separated
+func (m *TeamMember) PermissionCodes() []string {
+	return splitCommaList(m.Permissions)
+}
+
+// splitCommaList splits a comma separated field from the API, dropping any empty items
+func splitCommaList(s string) []string {
+	list := make([]string, 0)
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 // ListTeams returns all teams for the current account
 func (c *Client) ListTeams() ([]Team, error) {
 	resp, err := c.SendGetRequest("/v2/teams")
@@ -169,3 +190,20 @@ func (c *Client) RemoveTeamMember(teamID, teamMemberID string) (*SimpleResponse,
 
 	return c.DecodeSimpleResponse(resp)
 }
+
+// UpdateTeamMemberRoles replaces the roles of a team member, leaving their permissions as they are
+func (c *Client) UpdateTeamMemberRoles(teamID, teamMemberID string, roles []string) (*TeamMember, error) {
+	members, err := c.ListTeamMembers(teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		if member.ID == teamMemberID {
+			return c.UpdateTeamMember(teamID, teamMemberID, member.Permissions, strings.Join(roles, ","))
+		}
+	}
+
+	err = fmt.Errorf("unable to find team member %s in team %s", teamMemberID, teamID)
+	return nil, ZeroMatchesError.wrap(err)
+}
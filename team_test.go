@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -146,3 +147,27 @@ func TestRemoveTeamMember(t *testing.T) {
 		t.Errorf("Expected %s, got %s", "success", got.Result)
 	}
 }
+
+func TestUpdateTeamMemberRoles(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/teams/12345/members":        {`[{"id":"abcde","user_id":"bcdef","permissions":"instances.*","roles":"owner"}]`},
+		"POST /v2/teams/12345/members/abcde": {`{"id":"abcde","permissions":"instances.*","roles":"billing,support"}`},
+	})
+	defer server.Close()
+
+	got, err := client.UpdateTeamMemberRoles("12345", "abcde", []string{"billing", "support"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if roles := got.RoleIDs(); len(roles) != 2 || roles[0] != "billing" || roles[1] != "support" {
+		t.Errorf("Expected %v, got %v", []string{"billing", "support"}, roles)
+	}
+	if permissions := got.PermissionCodes(); len(permissions) != 1 || permissions[0] != "instances.*" {
+		t.Errorf("Expected %v, got %v", []string{"instances.*"}, permissions)
+	}
+
+	if _, err := client.UpdateTeamMemberRoles("12345", "missing", nil); !errors.Is(err, ZeroMatchesError) {
+		t.Errorf("Expected ZeroMatchesError, got %v", err)
+	}
+}
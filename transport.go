@@ -0,0 +1,207 @@
+package civogo
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Defaults used for the HTTP transport when TransportOptions fields are left empty
+const (
+	DefaultMaxIdleConns        = 100
+	DefaultMaxIdleConnsPerHost = 10
+	DefaultIdleConnTimeout     = 90 * time.Second
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+	DefaultDialTimeout         = 30 * time.Second
+	DefaultKeepAlive           = 30 * time.Second
+)
+
+// TransportOptions tunes the HTTP transport the client sends its requests with. Connections
+// to the API are kept alive and reused between requests, so a client making many calls should
+// be created once and shared rather than created per call.
+type TransportOptions struct {
+	// DisableKeepAlives opens a new connection for every request
+	DisableKeepAlives bool
+	// DisableHTTP2 keeps the client on HTTP/1.1 even if the server supports HTTP/2
+	DisableHTTP2 bool
+	// MaxIdleConns limits the idle connections kept across all hosts
+	MaxIdleConns int
+	// MaxIdleConnsPerHost limits the idle connections kept to the API, raise it for clients
+	// sending many requests concurrently
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost limits the connections to the API, zero means no limit
+	MaxConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept before it's closed
+	IdleConnTimeout time.Duration
+	// KeepAlive is the interval between TCP keep-alive probes on open connections
+	KeepAlive time.Duration
+	// DialTimeout limits how long opening a connection can take
+	DialTimeout time.Duration
+	// TLSHandshakeTimeout limits how long the TLS handshake can take
+	TLSHandshakeTimeout time.Duration
+	// TLSClientConfig sets the TLS settings, e.g. a minimum version or custom root CAs
+	TLSClientConfig *tls.Config
+	// Proxy is the URL of the proxy to send requests through, e.g. "http://proxy:3128". If
+	// empty the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.
+	Proxy string
+	// Timeout limits how long a request can take, including reading the response. Zero means
+	// no limit other than the ones above.
+	Timeout time.Duration
+}
+
+// Validate checks the options are usable
+func (o *TransportOptions) Validate() error {
+	counts := map[string]int{
+		"MaxIdleConns":        o.MaxIdleConns,
+		"MaxIdleConnsPerHost": o.MaxIdleConnsPerHost,
+		"MaxConnsPerHost":     o.MaxConnsPerHost,
+	}
+	for name, value := range counts {
+		if value < 0 {
+			err := fmt.Errorf("%s can't be negative, got %d", name, value)
+			return InvalidTransportOptionsError.wrap(err)
+		}
+	}
+
+	durations := map[string]time.Duration{
+		"IdleConnTimeout":     o.IdleConnTimeout,
+		"KeepAlive":           o.KeepAlive,
+		"DialTimeout":         o.DialTimeout,
+		"TLSHandshakeTimeout": o.TLSHandshakeTimeout,
+		"Timeout":             o.Timeout,
+	}
+	for name, value := range durations {
+		if value < 0 {
+			err := fmt.Errorf("%s can't be negative, got %s", name, value)
+			return InvalidTransportOptionsError.wrap(err)
+		}
+	}
+
+	if o.Proxy != "" {
+		if _, err := parseProxyURL(o.Proxy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseProxyURL parses the URL of a proxy, which must have an http, https or socks5 scheme and a host
+func parseProxyURL(proxy string) (*url.URL, error) {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, InvalidTransportOptionsError.wrap(fmt.Errorf("invalid proxy %q: %s", proxy, err))
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		err := fmt.Errorf("proxy %q must use the http, https or socks5 scheme", proxy)
+		return nil, InvalidTransportOptionsError.wrap(err)
+	}
+	if u.Host == "" {
+		err := fmt.Errorf("proxy %q doesn't have a host", proxy)
+		return nil, InvalidTransportOptionsError.wrap(err)
+	}
+
+	return u, nil
+}
+
+// newTransport returns an http.Transport configured by opts, with defaults for the empty fields
+func newTransport(opts TransportOptions) (*http.Transport, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.MaxIdleConns == 0 {
+		opts.MaxIdleConns = DefaultMaxIdleConns
+	}
+	if opts.MaxIdleConnsPerHost == 0 {
+		opts.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+	if opts.IdleConnTimeout == 0 {
+		opts.IdleConnTimeout = DefaultIdleConnTimeout
+	}
+	if opts.TLSHandshakeTimeout == 0 {
+		opts.TLSHandshakeTimeout = DefaultTLSHandshakeTimeout
+	}
+	if opts.DialTimeout == 0 {
+		opts.DialTimeout = DefaultDialTimeout
+	}
+	if opts.KeepAlive == 0 {
+		opts.KeepAlive = DefaultKeepAlive
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if opts.Proxy != "" {
+		proxyURL, _ := parseProxyURL(opts.Proxy)
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   opts.DialTimeout,
+		KeepAlive: opts.KeepAlive,
+	}
+	if opts.DisableKeepAlives {
+		dialer.KeepAlive = -1
+	}
+
+	transport := &http.Transport{
+		Proxy:               proxy,
+		DialContext:         dialer.DialContext,
+		DisableKeepAlives:   opts.DisableKeepAlives,
+		ForceAttemptHTTP2:   !opts.DisableHTTP2,
+		MaxIdleConns:        opts.MaxIdleConns,
+		MaxIdleConnsPerHost: opts.MaxIdleConnsPerHost,
+		MaxConnsPerHost:     opts.MaxConnsPerHost,
+		IdleConnTimeout:     opts.IdleConnTimeout,
+		TLSHandshakeTimeout: opts.TLSHandshakeTimeout,
+	}
+	if opts.TLSClientConfig != nil {
+		transport.TLSClientConfig = opts.TLSClientConfig.Clone()
+	}
+	if opts.DisableHTTP2 {
+		// a non-nil empty map stops the transport from upgrading TLS connections to HTTP/2
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+
+	return transport, nil
+}
+
+// NewClientWithTransportOptions initializes a Client with a specific API URL and a tuned HTTP transport
+func NewClientWithTransportOptions(apiKey, civoAPIURL, region string, opts TransportOptions) (*Client, error) {
+	client, err := NewClientWithURL(apiKey, civoAPIURL, region)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.SetTransportOptions(opts); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// SetTransportOptions replaces the client's HTTP transport with one configured by opts. Idle
+// connections of the previous transport are closed.
+func (c *Client) SetTransportOptions(opts TransportOptions) error {
+	transport, err := newTransport(opts)
+	if err != nil {
+		return err
+	}
+
+	if previous, ok := c.httpClient.Transport.(*http.Transport); ok {
+		previous.CloseIdleConnections()
+	}
+	c.httpClient.Transport = transport
+	c.httpClient.Timeout = opts.Timeout
+	return nil
+}
+
+// CloseIdleConnections closes the connections kept open for reuse, e.g. before a long pause
+// in a client's activity
+func (c *Client) CloseIdleConnections() {
+	c.httpClient.CloseIdleConnections()
+}
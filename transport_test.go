@@ -0,0 +1,121 @@
+package civogo
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientReusesConnections(t *testing.T) {
+	var connections int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"result": "success"}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	client, err := NewClientWithURL("TEST-API-KEY", server.URL, "TEST")
+	if err != nil {
+		t.Fatalf("Creating the client returned an error: %s", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.SendGetRequest("/v2/ping"); err != nil {
+			t.Fatalf("Request returned an error: %s", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&connections); got != 1 {
+		t.Errorf("Expected the requests to share 1 connection, got %d", got)
+	}
+}
+
+func TestSetTransportOptions(t *testing.T) {
+	client, _ := NewClient("TEST-API-KEY", "TEST")
+
+	err := client.SetTransportOptions(TransportOptions{
+		DisableHTTP2:        true,
+		MaxIdleConnsPerHost: 50,
+		MaxConnsPerHost:     64,
+		TLSClientConfig:     &tls.Config{MinVersion: tls.VersionTLS12},
+		Proxy:               "http://proxy.example.com:3128",
+		Timeout:             time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("Setting the options returned an error: %s", err)
+	}
+
+	transport := client.httpClient.Transport.(*http.Transport)
+	if transport.MaxIdleConnsPerHost != 50 || transport.MaxConnsPerHost != 64 || transport.MaxIdleConns != DefaultMaxIdleConns {
+		t.Errorf("Expected the connection limits to be set, got %d, %d and %d", transport.MaxIdleConnsPerHost, transport.MaxConnsPerHost, transport.MaxIdleConns)
+	}
+	if transport.ForceAttemptHTTP2 || transport.TLSNextProto == nil {
+		t.Errorf("Expected HTTP/2 to be disabled")
+	}
+	if transport.TLSClientConfig.MinVersion != tls.VersionTLS12 {
+		t.Errorf("Expected the TLS config to be used, got %+v", transport.TLSClientConfig)
+	}
+	if client.httpClient.Timeout != time.Minute {
+		t.Errorf("Expected a %s timeout, got %s", time.Minute, client.httpClient.Timeout)
+	}
+
+	req, _ := http.NewRequest("GET", "https://api.civo.com/v2/regions", nil)
+	proxy, err := transport.Proxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.example.com:3128" {
+		t.Errorf("Expected requests to go through the proxy, got %v", proxy)
+	}
+}
+
+func TestTransportOptionsProxy(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Host != "api.civo.invalid" {
+			rw.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		rw.Write([]byte(`{"result": "success"}`))
+	}))
+	defer proxy.Close()
+
+	client, err := NewClientWithTransportOptions("TEST-API-KEY", "http://api.civo.invalid", "TEST", TransportOptions{Proxy: proxy.URL})
+	if err != nil {
+		t.Fatalf("Creating the client returned an error: %s", err)
+	}
+
+	resp, err := client.SendGetRequest("/v2/ping")
+	if err != nil || string(resp) != `{"result": "success"}` {
+		t.Errorf("Expected the request to be sent through the proxy, got %s and %v", resp, err)
+	}
+}
+
+func TestTransportOptionsValidate(t *testing.T) {
+	invalid := []TransportOptions{
+		{MaxIdleConnsPerHost: -1},
+		{IdleConnTimeout: -time.Second},
+		{Proxy: "ftp://proxy.example.com"},
+		{Proxy: "http://"},
+	}
+	for _, opts := range invalid {
+		if err := opts.Validate(); !errors.Is(err, InvalidTransportOptionsError) {
+			t.Errorf("Expected %s for %+v, got %v", InvalidTransportOptionsError, opts, err)
+		}
+	}
+
+	if _, err := NewClientWithTransportOptions("TEST-API-KEY", "https://api.civo.com", "TEST", TransportOptions{MaxConnsPerHost: -1}); !errors.Is(err, InvalidTransportOptionsError) {
+		t.Errorf("Expected %s, got %v", InvalidTransportOptionsError, err)
+	}
+
+	valid := TransportOptions{Proxy: "socks5://127.0.0.1:1080", DisableKeepAlives: true}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected the options to be valid, got %s", err)
+	}
+}
@@ -3,7 +3,10 @@ package civogo
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -44,6 +47,15 @@ type VolumeConfig struct {
 	SnapshotID    string `json:"snapshot_id,omitempty"`
 }
 
+// VolumeResizeResult is the outcome of ResizeVolumeAndWait
+type VolumeResizeResult struct {
+	Volume                *Volume
+	PreviousSizeGigabytes int
+	// NeedsFilesystemGrow is true when the block device grew, the filesystem on it
+	// still has to be grown from inside the instance once the volume is attached
+	NeedsFilesystemGrow bool
+}
+
 // VolumeAttachConfig is the configuration used to attach volume
 type VolumeAttachConfig struct {
 	InstanceID   string `json:"instance_id"`
@@ -54,29 +66,24 @@ type VolumeAttachConfig struct {
 // ListVolumes returns all volumes owned by the calling API account
 // https://www.civo.com/api/volumes#list-volumes
 func (c *Client) ListVolumes() ([]Volume, error) {
-	resp, err := c.SendGetRequest("/v2/volumes")
-	if err != nil {
-		return nil, decodeError(err)
-	}
-
-	var volumes = make([]Volume, 0)
-	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&volumes); err != nil {
-		return nil, err
-	}
-
-	return volumes, nil
+	return c.listVolumes(nil)
 }
 
-// ListVolumesForCluster returns all volumes for a cluster
+// ListVolumesForCluster returns all volumes for a cluster, found by its name or part of its ID. The
+// API filters the volumes by cluster ID, regions that don't support the filter return every volume
+// so the result is always filtered locally too.
 func (c *Client) ListVolumesForCluster(clusterID string) ([]Volume, error) {
 	cluster, err := c.FindKubernetesCluster(clusterID)
 	if err != nil {
 		return nil, err
 	}
 
-	volumes, err := c.ListVolumes()
+	volumes, err := c.listVolumes(url.Values{"cluster_id": {cluster.ID}})
+	if errors.Is(err, UnsupportedFilterError) {
+		volumes, err = c.ListVolumes()
+	}
 	if err != nil {
-		return nil, decodeError(err)
+		return nil, err
 	}
 
 	var vols []Volume
@@ -90,21 +97,26 @@ func (c *Client) ListVolumesForCluster(clusterID string) ([]Volume, error) {
 	return vols, nil
 }
 
-// ListDanglingVolumes returns all dangling volumes (Volumes which have a cluster ID set but that cluster doesn't exist anymore)
+// ListDanglingVolumes returns all dangling volumes (Volumes which have a cluster ID set but that cluster doesn't exist anymore).
+// The API is asked for the dangling volumes only, but as regions that don't support the filter return every volume
+// each volume's cluster is still checked against the account's clusters.
 func (c *Client) ListDanglingVolumes() ([]Volume, error) {
-	clusters, err := c.ListKubernetesClusters()
+	clusters, err := listAllPages[KubernetesCluster](c, "/v2/kubernetes/clusters")
 	if err != nil {
-		return nil, decodeError(err)
+		return nil, err
 	}
 
 	var clusterIDs []string
-	for _, cluster := range clusters.Items {
+	for _, cluster := range clusters {
 		clusterIDs = append(clusterIDs, cluster.ID)
 	}
 
-	volumes, err := c.ListVolumes()
+	volumes, err := c.listVolumes(url.Values{"dangling": {"true"}})
+	if errors.Is(err, UnsupportedFilterError) {
+		volumes, err = c.ListVolumes()
+	}
 	if err != nil {
-		return nil, decodeError(err)
+		return nil, err
 	}
 
 	var danglingVolumes = make([]Volume, 0)
@@ -118,6 +130,33 @@ func (c *Client) ListDanglingVolumes() ([]Volume, error) {
 	return danglingVolumes, nil
 }
 
+// listVolumes lists the volumes matching params. A filter the API rejects returns an
+// UnsupportedFilterError, callers have to check the volumes themselves as a filter can
+// also be ignored.
+func (c *Client) listVolumes(params url.Values) ([]Volume, error) {
+	requestURL := "/v2/volumes"
+	if len(params) > 0 {
+		requestURL += "?" + params.Encode()
+	}
+
+	resp, err := c.SendGetRequest(requestURL)
+	if err != nil {
+		var httpErr HTTPError
+		if len(params) > 0 && errors.As(err, &httpErr) && (httpErr.Code == http.StatusBadRequest || httpErr.Code == http.StatusUnprocessableEntity) {
+			err := fmt.Errorf("the volumes can't be filtered by %s: %s", params.Encode(), httpErr.Reason)
+			return nil, UnsupportedFilterError.wrap(err)
+		}
+		return nil, decodeError(err)
+	}
+
+	var volumes = make([]Volume, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&volumes); err != nil {
+		return nil, err
+	}
+
+	return volumes, nil
+}
+
 func findString(slice []string, val string) bool {
 	for _, item := range slice {
 		if item == val {
@@ -207,9 +246,48 @@ func (c *Client) ResizeVolume(id string, size int) (*SimpleResponse, error) {
 	return response, err
 }
 
+// ResizeVolumeAndWait resizes a volume and waits until the resize has completed
+func (c *Client) ResizeVolumeAndWait(id string, size int, opts WaitOptions) (*VolumeResizeResult, error) {
+	volume, err := c.GetVolume(id)
+	if err != nil {
+		return nil, err
+	}
+	previousSize := volume.SizeGigabytes
+
+	if _, err := c.ResizeVolume(id, size); err != nil {
+		return nil, err
+	}
+
+	err = waitFor(opts, func() (bool, error) {
+		volume, err = c.GetVolume(id)
+		if err != nil {
+			return false, err
+		}
+		return volume.Status != "resizing" && volume.SizeGigabytes >= size, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &VolumeResizeResult{
+		Volume:                volume,
+		PreviousSizeGigabytes: previousSize,
+		NeedsFilesystemGrow:   volume.SizeGigabytes > previousSize,
+	}, nil
+}
+
 // AttachVolume attaches a volume to an instance
+// With ResolveNames set the volume and instance can be given by name instead of ID
 // https://www.civo.com/api/volumes#attach-a-volume-to-an-instance
 func (c *Client) AttachVolume(id string, v VolumeAttachConfig) (*SimpleResponse, error) {
+	id, err := c.resolveID(ResourceTypeVolume, id)
+	if err != nil {
+		return nil, err
+	}
+	if v.InstanceID, err = c.resolveID(ResourceTypeInstance, v.InstanceID); err != nil {
+		return nil, err
+	}
+
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/volumes/%s/attach", id), v)
 	if err != nil {
 		return nil, decodeError(err)
@@ -220,8 +298,14 @@ func (c *Client) AttachVolume(id string, v VolumeAttachConfig) (*SimpleResponse,
 }
 
 // DetachVolume attach volume from any instances
+// With ResolveNames set the volume's name can be used instead of its ID
 // https://www.civo.com/api/volumes#attach-a-volume-to-an-instance
 func (c *Client) DetachVolume(id string) (*SimpleResponse, error) {
+	id, err := c.resolveExactID(ResourceTypeVolume, id)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/volumes/%s/detach", id), map[string]string{
 		"region": c.Region,
 	})
@@ -234,8 +318,14 @@ func (c *Client) DetachVolume(id string) (*SimpleResponse, error) {
 }
 
 // DeleteVolume deletes a volumes
+// With ResolveNames set the volume's name can be used instead of its ID
 // https://www.civo.com/api/volumes#deleting-a-volume
 func (c *Client) DeleteVolume(id string) (*SimpleResponse, error) {
+	id, err := c.resolveExactID(ResourceTypeVolume, id)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/volumes/%s", id))
 	if err != nil {
 		return nil, decodeError(err)
@@ -287,6 +377,43 @@ func (c *Client) CreateVolumeSnapshot(volumeID string, config *VolumeSnapshotCon
 	return result, nil
 }
 
+// RestoreVolumeSnapshotInPlace rolls a volume back to one of its snapshots without creating a
+// new volume, overwrite must be set to confirm the current contents of the volume can be replaced
+func (c *Client) RestoreVolumeSnapshotInPlace(volumeID, snapshotID string, overwrite bool) (*SimpleResponse, error) {
+	if !overwrite {
+		err := fmt.Errorf("restoring snapshot %s replaces the contents of volume %s, set overwrite to confirm", snapshotID, volumeID)
+		return nil, VolumeRestoreNotConfirmedError.wrap(err)
+	}
+
+	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/volumes/%s/snapshots/%s/restore", volumeID, snapshotID), map[string]interface{}{
+		"overwrite": overwrite,
+		"region":    c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
+// WaitForVolumeRestore waits until a volume being restored from a snapshot leaves the "restoring" status
+func (c *Client) WaitForVolumeRestore(volumeID string, opts WaitOptions) (*Volume, error) {
+	var volume *Volume
+	err := waitFor(opts, func() (bool, error) {
+		var err error
+		volume, err = c.GetVolume(volumeID)
+		if err != nil {
+			return false, err
+		}
+		return volume.Status != "restoring", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return volume, nil
+}
+
 // DeleteVolumeAndAllSnapshot deletes a volume and all its snapshots
 func (c *Client) DeleteVolumeAndAllSnapshot(volumeID string) (*SimpleResponse, error) {
 	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/volumes/%s?delete_snapshot=true", volumeID))
@@ -1,8 +1,12 @@
 package civogo
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListVolumes(t *testing.T) {
@@ -81,6 +85,105 @@ func TestListDanglingVolumes(t *testing.T) {
 	}
 }
 
+func TestListVolumesForClusterServerSide(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/v2/kubernetes/clusters" {
+			rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "69a23478", "name": "web"}]}`))
+			return
+		}
+		query = req.URL.RawQuery
+		rw.Write([]byte(`[{"id": "12345", "name": "my-volume", "size_gb": 25, "cluster_id": "69a23478"}, {"id": "67890", "name": "other-volume", "size_gb": 25, "cluster_id": "other"}]`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	got, err := client.ListVolumesForCluster("web")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if query != "cluster_id=69a23478&region=TEST" {
+		t.Errorf("Expected the cluster ID to be sent to the API, got %q", query)
+	}
+	expected := []Volume{{ID: "12345", Name: "my-volume", SizeGigabytes: 25, ClusterID: "69a23478"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestListVolumesForClusterUnsupportedFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == "/v2/kubernetes/clusters":
+			rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "69a23478", "name": "web"}]}`))
+		case req.URL.Query().Get("cluster_id") != "":
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"code": "unknown_parameter", "reason": "cluster_id isn't a known parameter"}`))
+		default:
+			rw.Write([]byte(`[{"id": "12345", "name": "my-volume", "size_gb": 25, "cluster_id": "69a23478"}, {"id": "67890", "name": "other-volume", "size_gb": 25}]`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	got, err := client.ListVolumesForCluster("69a23478")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []Volume{{ID: "12345", Name: "my-volume", SizeGigabytes: 25, ClusterID: "69a23478"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestListDanglingVolumesServerSide(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes":             {`[{"id": "12345", "name": "my-volume", "size_gb": 25, "cluster_id": "deleted-cluster"}]`},
+		"GET /v2/kubernetes/clusters": {`{"page": 1, "per_page": 100, "pages": 1, "items": [{"id": "live-cluster"}]}`},
+	})
+	defer server.Close()
+
+	got, err := client.ListDanglingVolumes()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []Volume{{ID: "12345", Name: "my-volume", SizeGigabytes: 25, ClusterID: "deleted-cluster"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestListDanglingVolumesFilterIgnored(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes": {`[
+			{"id": "12345", "name": "live-volume", "size_gb": 25, "cluster_id": "live-cluster"},
+			{"id": "67890", "name": "dangling-volume", "size_gb": 25, "cluster_id": "deleted-cluster"}
+		]`},
+		"GET /v2/kubernetes/clusters": {
+			`{"page": 1, "per_page": 100, "pages": 2, "items": [{"id": "other-cluster"}]}`,
+			`{"page": 2, "per_page": 100, "pages": 2, "items": [{"id": "live-cluster"}]}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.ListDanglingVolumes()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []Volume{{ID: "67890", Name: "dangling-volume", SizeGigabytes: 25, ClusterID: "deleted-cluster"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
 func TestFindVolume(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/volumes": `[
@@ -244,6 +347,47 @@ func TestDeleteVolumes(t *testing.T) {
 	}
 }
 
+func TestResizeVolumeAndWait(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes/12346": {
+			`{"id": "12346", "name": "my-volume", "status": "available", "size_gb": 20}`,
+			`{"id": "12346", "name": "my-volume", "status": "resizing", "size_gb": 20}`,
+			`{"id": "12346", "name": "my-volume", "status": "available", "size_gb": 40}`,
+		},
+		"PUT /v2/volumes/12346/resize": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	got, err := client.ResizeVolumeAndWait("12346", 40, WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Volume.SizeGigabytes != 40 {
+		t.Errorf("Expected %d, got %d", 40, got.Volume.SizeGigabytes)
+	}
+	if got.PreviousSizeGigabytes != 20 {
+		t.Errorf("Expected %d, got %d", 20, got.PreviousSizeGigabytes)
+	}
+	if !got.NeedsFilesystemGrow {
+		t.Errorf("Expected the filesystem to need growing")
+	}
+}
+
+func TestResizeVolumeAndWaitTimeout(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes/12346":        {`{"id": "12346", "name": "my-volume", "status": "resizing", "size_gb": 20}`},
+		"PUT /v2/volumes/12346/resize": {`{"result": "success"}`},
+	})
+	defer server.Close()
+
+	_, err := client.ResizeVolumeAndWait("12346", 40, WaitOptions{Timeout: 10 * time.Millisecond, Interval: time.Millisecond})
+	if !errors.Is(err, TimeoutError) {
+		t.Errorf("Expected %s, got %s", TimeoutError, err)
+	}
+}
+
 func TestResizeVolume(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/volumes/12346/resize": `{"result": "success"}`,
@@ -393,3 +537,49 @@ func TestDeleteVolumeAndAllSnapshot(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestRestoreVolumeSnapshotInPlace(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"overwrite":true,"region":"TEST"}`,
+					URL:          "/v2/volumes/12346/snapshots/snap-1/restore",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.RestoreVolumeSnapshotInPlace("12346", "snap-1", true)
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestRestoreVolumeSnapshotInPlaceNotConfirmed(t *testing.T) {
+	client, _ := NewClient("TEST-API-KEY", "TEST")
+
+	if _, err := client.RestoreVolumeSnapshotInPlace("12346", "snap-1", false); !errors.Is(err, VolumeRestoreNotConfirmedError) {
+		t.Errorf("Expected VolumeRestoreNotConfirmedError, got %v", err)
+	}
+}
+
+func TestWaitForVolumeRestore(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes/12346": {
+			`{"id": "12346", "name": "my-volume", "status": "restoring", "size_gb": 20}`,
+			`{"id": "12346", "name": "my-volume", "status": "available", "size_gb": 20}`,
+		},
+	})
+	defer server.Close()
+
+	got, err := client.WaitForVolumeRestore("12346", WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Status != "available" {
+		t.Errorf("Expected %s, got %s", "available", got.Status)
+	}
+}
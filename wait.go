@@ -0,0 +1,45 @@
+package civogo
+
+import (
+	"fmt"
+	"time"
+)
+
+// Defaults used by the wait helpers when WaitOptions fields are left empty
+const (
+	DefaultWaitTimeout  = 10 * time.Minute
+	DefaultWaitInterval = 5 * time.Second
+)
+
+// WaitOptions configures how long and how often the wait helpers poll the API
+type WaitOptions struct {
+	Timeout  time.Duration
+	Interval time.Duration
+}
+
+// waitFor calls check every interval until it reports done, returns an error or the timeout is reached
+func waitFor(opts WaitOptions, check func() (bool, error)) error {
+	if opts.Timeout == 0 {
+		opts.Timeout = DefaultWaitTimeout
+	}
+	if opts.Interval == 0 {
+		opts.Interval = DefaultWaitInterval
+	}
+
+	deadline := time.Now().Add(opts.Timeout)
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		if time.Now().Add(opts.Interval).After(deadline) {
+			err := fmt.Errorf("timed out after %s", opts.Timeout)
+			return TimeoutError.wrap(err)
+		}
+		time.Sleep(opts.Interval)
+	}
+}
@@ -0,0 +1,190 @@
+package civogo
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// WatchEventType is the kind of change a Watcher saw between two polls
+type WatchEventType string
+
+// Changes reported by a Watcher
+const (
+	WatchEventAdded   WatchEventType = "added"
+	WatchEventUpdated WatchEventType = "updated"
+	WatchEventDeleted WatchEventType = "deleted"
+)
+
+// WatchOptions controls how often a Watcher polls the API
+type WatchOptions struct {
+	// Interval is the time between polls, it defaults to DefaultWaitInterval
+	Interval time.Duration
+}
+
+// WatchEvent is a single change seen by a Watcher, Old is the zero value for added
+// resources and New is the zero value for deleted ones
+type WatchEvent[T any] struct {
+	Type WatchEventType
+	Old  T
+	New  T
+}
+
+// Watcher polls a list of resources and calls its handlers with the changes between each
+// poll. The first poll happens after one interval and reports every existing resource as
+// added, so handlers registered straight after creating the watcher see the current state.
+// Errors from the API don't stop the watcher, they're passed to the OnError handlers and
+// the next poll carries on from the last good list.
+type Watcher[T any] struct {
+	list     func() ([]T, error)
+	key      func(T) string
+	interval time.Duration
+	cancel   context.CancelFunc
+	done     chan struct{}
+
+	mu       sync.Mutex
+	handlers []func(WatchEvent[T])
+	onError  []func(error)
+}
+
+// NewWatcher starts watching the resources returned by list, using key to match the same
+// resource across polls. The watcher stops when the context is cancelled or Stop is called.
+func NewWatcher[T any](ctx context.Context, opts WatchOptions, list func() ([]T, error), key func(T) string) *Watcher[T] {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	w := &Watcher[T]{
+		list:     list,
+		key:      key,
+		interval: interval,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go w.run(ctx)
+
+	return w
+}
+
+// OnEvent registers a handler called with every change
+func (w *Watcher[T]) OnEvent(fn func(WatchEvent[T])) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.handlers = append(w.handlers, fn)
+}
+
+// OnChange registers a handler called with the old and new state of every change, old is
+// the zero value for added resources and new is the zero value for deleted ones
+func (w *Watcher[T]) OnChange(fn func(old, new T)) {
+	w.OnEvent(func(e WatchEvent[T]) { fn(e.Old, e.New) })
+}
+
+// OnError registers a handler called when listing the resources fails
+func (w *Watcher[T]) OnError(fn func(error)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.onError = append(w.onError, fn)
+}
+
+// Stop stops the watcher, no handlers are called once Done is closed
+func (w *Watcher[T]) Stop() {
+	w.cancel()
+}
+
+// Done is closed once the watcher has stopped
+func (w *Watcher[T]) Done() <-chan struct{} {
+	return w.done
+}
+
+func (w *Watcher[T]) run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	previous := map[string]T{}
+	var order []string
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		items, err := w.list()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			w.mu.Lock()
+			handlers := append([]func(error){}, w.onError...)
+			w.mu.Unlock()
+			for _, fn := range handlers {
+				fn(err)
+			}
+			continue
+		}
+
+		current := make(map[string]T, len(items))
+		currentOrder := make([]string, 0, len(items))
+		var events []WatchEvent[T]
+		for _, item := range items {
+			k := w.key(item)
+			current[k] = item
+			currentOrder = append(currentOrder, k)
+
+			old, existed := previous[k]
+			switch {
+			case !existed:
+				events = append(events, WatchEvent[T]{Type: WatchEventAdded, New: item})
+			case !reflect.DeepEqual(old, item):
+				events = append(events, WatchEvent[T]{Type: WatchEventUpdated, Old: old, New: item})
+			}
+		}
+		for _, k := range order {
+			if _, ok := current[k]; !ok {
+				events = append(events, WatchEvent[T]{Type: WatchEventDeleted, Old: previous[k]})
+			}
+		}
+		previous, order = current, currentOrder
+
+		w.mu.Lock()
+		handlers := append([]func(WatchEvent[T]){}, w.handlers...)
+		w.mu.Unlock()
+		for _, e := range events {
+			for _, fn := range handlers {
+				fn(e)
+			}
+		}
+	}
+}
+
+// WatchInstances polls the instances in the client's region and reports their changes
+func (c *Client) WatchInstances(ctx context.Context, opts WatchOptions) *Watcher[Instance] {
+	worker := c.worker()
+	return NewWatcher(ctx, opts, worker.ListAllInstances, func(i Instance) string { return i.ID })
+}
+
+// WatchVolumes polls the volumes in the client's region and reports their changes
+func (c *Client) WatchVolumes(ctx context.Context, opts WatchOptions) *Watcher[Volume] {
+	worker := c.worker()
+	return NewWatcher(ctx, opts, worker.ListVolumes, func(v Volume) string { return v.ID })
+}
+
+// WatchKubernetesClusters polls the Kubernetes clusters in the client's region and reports their changes
+func (c *Client) WatchKubernetesClusters(ctx context.Context, opts WatchOptions) *Watcher[KubernetesCluster] {
+	worker := c.worker()
+	list := func() ([]KubernetesCluster, error) {
+		return listAllPages[KubernetesCluster](worker, "/v2/kubernetes/clusters")
+	}
+	return NewWatcher(ctx, opts, list, func(k KubernetesCluster) string { return k.ID })
+}
+
+// WatchLoadBalancers polls the load balancers in the client's region and reports their changes
+func (c *Client) WatchLoadBalancers(ctx context.Context, opts WatchOptions) *Watcher[LoadBalancer] {
+	worker := c.worker()
+	return NewWatcher(ctx, opts, worker.ListLoadBalancers, func(lb LoadBalancer) string { return lb.ID })
+}
@@ -0,0 +1,111 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWatchVolumes(t *testing.T) {
+	client, server, _ := NewRoutedClientForTesting(map[string][]string{
+		"GET /v2/volumes": {
+			`[{"id": "vol-1", "name": "data", "status": "available"}, {"id": "vol-2", "name": "logs", "status": "available"}]`,
+			`[{"id": "vol-1", "name": "data", "status": "attached"}, {"id": "vol-3", "name": "cache", "status": "available"}]`,
+		},
+	})
+	defer server.Close()
+
+	events := make(chan WatchEvent[Volume], 10)
+	changes := make(chan [2]Volume, 10)
+	w := client.WatchVolumes(context.Background(), WatchOptions{Interval: 5 * time.Millisecond})
+	w.OnEvent(func(e WatchEvent[Volume]) { events <- e })
+	w.OnChange(func(old, new Volume) { changes <- [2]Volume{old, new} })
+
+	expected := []struct {
+		Type WatchEventType
+		ID   string
+	}{
+		{WatchEventAdded, "vol-1"},
+		{WatchEventAdded, "vol-2"},
+		{WatchEventUpdated, "vol-1"},
+		{WatchEventAdded, "vol-3"},
+		{WatchEventDeleted, "vol-2"},
+	}
+	for _, want := range expected {
+		select {
+		case e := <-events:
+			id := e.New.ID
+			if e.Type == WatchEventDeleted {
+				id = e.Old.ID
+			}
+			if e.Type != want.Type || id != want.ID {
+				t.Errorf("Expected %s %s, got %s %s", want.Type, want.ID, e.Type, id)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out waiting for %s %s", want.Type, want.ID)
+		}
+	}
+
+	// the last response is repeated, so nothing else changes
+	select {
+	case e := <-events:
+		t.Errorf("Unexpected event %+v", e)
+	case <-time.After(30 * time.Millisecond):
+	}
+
+	w.Stop()
+	select {
+	case <-w.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for the watcher to stop")
+	}
+
+	var update [2]Volume
+	for i := 0; i < 3; i++ {
+		update = <-changes
+	}
+	if update[0].Status != "available" || update[1].Status != "attached" {
+		t.Errorf("Expected the old and new state of the update, got %+v", update)
+	}
+}
+
+func TestWatcherErrors(t *testing.T) {
+	calls := 0
+	list := func() ([]Volume, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("boom")
+		}
+		return []Volume{{ID: "vol-1"}}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errs := make(chan error, 10)
+	events := make(chan WatchEvent[Volume], 10)
+	w := NewWatcher(ctx, WatchOptions{Interval: 5 * time.Millisecond}, list, func(v Volume) string { return v.ID })
+	w.OnError(func(err error) { errs <- err })
+	w.OnEvent(func(e WatchEvent[Volume]) { events <- e })
+
+	select {
+	case err := <-errs:
+		if err.Error() != "boom" {
+			t.Errorf("Unexpected error %s", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for the error")
+	}
+	select {
+	case e := <-events:
+		if e.Type != WatchEventAdded || e.New.ID != "vol-1" {
+			t.Errorf("Unexpected event %+v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for the watcher to recover")
+	}
+
+	cancel()
+	<-w.Done()
+}
@@ -2,11 +2,39 @@ package civogo
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
 	"strings"
 )
 
+// WebhookEvent is an event a webhook can be subscribed to, named "resource.action". A
+// webhook can subscribe to every event with "*" or every action of a resource with "resource.*"
+type WebhookEvent string
+
+// Events webhooks can be subscribed to
+const (
+	WebhookEventAll                      WebhookEvent = "*"
+	WebhookEventInstanceCreated          WebhookEvent = "instance.created"
+	WebhookEventInstanceActive           WebhookEvent = "instance.active"
+	WebhookEventInstanceDeleted          WebhookEvent = "instance.deleted"
+	WebhookEventKubernetesClusterCreated WebhookEvent = "kubernetes_cluster.created"
+	WebhookEventKubernetesClusterDeleted WebhookEvent = "kubernetes_cluster.deleted"
+	WebhookEventVolumeCreated            WebhookEvent = "volume.created"
+	WebhookEventVolumeDeleted            WebhookEvent = "volume.deleted"
+)
+
+// webhookSecretLength is the length of the secrets made by GenerateWebhookSecret
+const webhookSecretLength = 32
+
+var webhookEventPattern = regexp.MustCompile(`^(\*|[a-z0-9_]+\.(\*|[a-z0-9_]+))$`)
+
 // Webhook is a representation of a saved webhook callback from changes in Civo
 type Webhook struct {
 	ID                string   `json:"id"`
@@ -25,8 +53,115 @@ type WebhookConfig struct {
 	Secret string   `json:"secret"`
 }
 
-// CreateWebhook creates a new webhook
+// WebhookEvents converts typed events to the strings used by Webhook and WebhookConfig
+func WebhookEvents(events ...WebhookEvent) []string {
+	result := make([]string, 0, len(events))
+	for _, event := range events {
+		result = append(result, string(event))
+	}
+	return result
+}
+
+// Validate checks the URL and events that are set, an update only needs the fields it changes
+func (r *WebhookConfig) Validate() error {
+	if r.URL != "" {
+		u, err := url.Parse(r.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			err := fmt.Errorf("%q isn't a valid http or https URL", r.URL)
+			return InvalidWebhookConfigError.wrap(err)
+		}
+	}
+
+	for _, event := range r.Events {
+		if !webhookEventPattern.MatchString(event) {
+			err := fmt.Errorf("%q isn't a valid event, must be \"*\", \"resource.*\" or \"resource.action\"", event)
+			return InvalidWebhookConfigError.wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// Subscribed reports whether the webhook receives an event, taking wildcards into account
+func (w *Webhook) Subscribed(event WebhookEvent) bool {
+	resource, _, _ := strings.Cut(string(event), ".")
+	for _, subscribed := range w.Events {
+		if subscribed == string(WebhookEventAll) || subscribed == string(event) || subscribed == resource+".*" {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskedSecret returns the webhook's secret with all but the last four characters hidden,
+// suitable for showing in logs and UIs
+func (w *Webhook) MaskedSecret() string {
+	if len(w.Secret) <= 4 {
+		return strings.Repeat("*", len(w.Secret))
+	}
+	return strings.Repeat("*", len(w.Secret)-4) + w.Secret[len(w.Secret)-4:]
+}
+
+// GenerateWebhookSecret returns a random alphanumeric secret for signing webhook deliveries
+func GenerateWebhookSecret() (string, error) {
+	const alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	secret := make([]byte, webhookSecretLength)
+	for i := range secret {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(alphabet))))
+		if err != nil {
+			return "", err
+		}
+		secret[i] = alphabet[n.Int64()]
+	}
+
+	return string(secret), nil
+}
+
+// WebhookSignature returns the hex encoded HMAC-SHA256 of a webhook payload signed with secret
+func WebhookSignature(payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature checks the signature of a webhook delivery, the value of the header the
+// receiver reads it from, against its raw payload and the webhook's secret. The value may be
+// prefixed with "sha256=" and is compared in constant time. The signature only covers the
+// payload, so it doesn't protect against a captured delivery being replayed; receivers that
+// mustn't act twice should skip payloads they've already handled.
+func VerifyWebhookSignature(payload []byte, header, secret string) error {
+	if secret == "" {
+		err := fmt.Errorf("the webhook secret is empty so the signature can't be checked")
+		return InvalidWebhookSignatureError.wrap(err)
+	}
+
+	signature := strings.TrimPrefix(strings.TrimSpace(header), "sha256=")
+	given, err := hex.DecodeString(signature)
+	if err != nil || len(given) != sha256.Size {
+		err := fmt.Errorf("the signature header %q isn't a hex encoded SHA256 HMAC", header)
+		return InvalidWebhookSignatureError.wrap(err)
+	}
+
+	expected, _ := hex.DecodeString(WebhookSignature(payload, secret))
+	if !hmac.Equal(given, expected) {
+		err := fmt.Errorf("the signature doesn't match the payload")
+		return InvalidWebhookSignatureError.wrap(err)
+	}
+
+	return nil
+}
+
+// CreateWebhook creates a new webhook, GenerateWebhookSecret can be used to make its secret
 func (c *Client) CreateWebhook(r *WebhookConfig) (*Webhook, error) {
+	if r.URL == "" {
+		err := fmt.Errorf("a URL is needed to create a webhook")
+		return nil, InvalidWebhookConfigError.wrap(err)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
 	body, err := c.SendPostRequest("/v2/webhooks", r)
 	if err != nil {
 		return nil, decodeError(err)
@@ -91,6 +226,10 @@ func (c *Client) FindWebhook(search string) (*Webhook, error) {
 
 // UpdateWebhook updates a webhook
 func (c *Client) UpdateWebhook(id string, r *WebhookConfig) (*Webhook, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
 	body, err := c.SendPutRequest(fmt.Sprintf("/v2/webhooks/%s", id), r)
 	if err != nil {
 		return nil, decodeError(err)
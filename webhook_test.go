@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -190,3 +191,91 @@ func TestDeleteWebhook(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestCreateWebhookValidation(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/webhooks": `{"id": "b8de2e4e-72f4-4911-83ee-f4fc030fc4a2"}`,
+	})
+	defer server.Close()
+
+	for _, cfg := range []*WebhookConfig{
+		{Events: []string{"*"}},
+		{Events: []string{"*"}, URL: "ftp://example.com/webhook"},
+		{Events: []string{"instance created"}, URL: "https://api.example.com/webhook"},
+	} {
+		_, err := client.CreateWebhook(cfg)
+		if !errors.Is(err, InvalidWebhookConfigError) {
+			t.Errorf("Expected %s for %+v, got %v", InvalidWebhookConfigError, cfg, err)
+		}
+	}
+}
+
+func TestWebhookSubscribed(t *testing.T) {
+	webhook := Webhook{Events: []string{"instance.*", "volume.created"}}
+
+	cases := map[WebhookEvent]bool{
+		WebhookEventInstanceCreated:          true,
+		WebhookEventInstanceDeleted:          true,
+		WebhookEventVolumeCreated:            true,
+		WebhookEventVolumeDeleted:            false,
+		WebhookEventKubernetesClusterCreated: false,
+	}
+	for event, expected := range cases {
+		if got := webhook.Subscribed(event); got != expected {
+			t.Errorf("Expected %t for %s, got %t", expected, event, got)
+		}
+	}
+
+	all := Webhook{Events: WebhookEvents(WebhookEventAll)}
+	if !all.Subscribed(WebhookEventKubernetesClusterDeleted) {
+		t.Errorf("Expected a wildcard webhook to receive every event")
+	}
+}
+
+func TestWebhookMaskedSecret(t *testing.T) {
+	webhook := Webhook{Secret: "DfeFUON8gorc5Zj0hk4GT1M9QImnRL6J"}
+	if got := webhook.MaskedSecret(); got != "****************************RL6J" {
+		t.Errorf("Expected the secret to be masked, got %s", got)
+	}
+}
+
+func TestGenerateWebhookSecret(t *testing.T) {
+	first, err := GenerateWebhookSecret()
+	if err != nil {
+		t.Errorf("Generating returned an error: %s", err)
+		return
+	}
+	second, _ := GenerateWebhookSecret()
+
+	if len(first) != webhookSecretLength || first == second {
+		t.Errorf("Expected two different secrets of %d characters, got %s and %s", webhookSecretLength, first, second)
+	}
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	payload := []byte(`{"event": "instance.created", "id": "12345"}`)
+	secret := "DfeFUON8gorc5Zj0hk4GT1M9QImnRL6J"
+	signature := WebhookSignature(payload, secret)
+
+	if err := VerifyWebhookSignature(payload, signature, secret); err != nil {
+		t.Errorf("Expected the signature to verify, got %s", err)
+	}
+	if err := VerifyWebhookSignature(payload, "sha256="+signature, secret); err != nil {
+		t.Errorf("Expected the prefixed signature to verify, got %s", err)
+	}
+
+	for name, header := range map[string]string{
+		"tampered payload": WebhookSignature([]byte(`{"event": "instance.deleted"}`), secret),
+		"wrong secret":     WebhookSignature(payload, "other"),
+		"not hex":          "not-a-signature",
+		"empty":            "",
+	} {
+		if err := VerifyWebhookSignature(payload, header, secret); !errors.Is(err, InvalidWebhookSignatureError) {
+			t.Errorf("Expected %s for a %s, got %v", InvalidWebhookSignatureError, name, err)
+		}
+	}
+
+	if err := VerifyWebhookSignature(payload, signature, ""); !errors.Is(err, InvalidWebhookSignatureError) {
+		t.Errorf("Expected %s without a secret, got %v", InvalidWebhookSignatureError, err)
+	}
+}